@@ -2,8 +2,10 @@ package gofakeit
 
 import (
 	"errors"
-	"math/rand"
+	"math"
 	"strings"
+
+	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 // AddressInfo is a struct full of address information
@@ -19,11 +21,16 @@ type AddressInfo struct {
 }
 
 // Address will generate a struct of address information
-func Address() *AddressInfo {
-	street := Street()
-	city := City()
-	state := State()
-	zip := Zip()
+func Address() *AddressInfo { return address(globalFaker.ctx()) }
+
+// Address will generate a struct of address information
+func (f *Faker) Address() *AddressInfo { return address(f.ctx()) }
+
+func address(r fakerCtx) *AddressInfo {
+	street := street(r)
+	city := city(r)
+	state := state(r)
+	zip := zip(r)
 
 	return &AddressInfo{
 		Address:   street + ", " + city + ", " + state + " " + zip,
@@ -31,103 +38,328 @@ func Address() *AddressInfo {
 		City:      city,
 		State:     state,
 		Zip:       zip,
-		Country:   Country(),
-		Latitude:  Latitude(),
-		Longitude: Longitude(),
+		Country:   country(r),
+		Latitude:  latitude(r),
+		Longitude: longitude(r),
 	}
 }
 
+// AddressConsistent will generate a struct of united states address information
+// where the city, state, zip and coordinates agree with each other
+func AddressConsistent() *AddressInfo { return addressConsistent(globalFaker.ctx()) }
+
+// AddressConsistent will generate a struct of united states address information
+// where the city, state, zip and coordinates agree with each other
+func (f *Faker) AddressConsistent() *AddressInfo { return addressConsistent(f.ctx()) }
+
+func addressConsistent(r fakerCtx) *AddressInfo {
+	city := data.USCities[r.Intn(len(data.USCities))]
+	street := street(r)
+	zip := city.ZipPrefix + replaceWithNumbers(r, "##")
+
+	// Keep the coordinates within a few kilometers of the city center
+	lat := toFixed(city.Latitude+randFloat64Range(r, -0.05, 0.05), 6)
+	lng := toFixed(city.Longitude+randFloat64Range(r, -0.05, 0.05), 6)
+
+	return &AddressInfo{
+		Address:   street + ", " + city.City + ", " + city.State + " " + zip,
+		Street:    street,
+		City:      city.City,
+		State:     city.State,
+		Zip:       zip,
+		Country:   "United States of America",
+		Latitude:  lat,
+		Longitude: lng,
+	}
+}
+
+// Street will generate a random address street string
+func Street() string { return street(globalFaker.ctx()) }
+
 // Street will generate a random address street string
-func Street() (street string) {
-	switch randInt := randIntRange(1, 2); randInt {
+func (f *Faker) Street() string { return street(f.ctx()) }
+
+func street(r fakerCtx) (street string) {
+	switch randInt := randIntRange(r, 1, 2); randInt {
 	case 1:
-		street = StreetNumber() + " " + StreetPrefix() + " " + StreetName() + " " + StreetSuffix()
+		street = streetNumber(r) + " " + streetPrefix(r) + " " + streetName(r) + " " + streetSuffix(r)
 	case 2:
-		street = StreetNumber() + " " + StreetName() + " " + StreetSuffix()
+		street = streetNumber(r) + " " + streetName(r) + " " + streetSuffix(r)
 	}
 
 	return
 }
 
 // StreetNumber will generate a random address street number string
-func StreetNumber() string {
-	return strings.TrimLeft(replaceWithNumbers(getRandValue([]string{"address", "number"})), "0")
+func StreetNumber() string { return streetNumber(globalFaker.ctx()) }
+
+// StreetNumber will generate a random address street number string
+func (f *Faker) StreetNumber() string { return streetNumber(f.ctx()) }
+
+func streetNumber(r fakerCtx) string {
+	return strings.TrimLeft(replaceWithNumbers(r, getRandValue(r, []string{"address", "number"})), "0")
 }
 
 // StreetPrefix will generate a random address street prefix string
-func StreetPrefix() string {
-	return getRandValue([]string{"address", "street_prefix"})
+func StreetPrefix() string { return streetPrefix(globalFaker.ctx()) }
+
+// StreetPrefix will generate a random address street prefix string
+func (f *Faker) StreetPrefix() string { return streetPrefix(f.ctx()) }
+
+func streetPrefix(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "street_prefix"})
 }
 
 // StreetName will generate a random address street name string
-func StreetName() string {
-	return getRandValue([]string{"address", "street_name"})
+func StreetName() string { return streetName(globalFaker.ctx()) }
+
+// StreetName will generate a random address street name string
+func (f *Faker) StreetName() string { return streetName(f.ctx()) }
+
+func streetName(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "street_name"})
 }
 
 // StreetSuffix will generate a random address street suffix string
-func StreetSuffix() string {
-	return getRandValue([]string{"address", "street_suffix"})
+func StreetSuffix() string { return streetSuffix(globalFaker.ctx()) }
+
+// StreetSuffix will generate a random address street suffix string
+func (f *Faker) StreetSuffix() string { return streetSuffix(f.ctx()) }
+
+func streetSuffix(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "street_suffix"})
 }
 
 // City will generate a random city string
-func City() (city string) {
-	switch randInt := randIntRange(1, 3); randInt {
+func City() string { return city(globalFaker.ctx()) }
+
+// City will generate a random city string
+func (f *Faker) City() string { return city(f.ctx()) }
+
+func city(r fakerCtx) (city string) {
+	// City names are built from other data sets unless they were set with SetData
+	if cities, ok := dataOverride(r, []string{"address", "city"}); ok {
+		return cities[r.Intn(len(cities))]
+	}
+
+	switch randInt := randIntRange(r, 1, 3); randInt {
 	case 1:
-		city = FirstName() + StreetSuffix()
+		city = firstName(r) + streetSuffix(r)
 	case 2:
-		city = LastName() + StreetSuffix()
+		city = lastName(r) + streetSuffix(r)
 	case 3:
-		city = StreetPrefix() + " " + LastName()
+		city = streetPrefix(r) + " " + lastName(r)
 	}
 
 	return
 }
 
 // State will generate a random state string
-func State() string {
-	return getRandValue([]string{"address", "state"})
+func State() string { return state(globalFaker.ctx()) }
+
+// State will generate a random state string
+func (f *Faker) State() string { return state(f.ctx()) }
+
+func state(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "state"})
 }
 
 // StateAbr will generate a random abbreviated state string
-func StateAbr() string {
-	return getRandValue([]string{"address", "state_abr"})
+func StateAbr() string { return stateAbr(globalFaker.ctx()) }
+
+// StateAbr will generate a random abbreviated state string
+func (f *Faker) StateAbr() string { return stateAbr(f.ctx()) }
+
+func stateAbr(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "state_abr"})
 }
 
 // Zip will generate a random Zip code string
-func Zip() string {
-	return replaceWithNumbers(getRandValue([]string{"address", "zip"}))
+func Zip() string { return zip(globalFaker.ctx()) }
+
+// Zip will generate a random Zip code string
+func (f *Faker) Zip() string { return zip(f.ctx()) }
+
+func zip(r fakerCtx) string {
+	return replaceWithNumbers(r, getRandValue(r, []string{"address", "zip"}))
+}
+
+// PostalCode will generate a random postal code in the format of the country code,
+// US (12345 or 12345-6789), GB (L48 9BS), CA (A1A 1A1), NL (1234 AB) or JP (123-4567)
+func PostalCode(countryCode string) (string, error) {
+	return postalCode(globalFaker.ctx(), countryCode)
+}
+
+// PostalCode will generate a random postal code in the format of the country code,
+// US (12345 or 12345-6789), GB (L48 9BS), CA (A1A 1A1), NL (1234 AB) or JP (123-4567)
+func (f *Faker) PostalCode(countryCode string) (string, error) {
+	return postalCode(f.ctx(), countryCode)
+}
+
+func postalCode(r fakerCtx, countryCode string) (string, error) {
+	format, ok := data.PostalCodeFormats[strings.ToUpper(countryCode)]
+	if !ok {
+		return "", errors.New("Unsupported postal code country code " + countryCode)
+	}
+
+	b := []byte(randomString(r, format.Formats))
+	for i := 0; i < len(b); i++ {
+		switch b[i] {
+		case '#':
+			b[i] = byte(randDigit(r))
+		case 'N':
+			b[i] = byte(randIntRange(r, 1, 9)) + '0'
+		case 'A':
+			b[i] = format.Letters[r.Intn(len(format.Letters))]
+		}
+	}
+
+	return string(b), nil
 }
 
 // Country will generate a random country string
-func Country() string {
-	return getRandValue([]string{"address", "country"})
+func Country() string { return country(globalFaker.ctx()) }
+
+// Country will generate a random country string
+func (f *Faker) Country() string { return country(f.ctx()) }
+
+func country(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "country"})
 }
 
 // CountryAbr will generate a random abbreviated country string
-func CountryAbr() string {
-	return getRandValue([]string{"address", "country_abr"})
+func CountryAbr() string { return countryAbr(globalFaker.ctx()) }
+
+// CountryAbr will generate a random abbreviated country string
+func (f *Faker) CountryAbr() string { return countryAbr(f.ctx()) }
+
+func countryAbr(r fakerCtx) string {
+	return getRandValue(r, []string{"address", "country_abr"})
 }
 
 // Latitude will generate a random latitude float64
-func Latitude() float64 { return toFixed((rand.Float64()*180)-90, 6) }
+func Latitude() float64 { return latitude(globalFaker.ctx()) }
+
+// Latitude will generate a random latitude float64
+func (f *Faker) Latitude() float64 { return latitude(f.ctx()) }
+
+func latitude(r fakerCtx) float64 { return toFixed((r.Float64()*180)-90, 6) }
 
 // LatitudeInRange will generate a random latitude within the input range
 func LatitudeInRange(min, max float64) (float64, error) {
+	return latitudeInRange(globalFaker.ctx(), min, max)
+}
+
+// LatitudeInRange will generate a random latitude within the input range
+func (f *Faker) LatitudeInRange(min, max float64) (float64, error) {
+	return latitudeInRange(f.ctx(), min, max)
+}
+
+func latitudeInRange(r fakerCtx, min, max float64) (float64, error) {
 	if min > max || min < -90 || min > 90 || max < -90 || max > 90 {
 		return 0, errors.New("Invalid min or max range, must be valid floats and between -90 and 90")
 	}
-	return toFixed(randFloat64Range(min, max), 6), nil
+	return toFixed(randFloat64Range(r, min, max), 6), nil
 }
 
 // Longitude will generate a random longitude float64
-func Longitude() float64 { return toFixed((rand.Float64()*360)-180, 6) }
+func Longitude() float64 { return longitude(globalFaker.ctx()) }
+
+// Longitude will generate a random longitude float64
+func (f *Faker) Longitude() float64 { return longitude(f.ctx()) }
+
+func longitude(r fakerCtx) float64 { return toFixed((r.Float64()*360)-180, 6) }
 
 // LongitudeInRange will generate a random longitude within the input range
 func LongitudeInRange(min, max float64) (float64, error) {
+	return longitudeInRange(globalFaker.ctx(), min, max)
+}
+
+// LongitudeInRange will generate a random longitude within the input range
+func (f *Faker) LongitudeInRange(min, max float64) (float64, error) {
+	return longitudeInRange(f.ctx(), min, max)
+}
+
+func longitudeInRange(r fakerCtx, min, max float64) (float64, error) {
 	if min > max || min < -180 || min > 180 || max < -180 || max > 180 {
 		return 0, errors.New("Invalid min or max range, must be valid floats and between -180 and 180")
 	}
-	return toFixed(randFloat64Range(min, max), 6), nil
+	return toFixed(randFloat64Range(r, min, max), 6), nil
+}
+
+// earthRadiusKm is the mean radius of the earth used for geodesic offsets
+const earthRadiusKm = 6371.0088
+
+// LatLngInRange will generate a random latitude and longitude within a bounding box.
+// A box where minLng is greater than maxLng crosses the antimeridian
+func LatLngInRange(minLat, minLng, maxLat, maxLng float64) (float64, float64, error) {
+	return latLngInRange(globalFaker.ctx(), minLat, minLng, maxLat, maxLng)
+}
+
+// LatLngInRange will generate a random latitude and longitude within a bounding box.
+// A box where minLng is greater than maxLng crosses the antimeridian
+func (f *Faker) LatLngInRange(minLat, minLng, maxLat, maxLng float64) (float64, float64, error) {
+	return latLngInRange(f.ctx(), minLat, minLng, maxLat, maxLng)
+}
+
+func latLngInRange(r fakerCtx, minLat, minLng, maxLat, maxLng float64) (float64, float64, error) {
+	lat, err := latitudeInRange(r, minLat, maxLat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if minLng <= maxLng {
+		lng, err := longitudeInRange(r, minLng, maxLng)
+		if err != nil {
+			return 0, 0, err
+		}
+		return lat, lng, nil
+	}
+
+	// Crossing the antimeridian, pick along the wrapped span
+	if minLng > 180 || maxLng < -180 {
+		return 0, 0, errors.New("Invalid min or max range, must be valid floats and between -180 and 180")
+	}
+	lng := randFloat64Range(r, minLng, maxLng+360)
+	if lng > 180 {
+		lng -= 360
+	}
+	return lat, toFixed(lng, 6), nil
+}
+
+// LatLngNear will generate a random latitude and longitude within radiusKm kilometers
+// of a point, spread evenly over the area using a geodesic offset
+func LatLngNear(lat, lng, radiusKm float64) (float64, float64, error) {
+	return latLngNear(globalFaker.ctx(), lat, lng, radiusKm)
+}
+
+// LatLngNear will generate a random latitude and longitude within radiusKm kilometers
+// of a point, spread evenly over the area using a geodesic offset
+func (f *Faker) LatLngNear(lat, lng, radiusKm float64) (float64, float64, error) {
+	return latLngNear(f.ctx(), lat, lng, radiusKm)
+}
+
+func latLngNear(r fakerCtx, lat, lng, radiusKm float64) (float64, float64, error) {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return 0, 0, errors.New("Invalid latitude or longitude, must be between -90 and 90 and -180 and 180")
+	}
+	if radiusKm < 0 {
+		return 0, 0, errors.New("Radius must be 0 or greater")
+	}
+
+	// Angular distance, the square root keeps points from bunching up at the center
+	dist := math.Min(radiusKm*math.Sqrt(r.Float64())/earthRadiusKm, math.Pi)
+	bearing := r.Float64() * 2 * math.Pi
+
+	lat1 := lat * math.Pi / 180
+	lng1 := lng * math.Pi / 180
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(dist) + math.Cos(lat1)*math.Sin(dist)*math.Cos(bearing))
+	lng2 := lng1 + math.Atan2(math.Sin(bearing)*math.Sin(dist)*math.Cos(lat1), math.Cos(dist)-math.Sin(lat1)*math.Sin(lat2))
+
+	// Normalize the longitude back to -180 to 180
+	lngOut := math.Mod(lng2*180/math.Pi+540, 360) - 180
+
+	return toFixed(lat2*180/math.Pi, 6), toFixed(lngOut, 6), nil
 }
 
 func addAddressLookup() {
@@ -146,8 +378,20 @@ func addAddressLookup() {
 			longitude: "89.022594"
 		}`,
 		Output: "map[string]interface",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Address(), nil
+		Params: []Param{
+			{Field: "consistent", Display: "Consistent", Type: "bool", Default: "false", Description: "Whether the city, state and zip should agree with each other"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			consistent, err := info.GetBool(m, "consistent")
+			if err != nil {
+				return nil, err
+			}
+
+			if consistent {
+				return addressConsistent(r), nil
+			}
+
+			return address(r), nil
 		},
 	})
 
@@ -157,8 +401,8 @@ func addAddressLookup() {
 		Description: "Random city",
 		Example:     "Marcelside",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return City(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return city(r), nil
 		},
 	})
 
@@ -168,8 +412,8 @@ func addAddressLookup() {
 		Description: "Random country",
 		Example:     "United States of America",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Country(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return country(r), nil
 		},
 	})
 
@@ -179,8 +423,8 @@ func addAddressLookup() {
 		Description: "Random 2 digit country abbreviation",
 		Example:     "US",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CountryAbr(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return countryAbr(r), nil
 		},
 	})
 
@@ -190,8 +434,8 @@ func addAddressLookup() {
 		Description: "Random state",
 		Example:     "Illinois",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return State(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return state(r), nil
 		},
 	})
 
@@ -201,8 +445,8 @@ func addAddressLookup() {
 		Description: "Random 2 digit state abbreviation",
 		Example:     "IL",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return StateAbr(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return stateAbr(r), nil
 		},
 	})
 
@@ -212,8 +456,8 @@ func addAddressLookup() {
 		Description: "Random full street",
 		Example:     "364 East Rapidsborough",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Street(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return street(r), nil
 		},
 	})
 
@@ -223,8 +467,8 @@ func addAddressLookup() {
 		Description: "Random street name",
 		Example:     "View",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return StreetName(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return streetName(r), nil
 		},
 	})
 
@@ -234,8 +478,8 @@ func addAddressLookup() {
 		Description: "Random street number",
 		Example:     "13645",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return StreetNumber(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return streetNumber(r), nil
 		},
 	})
 
@@ -245,8 +489,8 @@ func addAddressLookup() {
 		Description: "Random street prefix",
 		Example:     "Lake",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return StreetPrefix(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return streetPrefix(r), nil
 		},
 	})
 
@@ -256,8 +500,8 @@ func addAddressLookup() {
 		Description: "Random street suffix",
 		Example:     "land",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return StreetSuffix(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return streetSuffix(r), nil
 		},
 	})
 
@@ -267,8 +511,27 @@ func addAddressLookup() {
 		Description: "Random street zip",
 		Example:     "13645",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Zip(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return zip(r), nil
+		},
+	})
+
+	AddFuncLookup("postalcode", Info{
+		Display:     "Postal Code",
+		Category:    "address",
+		Description: "Random postal code in the format of a country",
+		Example:     "L48 9BS",
+		Output:      "string",
+		Params: []Param{
+			{Field: "country", Display: "Country", Type: "string", Default: "US", Options: []string{"US", "GB", "CA", "NL", "JP"}, Description: "Country code of the postal code format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			country, err := info.GetString(m, "country")
+			if err != nil {
+				return nil, err
+			}
+
+			return postalCode(r, country)
 		},
 	})
 
@@ -278,8 +541,8 @@ func addAddressLookup() {
 		Description: "Random latitude",
 		Example:     "-73.534056",
 		Output:      "float",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Latitude(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return latitude(r), nil
 		},
 	})
 
@@ -293,7 +556,7 @@ func addAddressLookup() {
 			{Field: "min", Display: "Min", Type: "float", Default: "0", Description: "Minimum range"},
 			{Field: "max", Display: "Max", Type: "float", Default: "90", Description: "Maximum range"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			min, err := info.GetFloat64(m, "min")
 			if err != nil {
 				return nil, err
@@ -304,7 +567,7 @@ func addAddressLookup() {
 				return nil, err
 			}
 
-			rangeOut, err := LatitudeInRange(min, max)
+			rangeOut, err := latitudeInRange(r, min, max)
 			if err != nil {
 				return nil, err
 			}
@@ -319,8 +582,8 @@ func addAddressLookup() {
 		Description: "Random longitude",
 		Example:     "-147.068112",
 		Output:      "float",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Longitude(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return longitude(r), nil
 		},
 	})
 
@@ -334,7 +597,7 @@ func addAddressLookup() {
 			{Field: "min", Display: "Min", Type: "float", Default: "0", Description: "Minimum range"},
 			{Field: "max", Display: "Max", Type: "float", Default: "180", Description: "Maximum range"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			min, err := info.GetFloat64(m, "min")
 			if err != nil {
 				return nil, err
@@ -345,7 +608,7 @@ func addAddressLookup() {
 				return nil, err
 			}
 
-			rangeOut, err := LongitudeInRange(min, max)
+			rangeOut, err := longitudeInRange(r, min, max)
 			if err != nil {
 				return nil, err
 			}
@@ -353,4 +616,82 @@ func addAddressLookup() {
 			return rangeOut, nil
 		},
 	})
+
+	AddFuncLookup("latlnginrange", Info{
+		Display:     "Latitude Longitude In Range",
+		Category:    "address",
+		Description: "Random latitude and longitude within a bounding box",
+		Example:     "[40.712776, -74.005974]",
+		Output:      "[]float64",
+		Params: []Param{
+			{Field: "minlat", Display: "Min Latitude", Type: "float", Default: "-90", Description: "Minimum latitude"},
+			{Field: "minlng", Display: "Min Longitude", Type: "float", Default: "-180", Description: "Minimum longitude"},
+			{Field: "maxlat", Display: "Max Latitude", Type: "float", Default: "90", Description: "Maximum latitude"},
+			{Field: "maxlng", Display: "Max Longitude", Type: "float", Default: "180", Description: "Maximum longitude, less than the minimum to cross the antimeridian"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			minLat, err := info.GetFloat64(m, "minlat")
+			if err != nil {
+				return nil, err
+			}
+
+			minLng, err := info.GetFloat64(m, "minlng")
+			if err != nil {
+				return nil, err
+			}
+
+			maxLat, err := info.GetFloat64(m, "maxlat")
+			if err != nil {
+				return nil, err
+			}
+
+			maxLng, err := info.GetFloat64(m, "maxlng")
+			if err != nil {
+				return nil, err
+			}
+
+			lat, lng, err := latLngInRange(r, minLat, minLng, maxLat, maxLng)
+			if err != nil {
+				return nil, err
+			}
+
+			return []float64{lat, lng}, nil
+		},
+	})
+
+	AddFuncLookup("latlngnear", Info{
+		Display:     "Latitude Longitude Near",
+		Category:    "address",
+		Description: "Random latitude and longitude within a radius of a point",
+		Example:     "[40.731112, -73.989185]",
+		Output:      "[]float64",
+		Params: []Param{
+			{Field: "lat", Display: "Latitude", Type: "float", Default: "0", Description: "Latitude of the center point"},
+			{Field: "lng", Display: "Longitude", Type: "float", Default: "0", Description: "Longitude of the center point"},
+			{Field: "radius", Display: "Radius", Type: "float", Default: "10", Description: "Radius in kilometers"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			lat, err := info.GetFloat64(m, "lat")
+			if err != nil {
+				return nil, err
+			}
+
+			lng, err := info.GetFloat64(m, "lng")
+			if err != nil {
+				return nil, err
+			}
+
+			radius, err := info.GetFloat64(m, "radius")
+			if err != nil {
+				return nil, err
+			}
+
+			lat, lng, err = latLngNear(r, lat, lng, radius)
+			if err != nil {
+				return nil, err
+			}
+
+			return []float64{lat, lng}, nil
+		},
+	})
 }
@@ -2,6 +2,9 @@ package gofakeit
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -32,6 +35,84 @@ func BenchmarkAddress(b *testing.B) {
 	}
 }
 
+func ExampleAddressConsistent() {
+	Seed(11)
+	address := AddressConsistent()
+	fmt.Println(address.Address)
+	fmt.Println(address.Street)
+	fmt.Println(address.City)
+	fmt.Println(address.State)
+	fmt.Println(address.Zip)
+	fmt.Println(address.Country)
+	fmt.Println(address.Latitude)
+	fmt.Println(address.Longitude)
+	// Output: 645 Rapids borough, Des Moines, Iowa 50348
+	// 645 Rapids borough
+	// Des Moines
+	// Iowa
+	// 50348
+	// United States of America
+	// 41.54701
+	// -93.646487
+}
+
+func TestAddressConsistent(t *testing.T) {
+	// First digit of zip codes by state, assigned by region
+	regions := map[string]byte{
+		"Connecticut": '0', "Maine": '0', "Massachusetts": '0', "New Hampshire": '0', "New Jersey": '0', "Rhode Island": '0', "Vermont": '0',
+		"Delaware": '1', "New York": '1', "Pennsylvania": '1',
+		"Maryland": '2', "North Carolina": '2', "South Carolina": '2', "Virginia": '2', "West Virginia": '2',
+		"Alabama": '3', "Florida": '3', "Georgia": '3', "Mississippi": '3', "Tennessee": '3',
+		"Indiana": '4', "Kentucky": '4', "Michigan": '4', "Ohio": '4',
+		"Iowa": '5', "Minnesota": '5', "Montana": '5', "North Dakota": '5', "South Dakota": '5', "Wisconsin": '5',
+		"Illinois": '6', "Kansas": '6', "Missouri": '6', "Nebraska": '6',
+		"Arkansas": '7', "Louisiana": '7', "Oklahoma": '7', "Texas": '7',
+		"Arizona": '8', "Colorado": '8', "Idaho": '8', "Nevada": '8', "New Mexico": '8', "Utah": '8', "Wyoming": '8',
+		"Alaska": '9', "California": '9', "Hawaii": '9', "Oregon": '9', "Washington": '9',
+	}
+
+	states := map[string]bool{}
+	for i := 0; i < 2000; i++ {
+		address := AddressConsistent()
+
+		region, ok := regions[address.State]
+		if !ok {
+			t.Fatalf("unknown state %s", address.State)
+		}
+		if len(address.Zip) != 5 || address.Zip[0] != region {
+			t.Fatalf("zip %s does not belong to %s", address.Zip, address.State)
+		}
+		if !strings.HasSuffix(address.Address, address.City+", "+address.State+" "+address.Zip) {
+			t.Fatalf("address %s does not match its parts", address.Address)
+		}
+		states[address.State] = true
+	}
+
+	if len(states) != len(regions) {
+		t.Errorf("expected all %d states to be generated, got %d", len(regions), len(states))
+	}
+}
+
+func TestAddressLookupConsistent(t *testing.T) {
+	info := GetFuncLookup("address")
+
+	m := map[string][]string{"consistent": {"true"}}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if country := value.(*AddressInfo).Country; country != "United States of America" {
+		t.Errorf("expected a united states address, got %s", country)
+	}
+}
+
+func BenchmarkAddressConsistent(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		AddressConsistent()
+	}
+}
+
 func ExampleStreet() {
 	Seed(11)
 	fmt.Println(Street())
@@ -146,6 +227,51 @@ func BenchmarkZip(b *testing.B) {
 	}
 }
 
+func ExamplePostalCode() {
+	Seed(11)
+	for _, country := range []string{"US", "GB", "CA", "NL", "JP"} {
+		code, _ := PostalCode(country)
+		fmt.Println(code)
+	}
+	// Output: 13645
+	// L48 9BS
+	// K6Y 0R3
+	// 8300 LH
+	// 591-4583
+}
+
+func TestPostalCode(t *testing.T) {
+	formats := map[string]*regexp.Regexp{
+		"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		"GB": regexp.MustCompile(`^[A-Z]{1,2}\d{1,2} \d[A-Z]{2}$`),
+		"CA": regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] \d[ABCEGHJ-NPRSTV-Z]\d$`),
+		"NL": regexp.MustCompile(`^[1-9]\d{3} [A-Z]{2}$`),
+		"JP": regexp.MustCompile(`^\d{3}-\d{4}$`),
+	}
+
+	for country, format := range formats {
+		for i := 0; i < 1000; i++ {
+			code, err := PostalCode(strings.ToLower(country))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !format.MatchString(code) {
+				t.Fatalf("%s postal code %s does not match %s", country, code, format)
+			}
+		}
+	}
+
+	if _, err := PostalCode("XX"); err == nil {
+		t.Error("Expected error for unsupported country code")
+	}
+}
+
+func BenchmarkPostalCode(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		PostalCode("GB")
+	}
+}
+
 func ExampleCountry() {
 	Seed(11)
 	fmt.Println(Country())
@@ -263,3 +389,103 @@ func BenchmarkLongitudeInRange(b *testing.B) {
 		LongitudeInRange(-180, 180)
 	}
 }
+
+func ExampleLatLngInRange() {
+	Seed(11)
+	lat, lng, _ := LatLngInRange(40.5, -74.25, 40.9, -73.7)
+	fmt.Println(lat, lng)
+	// Output: 40.53659 -73.807289
+}
+
+func ExampleLatLngNear() {
+	Seed(11)
+	lat, lng, _ := LatLngNear(40.712776, -74.005974, 5)
+	fmt.Println(lat, lng)
+	// Output: 40.717375 -74.02286
+}
+
+// haversineKm is the great circle distance between two points
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	rad := math.Pi / 180
+	dLat := (lat2 - lat1) * rad
+	dLng := (lng2 - lng1) * rad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+func TestLatLngInRange(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		lat, lng, err := LatLngInRange(40.5, -74.25, 40.9, -73.7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lat < 40.5 || lat > 40.9 || lng < -74.25 || lng > -73.7 {
+			t.Fatalf("expected point inside the box, got %f, %f", lat, lng)
+		}
+
+		// Box crossing the antimeridian
+		lat, lng, err = LatLngInRange(-20, 170, -10, -170)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lat < -20 || lat > -10 || (lng < 170 && lng > -170) {
+			t.Fatalf("expected point inside the wrapped box, got %f, %f", lat, lng)
+		}
+	}
+
+	for _, box := range [][4]float64{{10, 0, 5, 1}, {-91, 0, 0, 1}, {0, 0, 1, 181}, {0, 190, 1, 10}} {
+		if _, _, err := LatLngInRange(box[0], box[1], box[2], box[3]); err == nil {
+			t.Errorf("expected error for box %v", box)
+		}
+	}
+}
+
+func TestLatLngNear(t *testing.T) {
+	points := [][3]float64{
+		{40.712776, -74.005974, 5},
+		{-33.868820, 151.209290, 0.5},
+		{89.9, 0, 50},   // near the pole
+		{0, 179.95, 20}, // across the antimeridian
+		{51.5, -0.12, 1000},
+	}
+
+	for _, p := range points {
+		insideHalf := 0
+		for i := 0; i < 2000; i++ {
+			lat, lng, err := LatLngNear(p[0], p[1], p[2])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+				t.Fatalf("expected valid coordinates, got %f, %f", lat, lng)
+			}
+
+			// Rounding to 6 decimals can move a point up to about 0.15 meters
+			d := haversineKm(p[0], p[1], lat, lng)
+			if d > p[2]+0.0002 {
+				t.Fatalf("expected point within %f km of %f, %f, got %f km", p[2], p[0], p[1], d)
+			}
+			if d <= p[2]/math.Sqrt2 {
+				insideHalf++
+			}
+		}
+
+		// Points are spread over the area so half land within the inner half of the area
+		if insideHalf < 850 || insideHalf > 1150 {
+			t.Errorf("expected about half the points in the inner half of the area, got %d of 2000", insideHalf)
+		}
+	}
+
+	if _, _, err := LatLngNear(91, 0, 1); err == nil {
+		t.Error("expected error for invalid latitude")
+	}
+	if _, _, err := LatLngNear(0, 0, -1); err == nil {
+		t.Error("expected error for negative radius")
+	}
+}
+
+func BenchmarkLatLngNear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LatLngNear(40.712776, -74.005974, 5)
+	}
+}
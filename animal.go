@@ -1,33 +1,63 @@
 package gofakeit
 
 // PetName will return a random fun pet name
-func PetName() string {
-	return getRandValue([]string{"animal", "petname"})
+func PetName() string { return petName(globalFaker.ctx()) }
+
+// PetName will return a random fun pet name
+func (f *Faker) PetName() string { return petName(f.ctx()) }
+
+func petName(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "petname"})
 }
 
 // Animal will return a random animal
-func Animal() string {
-	return getRandValue([]string{"animal", "animal"})
+func Animal() string { return animal(globalFaker.ctx()) }
+
+// Animal will return a random animal
+func (f *Faker) Animal() string { return animal(f.ctx()) }
+
+func animal(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "animal"})
 }
 
 // AnimalType will return a random animal type
-func AnimalType() string {
-	return getRandValue([]string{"animal", "type"})
+func AnimalType() string { return animalType(globalFaker.ctx()) }
+
+// AnimalType will return a random animal type
+func (f *Faker) AnimalType() string { return animalType(f.ctx()) }
+
+func animalType(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "type"})
 }
 
 // FarmAnimal will return a random animal that usually lives on a farm
-func FarmAnimal() string {
-	return getRandValue([]string{"animal", "farm"})
+func FarmAnimal() string { return farmAnimal(globalFaker.ctx()) }
+
+// FarmAnimal will return a random animal that usually lives on a farm
+func (f *Faker) FarmAnimal() string { return farmAnimal(f.ctx()) }
+
+func farmAnimal(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "farm"})
 }
 
 // Cat will return a random cat breed
-func Cat() string {
-	return getRandValue([]string{"animal", "cat"})
+func Cat() string { return cat(globalFaker.ctx()) }
+
+// Cat will return a random cat breed
+func (f *Faker) Cat() string { return cat(f.ctx()) }
+
+func cat(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "cat"})
 }
 
 // Dog will return a random dog breed
-func Dog() string {
-	return getRandValue([]string{"animal", "dog"})
+func Dog() string { return dog(globalFaker.ctx()) }
+
+// Dog will return a random dog breed
+func (f *Faker) Dog() string { return dog(f.ctx()) }
+
+func dog(r fakerCtx) string {
+	return getRandValue(r, []string{"animal", "dog"})
 }
 
 func addAnimalLookup() {
@@ -37,8 +67,8 @@ func addAnimalLookup() {
 		Description: "Random pet name",
 		Example:     "Ozzy Pawsborne",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return PetName(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return petName(r), nil
 		},
 	})
 
@@ -48,8 +78,8 @@ func addAnimalLookup() {
 		Description: "Random animal",
 		Example:     "elk",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Animal(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return animal(r), nil
 		},
 	})
 
@@ -59,8 +89,8 @@ func addAnimalLookup() {
 		Description: "Random animal type",
 		Example:     "amphibians",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AnimalType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return animalType(r), nil
 		},
 	})
 
@@ -70,8 +100,8 @@ func addAnimalLookup() {
 		Description: "Random farm animal",
 		Example:     "Chicken",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return FarmAnimal(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return farmAnimal(r), nil
 		},
 	})
 
@@ -81,8 +111,8 @@ func addAnimalLookup() {
 		Description: "Random cat type",
 		Example:     "Chausie",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Cat(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return cat(r), nil
 		},
 	})
 
@@ -92,8 +122,8 @@ func addAnimalLookup() {
 		Description: "Random dog type",
 		Example:     "Norwich Terrier",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Dog(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return dog(r), nil
 		},
 	})
 }
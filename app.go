@@ -1,37 +1,214 @@
 package gofakeit
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
 // AppName will generate a random app name
-func AppName() string {
+func AppName() string { return appName(globalFaker.ctx()) }
+
+// AppName will generate a random app name
+func (f *Faker) AppName() string { return appName(f.ctx()) }
+
+func appName(r fakerCtx) string {
 	name := ""
-	switch Number(1, 3) {
+	switch number(r, 1, 3) {
 	case 1:
-		name = Noun() + Verb()
+		name = noun(r) + verb(r)
 	case 2:
-		name = Color() + Noun()
+		name = colorFunc(r) + noun(r)
 	case 3:
-		name = Animal() + Verb()
+		name = animal(r) + verb(r)
 	}
 
 	return strings.Title(name)
 }
 
 // AppVersion will generate a random app version
-func AppVersion() string {
-	return fmt.Sprintf("%d", Number(1, 5)) + "." + fmt.Sprintf("%d", Number(1, 20)) + "." + fmt.Sprintf("%d", Number(1, 20))
+func AppVersion() string { return appVersion(globalFaker.ctx()) }
+
+// AppVersion will generate a random app version
+func (f *Faker) AppVersion() string { return appVersion(f.ctx()) }
+
+func appVersion(r fakerCtx) string {
+	return fmt.Sprintf("%d", number(r, 1, 5)) + "." + fmt.Sprintf("%d", number(r, 1, 20)) + "." + fmt.Sprintf("%d", number(r, 1, 20))
+}
+
+// SemVer will generate a random semantic version, like 1.4.2,
+// sometimes with a pre release and build metadata
+func SemVer() string { return semVer(globalFaker.ctx()) }
+
+// SemVer will generate a random semantic version, like 1.4.2,
+// sometimes with a pre release and build metadata
+func (f *Faker) SemVer() string { return semVer(f.ctx()) }
+
+func semVer(r fakerCtx) string {
+	v := fmt.Sprintf("%d.%d.%d", number(r, 0, 9), number(r, 0, 20), number(r, 0, 20))
+
+	if r.Intn(5) == 0 {
+		v += "-" + randomString(r, []string{"alpha", "beta", "rc"})
+		if boolFunc(r) {
+			v += "." + strconv.Itoa(number(r, 1, 9))
+		}
+	}
+	if r.Intn(10) == 0 {
+		v += "+" + randomString(r, []string{"build." + strconv.Itoa(number(r, 1, 999)), replaceWithHexLetters(r, "???????")})
+	}
+
+	return v
+}
+
+// SemVerRange will generate a random semantic version that sorts between min and max, inclusive.
+// Versions are generated without pre release or build metadata unless max itself is a pre release
+func SemVerRange(min, max string) (string, error) { return semVerRange(globalFaker.ctx(), min, max) }
+
+// SemVerRange will generate a random semantic version that sorts between min and max, inclusive.
+// Versions are generated without pre release or build metadata unless max itself is a pre release
+func (f *Faker) SemVerRange(min, max string) (string, error) { return semVerRange(f.ctx(), min, max) }
+
+func semVerRange(r fakerCtx, min, max string) (string, error) {
+	minV, err := semVerParse(min)
+	if err != nil {
+		return "", err
+	}
+	maxV, err := semVerParse(max)
+	if err != nil {
+		return "", err
+	}
+	if semVerCompare(minV, maxV) > 0 {
+		return "", errors.New("Min version must be less than or equal to max version")
+	}
+
+	// Pick each part in turn, staying within the bounds only while
+	// the parts before it are equal to the bound
+	var core [3]int
+	atMin, atMax := true, true
+	for i := range core {
+		lo, hi := 0, 20
+		if atMin {
+			lo = minV.core[i]
+		}
+		if atMax {
+			hi = maxV.core[i]
+		} else {
+			hi = lo + 20
+		}
+
+		core[i] = randIntRange(r, lo, hi)
+		atMin = atMin && core[i] == minV.core[i]
+		atMax = atMax && core[i] == maxV.core[i]
+	}
+
+	// A release sorts after its pre releases, so landing on a pre release max
+	// can only give back max
+	if atMax && maxV.pre != nil {
+		return strings.SplitN(max, "+", 2)[0], nil
+	}
+
+	// A release also sorts after a pre release min of the same version
+	return fmt.Sprintf("%d.%d.%d", core[0], core[1], core[2]), nil
+}
+
+// semVerRegex is the pattern suggested by the semver spec
+var semVerRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+type semVerVersion struct {
+	core [3]int
+	pre  []string
+}
+
+func semVerParse(v string) (semVerVersion, error) {
+	m := semVerRegex.FindStringSubmatch(v)
+	if m == nil {
+		return semVerVersion{}, errors.New("Invalid semantic version " + v)
+	}
+
+	var sv semVerVersion
+	for i := range sv.core {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return semVerVersion{}, errors.New("Invalid semantic version " + v)
+		}
+		sv.core[i] = n
+	}
+	if m[4] != "" {
+		sv.pre = strings.Split(m[4], ".")
+	}
+
+	return sv, nil
+}
+
+// semVerCompare returns -1, 0 or 1 following semver precedence, build metadata is ignored
+func semVerCompare(a, b semVerVersion) int {
+	for i := range a.core {
+		if a.core[i] != b.core[i] {
+			if a.core[i] < b.core[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A version without a pre release has higher precedence
+	switch {
+	case a.pre == nil && b.pre == nil:
+		return 0
+	case a.pre == nil:
+		return 1
+	case b.pre == nil:
+		return -1
+	}
+
+	for i := 0; i < len(a.pre) && i < len(b.pre); i++ {
+		if a.pre[i] == b.pre[i] {
+			continue
+		}
+
+		an, aErr := strconv.Atoi(a.pre[i])
+		bn, bErr := strconv.Atoi(b.pre[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case aErr == nil:
+			// Numeric identifiers have lower precedence than alphanumeric ones
+			return -1
+		case bErr == nil:
+			return 1
+		case a.pre[i] < b.pre[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	switch {
+	case len(a.pre) < len(b.pre):
+		return -1
+	case len(a.pre) > len(b.pre):
+		return 1
+	}
+	return 0
 }
 
 // AppAuthor will generate a random company or person name
-func AppAuthor() string {
-	if Bool() {
-		return Name()
+func AppAuthor() string { return appAuthor(globalFaker.ctx()) }
+
+// AppAuthor will generate a random company or person name
+func (f *Faker) AppAuthor() string { return appAuthor(f.ctx()) }
+
+func appAuthor(r fakerCtx) string {
+	if boolFunc(r) {
+		return name(r)
 	}
 
-	return Company()
+	return company(r)
 }
 
 func addAppLookup() {
@@ -41,8 +218,8 @@ func addAppLookup() {
 		Description: "Random app name",
 		Example:     "Parkrespond",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AppName(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return appName(r), nil
 		},
 	})
 
@@ -52,8 +229,44 @@ func addAppLookup() {
 		Description: "Random app version",
 		Example:     "1.12.14",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AppVersion(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return appVersion(r), nil
+		},
+	})
+
+	AddFuncLookup("semver", Info{
+		Display:     "Semantic Version",
+		Category:    "app",
+		Description: "Random semantic version, sometimes with a pre release and build metadata",
+		Example:     "1.4.2-beta.3",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return semVer(r), nil
+		},
+	})
+
+	AddFuncLookup("semverrange", Info{
+		Display:     "Semantic Version Range",
+		Category:    "app",
+		Description: "Random semantic version between a min and max version",
+		Example:     "1.0.0 - 2.0.0 => 1.7.12",
+		Output:      "string",
+		Params: []Param{
+			{Field: "min", Display: "Min", Type: "string", Default: "0.0.0", Description: "Minimum version"},
+			{Field: "max", Display: "Max", Type: "string", Default: "9.20.20", Description: "Maximum version"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetString(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetString(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			return semVerRange(r, min, max)
 		},
 	})
 
@@ -63,8 +276,8 @@ func addAppLookup() {
 		Description: "Random app author",
 		Example:     "Qado Energy, Inc.",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AppAuthor(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return appAuthor(r), nil
 		},
 	})
 }
@@ -29,6 +29,115 @@ func BenchmarkAppVersion(b *testing.B) {
 	}
 }
 
+func ExampleSemVer() {
+	Seed(11)
+	fmt.Println(SemVer())
+	// Output: 0.14.8
+}
+
+func TestSemVer(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v := SemVer()
+		if !semVerRegex.MatchString(v) {
+			t.Fatalf("%s is not a valid semantic version", v)
+		}
+	}
+}
+
+func BenchmarkSemVer(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SemVer()
+	}
+}
+
+func ExampleSemVerRange() {
+	Seed(11)
+	value, err := SemVerRange("1.2.0", "1.4.0")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 1.2.14
+}
+
+func TestSemVerCompare(t *testing.T) {
+	// Precedence example from the semver spec, each version sorts before the next
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0"}
+	for i := 0; i < len(ordered); i++ {
+		for ii := 0; ii < len(ordered); ii++ {
+			a, _ := semVerParse(ordered[i])
+			b, _ := semVerParse(ordered[ii])
+
+			expected := 0
+			if i < ii {
+				expected = -1
+			} else if i > ii {
+				expected = 1
+			}
+			if got := semVerCompare(a, b); got != expected {
+				t.Errorf("expected compare %s to %s to be %d, got %d", ordered[i], ordered[ii], expected, got)
+			}
+		}
+	}
+
+	a, _ := semVerParse("1.0.0+build.1")
+	b, _ := semVerParse("1.0.0+build.2")
+	if semVerCompare(a, b) != 0 {
+		t.Error("expected build metadata to be ignored")
+	}
+}
+
+func TestSemVerRange(t *testing.T) {
+	for _, test := range []struct{ min, max string }{
+		{"0.0.0", "0.0.0"},
+		{"1.2.3", "1.2.9"},
+		{"1.9.5", "2.0.1"},
+		{"0.1.0", "10.0.0"},
+		{"1.0.0-alpha", "1.0.0"},
+		{"1.0.0-alpha", "1.0.0-beta"},
+		{"1.4.0", "2.0.0-rc.1"},
+		{"1.0.0+build.5", "1.0.1+build.1"},
+	} {
+		min, _ := semVerParse(test.min)
+		max, _ := semVerParse(test.max)
+
+		for i := 0; i < 500; i++ {
+			value, err := SemVerRange(test.min, test.max)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := semVerParse(value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if semVerCompare(v, min) < 0 || semVerCompare(v, max) > 0 {
+				t.Fatalf("%s is outside of %s to %s", value, test.min, test.max)
+			}
+		}
+	}
+}
+
+func TestSemVerRangeErrors(t *testing.T) {
+	for _, test := range []struct{ min, max string }{
+		{"2.0.0", "1.0.0"},
+		{"1.0.0", "1.0.0-rc.1"},
+		{"1.0", "2.0.0"},
+		{"1.0.0", "v2.0.0"},
+		{"01.0.0", "2.0.0"},
+	} {
+		if _, err := SemVerRange(test.min, test.max); err == nil {
+			t.Errorf("expected error for %s to %s", test.min, test.max)
+		}
+	}
+}
+
+func BenchmarkSemVerRange(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SemVerRange("1.0.0", "3.0.0")
+	}
+}
+
 func ExampleAppAuthor() {
 	Seed(11)
 	fmt.Println(AppAuthor())
@@ -0,0 +1,267 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+)
+
+// AvroOptions defines values needed for avro generation
+type AvroOptions struct {
+	Name     string  `json:"name" xml:"name"` // record name in the schema, defaults to Record
+	RowCount int     `json:"row_count" xml:"row_count"`
+	Fields   []Field `json:"fields" xml:"fields"`
+}
+
+var avroMagic = []byte("Obj\x01")
+
+// avroName is what avro allows for record and field names
+var avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type avroField struct {
+	Name string      `json:"name"`
+	Type interface{} `json:"type"`
+}
+
+type avroSchema struct {
+	Type   string      `json:"type"`
+	Name   string      `json:"name"`
+	Fields []avroField `json:"fields"`
+}
+
+// Avro generates records in an avro object container file along with the schema json of the records.
+// Field types are inferred from the functions output, integers become long, floats become double,
+// bools become boolean and everything else is stored as string. Fields with a null probability
+// are a union with null. Records are written uncompressed in blocks of up to 1000
+func Avro(ao *AvroOptions) ([]byte, []byte, error) { return avroFunc(globalFaker.ctx(), ao) }
+
+// Avro generates records in an avro object container file along with the schema json of the records.
+// Field types are inferred from the functions output, integers become long, floats become double,
+// bools become boolean and everything else is stored as string. Fields with a null probability
+// are a union with null. Records are written uncompressed in blocks of up to 1000
+func (f *Faker) Avro(ao *AvroOptions) ([]byte, []byte, error) { return avroFunc(f.ctx(), ao) }
+
+func avroFunc(r fakerCtx, ao *AvroOptions) ([]byte, []byte, error) {
+	name := ao.Name
+	if name == "" {
+		name = "Record"
+	}
+	if !avroName.MatchString(name) {
+		return nil, nil, errors.New("Invalid record name " + name + ", must start with a letter or _ followed by letters, numbers or _")
+	}
+
+	// Check fields
+	if ao.Fields == nil || len(ao.Fields) <= 0 {
+		return nil, nil, errors.New("Must pass fields in order to build avro records")
+	}
+
+	// Make sure you set a row count
+	if ao.RowCount <= 0 {
+		return nil, nil, errors.New("Must have row count")
+	}
+
+	// Set up the schema with the inferred types, stored the same as parquet columns
+	types := make([]int32, len(ao.Fields))
+	s := avroSchema{Type: "record", Name: name, Fields: make([]avroField, len(ao.Fields))}
+	for i, field := range ao.Fields {
+		if !avroName.MatchString(field.Name) {
+			return nil, nil, errors.New("Invalid field name " + field.Name + ", must start with a letter or _ followed by letters, numbers or _")
+		}
+
+		if field.Function == "autoincrement" {
+			types[i] = parquetInt64
+		} else if field.Function == "timeseries" {
+			types[i] = parquetByteArray
+		} else {
+			funcInfo := GetFuncLookup(field.Function)
+			if funcInfo == nil {
+				return nil, nil, errors.New("Invalid function, " + field.Function + " does not exist")
+			}
+			types[i] = parquetType(funcInfo.Output)
+		}
+
+		s.Fields[i] = avroField{Name: field.Name, Type: avroType(types[i])}
+		if field.NullProbability > 0 {
+			s.Fields[i].Type = []interface{}{"null", s.Fields[i].Type}
+		}
+	}
+
+	schema, err := json.Marshal(s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Header with the schema, codec and a sync marker that ends every block
+	b := &bytes.Buffer{}
+	b.Write(avroMagic)
+	avroLong(b, 2)
+	avroBytes(b, []byte("avro.schema"))
+	avroBytes(b, schema)
+	avroBytes(b, []byte("avro.codec"))
+	avroBytes(b, []byte("null"))
+	avroLong(b, 0)
+
+	sync := make([]byte, 16)
+	for i := range sync {
+		sync[i] = byte(r.Intn(256))
+	}
+	b.Write(sync)
+
+	block := &bytes.Buffer{}
+	count := 0
+	for i := 1; i <= ao.RowCount; i++ {
+		for ii, field := range ao.Fields {
+			nullable := field.NullProbability > 0
+			if fieldNull(r, &field) {
+				avroLong(block, 0) // null branch of the union
+				continue
+			}
+			if nullable {
+				avroLong(block, 1)
+			}
+
+			var value interface{}
+			if field.Function == "autoincrement" {
+				id, err := autoIncrement(&field, i)
+				if err != nil {
+					return nil, nil, err
+				}
+				value = int64(id)
+			} else if field.Function == "timeseries" {
+				value, err = timeSeries(r, &field, i)
+				if err != nil {
+					return nil, nil, err
+				}
+			} else {
+				funcInfo := GetFuncLookup(field.Function)
+				value, err = fieldCall(r, &field, funcInfo)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				value, err = parquetConvert(types[ii], value)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s field %s", field.Name, err)
+				}
+			}
+
+			avroValue(block, value)
+		}
+
+		count++
+		if count == csvFlushRows || i == ao.RowCount {
+			avroLong(b, int64(count))
+			avroLong(b, int64(block.Len()))
+			b.Write(block.Bytes())
+			b.Write(sync)
+			block.Reset()
+			count = 0
+		}
+	}
+
+	return b.Bytes(), schema, nil
+}
+
+// avroType maps a parquet physical type to its avro primitive type
+func avroType(typ int32) string {
+	switch typ {
+	case parquetInt64:
+		return "long"
+	case parquetDouble:
+		return "double"
+	case parquetBoolean:
+		return "boolean"
+	}
+
+	return "string"
+}
+
+// avroValue writes a value converted by parquetConvert with avro binary encoding
+func avroValue(b *bytes.Buffer, value interface{}) {
+	switch v := value.(type) {
+	case int64:
+		avroLong(b, v)
+	case float64:
+		binary.Write(b, binary.LittleEndian, math.Float64bits(v))
+	case bool:
+		if v {
+			b.WriteByte(1)
+		} else {
+			b.WriteByte(0)
+		}
+	case string:
+		avroBytes(b, []byte(v))
+	}
+}
+
+// avroLong writes a zig zag encoded variable length long
+func avroLong(b *bytes.Buffer, v int64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	b.Write(tmp[:n])
+}
+
+// avroBytes writes bytes or a string prefixed with its length
+func avroBytes(b *bytes.Buffer, v []byte) {
+	avroLong(b, int64(len(v)))
+	b.Write(v)
+}
+
+func addFileAvroLookup() {
+	AddFuncLookup("avro", Info{
+		Display:     "Avro",
+		Category:    "file",
+		Description: "Generates an avro object container file with a record per row",
+		Example:     "Obj...",
+		Output:      "[]byte",
+		Params: []Param{
+			{Field: "name", Display: "Name", Type: "string", Default: "Record", Description: "Name of the record in the schema"},
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing field name and function to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			ao := AvroOptions{}
+
+			name, err := info.GetString(m, "name")
+			if err != nil {
+				return nil, err
+			}
+			ao.Name = name
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			ao.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				ao.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &ao.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			data, _, err := avroFunc(r, &ao)
+			if err != nil {
+				return nil, err
+			}
+
+			return data, nil
+		},
+	})
+}
@@ -0,0 +1,274 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+)
+
+func ExampleAvro() {
+	Seed(11)
+
+	data, schema, err := Avro(&AvroOptions{
+		Name:     "User",
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(data[:3]))
+	fmt.Println(string(schema))
+
+	// Output: Obj
+	// {"type":"record","name":"User","fields":[{"name":"id","type":"long"},{"name":"first_name","type":"string"},{"name":"price","type":"double"}]}
+}
+
+func TestAvro(t *testing.T) {
+	data, schema, err := Avro(&AvroOptions{
+		RowCount: 2500,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+			{Name: "active", Function: "bool"},
+			{Name: "age", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"99"}}},
+			{Name: "email", Function: "email", NullProbability: 0.5},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileSchema, records, err := readAvroContainer(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(fileSchema, schema) {
+		t.Errorf("expected container schema to match returned schema, got %s and %s", fileSchema, schema)
+	}
+	if len(records) != 2500 {
+		t.Fatalf("expected 2500 records, got %d", len(records))
+	}
+
+	nulls := 0
+	for i, rec := range records {
+		if rec["id"].(int64) != int64(i+1) {
+			t.Fatalf("expected id %d, got %v", i+1, rec["id"])
+		}
+		if _, ok := rec["first_name"].(string); !ok {
+			t.Fatalf("expected first_name to be a string, got %T", rec["first_name"])
+		}
+		if _, ok := rec["price"].(float64); !ok {
+			t.Fatalf("expected price to be a double, got %T", rec["price"])
+		}
+		if _, ok := rec["active"].(bool); !ok {
+			t.Fatalf("expected active to be a boolean, got %T", rec["active"])
+		}
+		if age := rec["age"].(int64); age < 1 || age > 99 {
+			t.Fatalf("expected age between 1 and 99, got %d", age)
+		}
+		if rec["email"] == nil {
+			nulls++
+		} else if _, ok := rec["email"].(string); !ok {
+			t.Fatalf("expected email to be a string or null, got %T", rec["email"])
+		}
+	}
+	if nulls == 0 || nulls == len(records) {
+		t.Errorf("expected some null emails, got %d", nulls)
+	}
+}
+
+func TestAvroLookup(t *testing.T) {
+	info := GetFuncLookup("avro")
+	m := map[string][]string{
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+		},
+	}
+
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, records, err := readAvroContainer(value.([]byte))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 10 {
+		t.Errorf("expected 10 records, got %d", len(records))
+	}
+}
+
+func TestAvroErrors(t *testing.T) {
+	tests := []*AvroOptions{
+		{RowCount: 1},
+		{Fields: []Field{{Name: "x", Function: "firstname"}}},
+		{RowCount: 1, Fields: []Field{{Name: "x", Function: "notafunction"}}},
+		{RowCount: 1, Fields: []Field{{Name: "first-name", Function: "firstname"}}},
+		{RowCount: 1, Name: "1User", Fields: []Field{{Name: "x", Function: "firstname"}}},
+	}
+	for _, ao := range tests {
+		if _, _, err := Avro(ao); err == nil {
+			t.Errorf("expected error for %+v", ao)
+		}
+	}
+}
+
+func BenchmarkAvro(b *testing.B) {
+	ao := &AvroOptions{
+		RowCount: 100,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+		},
+	}
+	for i := 0; i < b.N; i++ {
+		Avro(ao)
+	}
+}
+
+// readAvroContainer reads an uncompressed avro object container file
+// of primitive and nullable primitive fields into maps of field name to value
+func readAvroContainer(data []byte) ([]byte, []map[string]interface{}, error) {
+	r := bytes.NewReader(data)
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, avroMagic) {
+		return nil, nil, errors.New("missing avro magic")
+	}
+
+	meta := map[string][]byte{}
+	for {
+		n, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n == 0 {
+			break
+		}
+		for i := int64(0); i < n; i++ {
+			k, err := readAvroBytes(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			v, err := readAvroBytes(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			meta[string(k)] = v
+		}
+	}
+	if string(meta["avro.codec"]) != "null" {
+		return nil, nil, errors.New("expected null codec")
+	}
+
+	var schema struct {
+		Fields []struct {
+			Name string          `json:"name"`
+			Type json.RawMessage `json:"type"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(meta["avro.schema"], &schema); err != nil {
+		return nil, nil, err
+	}
+
+	sync := make([]byte, 16)
+	if _, err := io.ReadFull(r, sync); err != nil {
+		return nil, nil, err
+	}
+
+	records := []map[string]interface{}{}
+	for r.Len() > 0 {
+		count, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		size, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		start := r.Len()
+
+		for i := int64(0); i < count; i++ {
+			rec := map[string]interface{}{}
+			for _, f := range schema.Fields {
+				typ := ""
+				if err := json.Unmarshal(f.Type, &typ); err != nil {
+					// Nullable union of ["null", type]
+					var union []string
+					if err := json.Unmarshal(f.Type, &union); err != nil || len(union) != 2 {
+						return nil, nil, errors.New("unsupported type " + string(f.Type))
+					}
+					branch, err := binary.ReadVarint(r)
+					if err != nil {
+						return nil, nil, err
+					}
+					if branch == 0 {
+						rec[f.Name] = nil
+						continue
+					}
+					typ = union[1]
+				}
+
+				v, err := readAvroValue(r, typ)
+				if err != nil {
+					return nil, nil, err
+				}
+				rec[f.Name] = v
+			}
+			records = append(records, rec)
+		}
+
+		if int64(start-r.Len()) != size {
+			return nil, nil, fmt.Errorf("expected block of %d bytes, read %d", size, start-r.Len())
+		}
+		marker := make([]byte, 16)
+		if _, err := io.ReadFull(r, marker); err != nil || !bytes.Equal(marker, sync) {
+			return nil, nil, errors.New("invalid sync marker")
+		}
+	}
+
+	return meta["avro.schema"], records, nil
+}
+
+func readAvroBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+func readAvroValue(r *bytes.Reader, typ string) (interface{}, error) {
+	switch typ {
+	case "long":
+		return binary.ReadVarint(r)
+	case "double":
+		var bits uint64
+		err := binary.Read(r, binary.LittleEndian, &bits)
+		return math.Float64frombits(bits), err
+	case "boolean":
+		b, err := r.ReadByte()
+		return b == 1, err
+	case "string":
+		b, err := readAvroBytes(r)
+		return string(b), err
+	}
+	return nil, errors.New("unsupported type " + typ)
+}
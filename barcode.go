@@ -0,0 +1,142 @@
+package gofakeit
+
+// EAN13 will generate a random 13 digit european article number with a valid GS1 check digit
+func EAN13() string { return ean13(globalFaker.ctx()) }
+
+// EAN13 will generate a random 13 digit european article number with a valid GS1 check digit
+func (f *Faker) EAN13() string { return ean13(f.ctx()) }
+
+func ean13(r fakerCtx) string {
+	return gs1Number(r, 13)
+}
+
+// UPCA will generate a random 12 digit universal product code with a valid GS1 check digit
+func UPCA() string { return upca(globalFaker.ctx()) }
+
+// UPCA will generate a random 12 digit universal product code with a valid GS1 check digit
+func (f *Faker) UPCA() string { return upca(f.ctx()) }
+
+func upca(r fakerCtx) string {
+	return gs1Number(r, 12)
+}
+
+// ISBN10 will generate a random 10 character international standard book number with a valid
+// modulo 11 check digit, which is X when it would be 10
+func ISBN10() string { return isbn10(globalFaker.ctx()) }
+
+// ISBN10 will generate a random 10 character international standard book number with a valid
+// modulo 11 check digit, which is X when it would be 10
+func (f *Faker) ISBN10() string { return isbn10(f.ctx()) }
+
+func isbn10(r fakerCtx) string {
+	b := make([]byte, 9, 10)
+	for i := range b {
+		b[i] = byte(randDigit(r))
+	}
+
+	return string(append(b, isbn10CheckDigit(string(b))))
+}
+
+// isbn10CheckDigit returns the check digit for the first 9 digits of an isbn 10, digits are
+// weighted 10 down to 2 and the check digit makes the sum a multiple of 11
+func isbn10CheckDigit(s string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * (10 - i)
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+
+	return byte('0' + check)
+}
+
+// ISBN13 will generate a random 13 digit international standard book number with a 978 or 979
+// prefix and a valid GS1 check digit
+func ISBN13() string { return isbn13(globalFaker.ctx()) }
+
+// ISBN13 will generate a random 13 digit international standard book number with a 978 or 979
+// prefix and a valid GS1 check digit
+func (f *Faker) ISBN13() string { return isbn13(f.ctx()) }
+
+func isbn13(r fakerCtx) string {
+	b := []byte(randomString(r, []string{"978", "979"}))
+	for i := 0; i < 9; i++ {
+		b = append(b, byte(randDigit(r)))
+	}
+
+	return string(append(b, gs1CheckDigit(string(b))))
+}
+
+// gs1Number generates random digits finished with the GS1 check digit for a total of length digits
+func gs1Number(r fakerCtx, length int) string {
+	b := make([]byte, length-1, length)
+	for i := range b {
+		b[i] = byte(randDigit(r))
+	}
+
+	return string(append(b, gs1CheckDigit(string(b))))
+}
+
+// gs1CheckDigit returns the modulo 10 check digit for s, digits are weighted
+// 3 and 1 alternating from the rightmost digit
+func gs1CheckDigit(s string) byte {
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-1-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func addBarcodeLookup() {
+	AddFuncLookup("ean13", Info{
+		Display:     "EAN-13",
+		Category:    "barcode",
+		Description: "Random 13 digit european article number with a valid check digit",
+		Example:     "4006381333931",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return ean13(r), nil
+		},
+	})
+
+	AddFuncLookup("upca", Info{
+		Display:     "UPC-A",
+		Category:    "barcode",
+		Description: "Random 12 digit universal product code with a valid check digit",
+		Example:     "036000291452",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return upca(r), nil
+		},
+	})
+
+	AddFuncLookup("isbn10", Info{
+		Display:     "ISBN-10",
+		Category:    "barcode",
+		Description: "Random 10 character international standard book number with a valid check digit",
+		Example:     "0306406152",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return isbn10(r), nil
+		},
+	})
+
+	AddFuncLookup("isbn13", Info{
+		Display:     "ISBN-13",
+		Category:    "barcode",
+		Description: "Random 13 digit international standard book number with a valid check digit",
+		Example:     "9780306406157",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return isbn13(r), nil
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package gofakeit
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleEAN13() {
+	Seed(11)
+	fmt.Println(EAN13())
+	// Output: 0136459948997
+}
+
+func ExampleUPCA() {
+	Seed(11)
+	fmt.Println(UPCA())
+	// Output: 013645994894
+}
+
+func ExampleISBN10() {
+	Seed(11)
+	fmt.Println(ISBN10())
+	// Output: 0136459943
+}
+
+func ExampleISBN13() {
+	Seed(11)
+	fmt.Println(ISBN13())
+	// Output: 9781364599485
+}
+
+// barcodeTestValid recomputes the GS1 checksum, the weighted sum including the check digit is a multiple of 10
+func barcodeTestValid(code string) bool {
+	sum := 0
+	for i, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+		weight := 1
+		if (len(code)-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(c-'0') * weight
+	}
+	return sum%10 == 0
+}
+
+func TestBarcodeCheckDigit(t *testing.T) {
+	for _, code := range []string{"4006381333931", "036000291452", "9780306406157"} {
+		if !barcodeTestValid(code) {
+			t.Errorf("expected known code %s to validate", code)
+		}
+		if gs1CheckDigit(code[:len(code)-1]) != code[len(code)-1] {
+			t.Errorf("expected check digit of %s to be %c", code, code[len(code)-1])
+		}
+	}
+}
+
+func TestEAN13(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		code := EAN13()
+		if len(code) != 13 || !barcodeTestValid(code) {
+			t.Fatalf("expected valid 13 digit ean, got %s", code)
+		}
+	}
+}
+
+func TestUPCA(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		code := UPCA()
+		if len(code) != 12 || !barcodeTestValid(code) {
+			t.Fatalf("expected valid 12 digit upc, got %s", code)
+		}
+
+		// A upc is an ean with a leading zero
+		if !barcodeTestValid("0" + code) {
+			t.Fatalf("expected upc %s to be a valid ean with a leading zero", code)
+		}
+	}
+}
+
+// isbn10TestValid recomputes the ISBN-10 checksum, the digits weighted 10 down to 1 sum to a multiple of 11
+func isbn10TestValid(code string) bool {
+	if len(code) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i, c := range code {
+		d := int(c - '0')
+		if i == 9 && c == 'X' {
+			d = 10
+		} else if c < '0' || c > '9' {
+			return false
+		}
+		sum += d * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+func TestISBN10(t *testing.T) {
+	for _, code := range []string{"0306406152", "080442957X"} {
+		if !isbn10TestValid(code) || isbn10CheckDigit(code[:9]) != code[9] {
+			t.Errorf("expected known isbn %s to validate", code)
+		}
+	}
+
+	x := false
+	for i := 0; i < 10000; i++ {
+		code := ISBN10()
+		if !isbn10TestValid(code) {
+			t.Fatalf("expected valid isbn 10, got %s", code)
+		}
+		x = x || code[9] == 'X'
+	}
+	if !x {
+		t.Error("expected some isbn 10 check digits to be X")
+	}
+}
+
+func TestISBN13(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		code := ISBN13()
+		if len(code) != 13 || !barcodeTestValid(code) {
+			t.Fatalf("expected valid 13 digit isbn, got %s", code)
+		}
+		if code[:3] != "978" && code[:3] != "979" {
+			t.Fatalf("expected isbn %s to start with 978 or 979", code)
+		}
+	}
+}
+
+func BenchmarkISBN10(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ISBN10()
+	}
+}
+
+func BenchmarkISBN13(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ISBN13()
+	}
+}
+
+func BenchmarkEAN13(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		EAN13()
+	}
+}
+
+func BenchmarkUPCA(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		UPCA()
+	}
+}
@@ -3,43 +3,83 @@ package gofakeit
 import "strconv"
 
 // BeerName will return a random beer name
-func BeerName() string {
-	return getRandValue([]string{"beer", "name"})
+func BeerName() string { return beerName(globalFaker.ctx()) }
+
+// BeerName will return a random beer name
+func (f *Faker) BeerName() string { return beerName(f.ctx()) }
+
+func beerName(r fakerCtx) string {
+	return getRandValue(r, []string{"beer", "name"})
 }
 
 // BeerStyle will return a random beer style
-func BeerStyle() string {
-	return getRandValue([]string{"beer", "style"})
+func BeerStyle() string { return beerStyle(globalFaker.ctx()) }
+
+// BeerStyle will return a random beer style
+func (f *Faker) BeerStyle() string { return beerStyle(f.ctx()) }
+
+func beerStyle(r fakerCtx) string {
+	return getRandValue(r, []string{"beer", "style"})
 }
 
 // BeerHop will return a random beer hop
-func BeerHop() string {
-	return getRandValue([]string{"beer", "hop"})
+func BeerHop() string { return beerHop(globalFaker.ctx()) }
+
+// BeerHop will return a random beer hop
+func (f *Faker) BeerHop() string { return beerHop(f.ctx()) }
+
+func beerHop(r fakerCtx) string {
+	return getRandValue(r, []string{"beer", "hop"})
 }
 
 // BeerYeast will return a random beer yeast
-func BeerYeast() string {
-	return getRandValue([]string{"beer", "yeast"})
+func BeerYeast() string { return beerYeast(globalFaker.ctx()) }
+
+// BeerYeast will return a random beer yeast
+func (f *Faker) BeerYeast() string { return beerYeast(f.ctx()) }
+
+func beerYeast(r fakerCtx) string {
+	return getRandValue(r, []string{"beer", "yeast"})
 }
 
 // BeerMalt will return a random beer malt
-func BeerMalt() string {
-	return getRandValue([]string{"beer", "malt"})
+func BeerMalt() string { return beerMalt(globalFaker.ctx()) }
+
+// BeerMalt will return a random beer malt
+func (f *Faker) BeerMalt() string { return beerMalt(f.ctx()) }
+
+func beerMalt(r fakerCtx) string {
+	return getRandValue(r, []string{"beer", "malt"})
 }
 
 // BeerAlcohol will return a random beer alcohol level between 2.0 and 10.0
-func BeerAlcohol() string {
-	return strconv.FormatFloat(randFloat64Range(2.0, 10.0), 'f', 1, 64) + "%"
+func BeerAlcohol() string { return beerAlcohol(globalFaker.ctx()) }
+
+// BeerAlcohol will return a random beer alcohol level between 2.0 and 10.0
+func (f *Faker) BeerAlcohol() string { return beerAlcohol(f.ctx()) }
+
+func beerAlcohol(r fakerCtx) string {
+	return strconv.FormatFloat(randFloat64Range(r, 2.0, 10.0), 'f', 1, 64) + "%"
 }
 
 // BeerIbu will return a random beer ibu value between 10 and 100
-func BeerIbu() string {
-	return strconv.Itoa(randIntRange(10, 100)) + " IBU"
+func BeerIbu() string { return beerIbu(globalFaker.ctx()) }
+
+// BeerIbu will return a random beer ibu value between 10 and 100
+func (f *Faker) BeerIbu() string { return beerIbu(f.ctx()) }
+
+func beerIbu(r fakerCtx) string {
+	return strconv.Itoa(randIntRange(r, 10, 100)) + " IBU"
 }
 
 // BeerBlg will return a random beer blg between 5.0 and 20.0
-func BeerBlg() string {
-	return strconv.FormatFloat(randFloat64Range(5.0, 20.0), 'f', 1, 64) + "°Blg"
+func BeerBlg() string { return beerBlg(globalFaker.ctx()) }
+
+// BeerBlg will return a random beer blg between 5.0 and 20.0
+func (f *Faker) BeerBlg() string { return beerBlg(f.ctx()) }
+
+func beerBlg(r fakerCtx) string {
+	return strconv.FormatFloat(randFloat64Range(r, 5.0, 20.0), 'f', 1, 64) + "°Blg"
 }
 
 func addBeerLookup() {
@@ -49,8 +89,8 @@ func addBeerLookup() {
 		Description: "Random beer name",
 		Example:     "Duvel",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerName(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerName(r), nil
 		},
 	})
 
@@ -60,8 +100,8 @@ func addBeerLookup() {
 		Description: "Random beer style",
 		Example:     "European Amber Lager",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerStyle(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerStyle(r), nil
 		},
 	})
 
@@ -71,8 +111,8 @@ func addBeerLookup() {
 		Description: "Random beer hop type",
 		Example:     "Glacier",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerHop(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerHop(r), nil
 		},
 	})
 
@@ -82,8 +122,8 @@ func addBeerLookup() {
 		Description: "Random beer yeast value",
 		Example:     "1388 - Belgian Strong Ale",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerYeast(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerYeast(r), nil
 		},
 	})
 
@@ -93,8 +133,8 @@ func addBeerLookup() {
 		Description: "Random beer malt",
 		Example:     "Munich",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerMalt(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerMalt(r), nil
 		},
 	})
 
@@ -104,8 +144,8 @@ func addBeerLookup() {
 		Description: "Random alcohol percentage",
 		Example:     "2.7%",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerAlcohol(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerAlcohol(r), nil
 		},
 	})
 
@@ -115,8 +155,8 @@ func addBeerLookup() {
 		Description: "Random beer ibu",
 		Example:     "29 IBU",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerIbu(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerIbu(r), nil
 		},
 	})
 
@@ -126,8 +166,8 @@ func addBeerLookup() {
 		Description: "Random beer blg",
 		Example:     "6.4°Blg",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BeerBlg(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return beerBlg(r), nil
 		},
 	})
 }
@@ -11,41 +11,137 @@ type CarInfo struct {
 }
 
 // Car will generate a struct with car information
-func Car() *CarInfo {
+func Car() *CarInfo { return car(globalFaker.ctx()) }
+
+// Car will generate a struct with car information
+func (f *Faker) Car() *CarInfo { return car(f.ctx()) }
+
+func car(r fakerCtx) *CarInfo {
 	return &CarInfo{
-		Type:         CarType(),
-		Fuel:         CarFuelType(),
-		Transmission: CarTransmissionType(),
-		Brand:        CarMaker(),
-		Model:        CarModel(),
-		Year:         Year(),
+		Type:         carType(r),
+		Fuel:         carFuelType(r),
+		Transmission: carTransmissionType(r),
+		Brand:        carMaker(r),
+		Model:        carModel(r),
+		Year:         year(r),
 	}
 
 }
 
 // CarType will generate a random car type string
-func CarType() string {
-	return getRandValue([]string{"car", "type"})
+func CarType() string { return carType(globalFaker.ctx()) }
+
+// CarType will generate a random car type string
+func (f *Faker) CarType() string { return carType(f.ctx()) }
+
+func carType(r fakerCtx) string {
+	return getRandValue(r, []string{"car", "type"})
 }
 
 // CarFuelType will return a random fuel type
-func CarFuelType() string {
-	return getRandValue([]string{"car", "fuel_type"})
+func CarFuelType() string { return carFuelType(globalFaker.ctx()) }
+
+// CarFuelType will return a random fuel type
+func (f *Faker) CarFuelType() string { return carFuelType(f.ctx()) }
+
+func carFuelType(r fakerCtx) string {
+	return getRandValue(r, []string{"car", "fuel_type"})
 }
 
 // CarTransmissionType will return a random transmission type
-func CarTransmissionType() string {
-	return getRandValue([]string{"car", "transmission_type"})
+func CarTransmissionType() string { return carTransmissionType(globalFaker.ctx()) }
+
+// CarTransmissionType will return a random transmission type
+func (f *Faker) CarTransmissionType() string { return carTransmissionType(f.ctx()) }
+
+func carTransmissionType(r fakerCtx) string {
+	return getRandValue(r, []string{"car", "transmission_type"})
 }
 
 // CarMaker will return a random car maker
-func CarMaker() string {
-	return getRandValue([]string{"car", "maker"})
+func CarMaker() string { return carMaker(globalFaker.ctx()) }
+
+// CarMaker will return a random car maker
+func (f *Faker) CarMaker() string { return carMaker(f.ctx()) }
+
+func carMaker(r fakerCtx) string {
+	return getRandValue(r, []string{"car", "maker"})
 }
 
 // CarModel will return a random car model
-func CarModel() string {
-	return getRandValue([]string{"car", "model"})
+func CarModel() string { return carModel(globalFaker.ctx()) }
+
+// CarModel will return a random car model
+func (f *Faker) CarModel() string { return carModel(f.ctx()) }
+
+func carModel(r fakerCtx) string {
+	return getRandValue(r, []string{"car", "model"})
+}
+
+// vinChars are the characters allowed in a vin, I, O and Q are left out to not be confused with 1 and 0
+const vinChars = "ABCDEFGHJKLMNPRSTUVWXYZ0123456789"
+
+// vinYearChars are the characters used for the model year in position 10
+const vinYearChars = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// vinWeights are the weights of each position used to compute the check digit
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// VIN will generate a random 17 character vehicle identification number with a valid check digit
+func VIN() string { return vin(globalFaker.ctx()) }
+
+// VIN will generate a random 17 character vehicle identification number with a valid check digit
+func (f *Faker) VIN() string { return vin(f.ctx()) }
+
+func vin(r fakerCtx) string {
+	b := make([]byte, 17)
+	for i := 0; i < 11; i++ {
+		b[i] = vinChars[r.Intn(len(vinChars))]
+	}
+	b[9] = vinYearChars[r.Intn(len(vinYearChars))]
+
+	// Serial number is numeric
+	for i := 11; i < 17; i++ {
+		b[i] = byte(randDigit(r))
+	}
+
+	b[8] = vinCheckDigit(b)
+	return string(b)
+}
+
+// vinCheckDigit computes the 9th position check digit of a vin per the NHTSA algorithm
+func vinCheckDigit(b []byte) byte {
+	sum := 0
+	for i, c := range b {
+		sum += vinValue(c) * vinWeights[i]
+	}
+
+	check := sum % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// vinValue transliterates a vin character to its numeric value
+func vinValue(c byte) int {
+	if c >= '0' && c <= '9' {
+		return int(c - '0')
+	}
+
+	switch {
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1
+	case c == 'P':
+		return 7
+	case c == 'R':
+		return 9
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2
+	}
+	return 0
 }
 
 func addCarLookup() {
@@ -55,8 +151,8 @@ func addCarLookup() {
 		Description: "Random car set of data",
 		Output:      "map[string]interface",
 		Example:     `{type: "Passenger car mini", fuel: "Gasoline", transmission: "Automatic", brand: "Fiat", model: "Freestyle Fwd", year: "1972"}`,
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Car(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return car(r), nil
 		},
 	})
 
@@ -66,8 +162,8 @@ func addCarLookup() {
 		Description: "Random car type",
 		Example:     "Passenger car mini",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CarType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return carType(r), nil
 		},
 	})
 
@@ -77,8 +173,8 @@ func addCarLookup() {
 		Description: "Random car fuel type",
 		Example:     "CNG",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CarFuelType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return carFuelType(r), nil
 		},
 	})
 
@@ -88,8 +184,8 @@ func addCarLookup() {
 		Description: "Random car transmission type",
 		Example:     "Manual",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CarTransmissionType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return carTransmissionType(r), nil
 		},
 	})
 
@@ -99,8 +195,8 @@ func addCarLookup() {
 		Description: "Random car maker",
 		Example:     "Nissan",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CarMaker(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return carMaker(r), nil
 		},
 	})
 
@@ -110,8 +206,19 @@ func addCarLookup() {
 		Description: "Random car model",
 		Example:     "Aveo",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CarModel(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return carModel(r), nil
+		},
+	})
+
+	AddFuncLookup("vin", Info{
+		Display:     "VIN",
+		Category:    "car",
+		Description: "Random 17 character vehicle identification number with a valid check digit",
+		Example:     "1HGCM82633A004352",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return vin(r), nil
 		},
 	})
 }
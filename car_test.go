@@ -2,6 +2,7 @@ package gofakeit
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -88,3 +89,54 @@ func BenchmarkCarModel(b *testing.B) {
 		CarModel()
 	}
 }
+
+func ExampleVIN() {
+	Seed(11)
+	fmt.Println(VIN())
+	// Output: AUXHU5Z63X0536906
+}
+
+func TestVIN(t *testing.T) {
+	// Letter values from the NHTSA transliteration table
+	values := map[rune]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+		'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+		'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
+	weights := []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	valid := func(v string) bool {
+		sum := 0
+		for i, c := range v {
+			if c >= '0' && c <= '9' {
+				sum += int(c-'0') * weights[i]
+			} else {
+				sum += values[c] * weights[i]
+			}
+		}
+		check := "0123456789X"[sum%11]
+		return v[8] == check
+	}
+
+	if !valid("1HGCM82633A004352") || vinCheckDigit([]byte("1HGCM82633A004352")) != '3' {
+		t.Fatal("expected known vin to validate")
+	}
+
+	for i := 0; i < 10000; i++ {
+		v := VIN()
+		if len(v) != 17 {
+			t.Fatalf("expected 17 characters, got %s", v)
+		}
+		if strings.ContainsAny(v, "IOQ") {
+			t.Fatalf("expected no I, O or Q, got %s", v)
+		}
+		if !valid(v) {
+			t.Fatalf("expected valid check digit, got %s", v)
+		}
+	}
+}
+
+func BenchmarkVIN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		VIN()
+	}
+}
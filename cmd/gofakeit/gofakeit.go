@@ -2,9 +2,11 @@ package main
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v5"
 )
@@ -12,7 +14,7 @@ import (
 var noFuncRunMsg = "Could not find function to run\nRun gofakeit help or gofakeit list for available functions"
 
 func main() {
-	gofakeit.Seed(0)
+	faker := gofakeit.New(rand.NewSource(time.Now().UnixNano()))
 
 	args := os.Args[1:]
 	argsLen := len(args)
@@ -83,7 +85,7 @@ func main() {
 		}
 	}
 
-	value, err := info.Call(&params, info)
+	value, err := faker.CallLookup(info, &params)
 	if err != nil {
 		fmt.Println(err.Error())
 		return
@@ -6,9 +6,12 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"math/rand"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v5"
 )
@@ -81,7 +84,9 @@ func lookupGet(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Call method to generate requested data
-	data, err := info.Call(&mapString, info)
+	// Each request gets its own faker as a rand source is not safe for concurrent use
+	faker := gofakeit.New(rand.NewSource(time.Now().UnixNano()))
+	data, err := faker.CallLookup(info, &mapString)
 	if err != nil {
 		badrequest(w, err.Error())
 		return
@@ -112,7 +117,8 @@ func lookupPost(w http.ResponseWriter, r *http.Request) {
 		mapString = make(map[string][]string)
 		mapInterface := map[string]interface{}{}
 		err = json.NewDecoder(r.Body).Decode(&mapInterface)
-		if err != nil {
+		// An empty body is fine, params will fall back to their defaults
+		if err != nil && err != io.EOF {
 			badrequest(w, "Could not parse post body. Expects key(string) - value or []value")
 			return
 		}
@@ -145,7 +151,9 @@ func lookupPost(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Call method to generate requested data
-	data, err := info.Call(&mapString, info)
+	// Each request gets its own faker as a rand source is not safe for concurrent use
+	faker := gofakeit.New(rand.NewSource(time.Now().UnixNano()))
+	data, err := faker.CallLookup(info, &mapString)
 	if err != nil {
 		badrequest(w, err.Error())
 		return
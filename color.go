@@ -1,33 +1,160 @@
 package gofakeit
 
 import (
-	"math/rand"
+	"errors"
+	"fmt"
+	"math"
 )
 
 // Color will generate a random color string
-func Color() string {
-	return getRandValue([]string{"color", "full"})
+func Color() string { return colorFunc(globalFaker.ctx()) }
+
+// Color will generate a random color string
+func (f *Faker) Color() string { return colorFunc(f.ctx()) }
+
+func colorFunc(r fakerCtx) string {
+	return getRandValue(r, []string{"color", "full"})
 }
 
 // SafeColor will generate a random safe color string
-func SafeColor() string {
-	return getRandValue([]string{"color", "safe"})
+func SafeColor() string { return safeColor(globalFaker.ctx()) }
+
+// SafeColor will generate a random safe color string
+func (f *Faker) SafeColor() string { return safeColor(f.ctx()) }
+
+func safeColor(r fakerCtx) string {
+	return getRandValue(r, []string{"color", "safe"})
 }
 
 // HexColor will generate a random hexadecimal color string
-func HexColor() string {
+func HexColor() string { return hexColor(globalFaker.ctx()) }
+
+// HexColor will generate a random hexadecimal color string
+func (f *Faker) HexColor() string { return hexColor(f.ctx()) }
+
+func hexColor(r fakerCtx) string {
 	color := make([]byte, 6)
 	hashQuestion := []byte("?#")
 	for i := 0; i < 6; i++ {
-		color[i] = hashQuestion[rand.Intn(2)]
+		color[i] = hashQuestion[r.Intn(2)]
 	}
 
-	return "#" + replaceWithHexLetters(replaceWithNumbers(string(color)))
+	return "#" + replaceWithHexLetters(r, replaceWithNumbers(r, string(color)))
 }
 
 // RGBColor will generate a random int slice color
-func RGBColor() []int {
-	return []int{randIntRange(0, 255), randIntRange(0, 255), randIntRange(0, 255)}
+func RGBColor() []int { return rgbColor(globalFaker.ctx()) }
+
+// RGBColor will generate a random int slice color
+func (f *Faker) RGBColor() []int { return rgbColor(f.ctx()) }
+
+func rgbColor(r fakerCtx) []int {
+	return []int{randIntRange(r, 0, 255), randIntRange(r, 0, 255), randIntRange(r, 0, 255)}
+}
+
+// HexColorShort will generate a random 3 digit hexadecimal color string
+func HexColorShort() string { return hexColorShort(globalFaker.ctx()) }
+
+// HexColorShort will generate a random 3 digit hexadecimal color string
+func (f *Faker) HexColorShort() string { return hexColorShort(f.ctx()) }
+
+func hexColorShort(r fakerCtx) string {
+	color := make([]byte, 3)
+	hashQuestion := []byte("?#")
+	for i := 0; i < 3; i++ {
+		color[i] = hashQuestion[r.Intn(2)]
+	}
+
+	return "#" + replaceWithHexLetters(r, replaceWithNumbers(r, string(color)))
+}
+
+// HSLColor will generate a random hue from 0 to 359 with saturation and lightness percentages from 0 to 100
+func HSLColor() (int, int, int) { return hslColor(globalFaker.ctx()) }
+
+// HSLColor will generate a random hue from 0 to 359 with saturation and lightness percentages from 0 to 100
+func (f *Faker) HSLColor() (int, int, int) { return hslColor(f.ctx()) }
+
+func hslColor(r fakerCtx) (int, int, int) {
+	return randIntRange(r, 0, 359), randIntRange(r, 0, 100), randIntRange(r, 0, 100)
+}
+
+// ColorPalette will generate n harmonious hex colors, hues evenly spaced around the color
+// wheel from a random start that share the same saturation and lightness
+func ColorPalette(n int) []string { return colorPalette(globalFaker.ctx(), n) }
+
+// ColorPalette will generate n harmonious hex colors, hues evenly spaced around the color
+// wheel from a random start that share the same saturation and lightness
+func (f *Faker) ColorPalette(n int) []string { return colorPalette(f.ctx(), n) }
+
+func colorPalette(r fakerCtx, n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+
+	start := r.Float64() * 360
+	s := float64(randIntRange(r, 50, 80)) / 100
+	l := float64(randIntRange(r, 40, 60)) / 100
+
+	palette := make([]string, n)
+	for i := range palette {
+		red, green, blue := hslToRGB(math.Mod(start+float64(i)*360/float64(n), 360), s, l)
+		palette[i] = fmt.Sprintf("#%02x%02x%02x", red, green, blue)
+	}
+	return palette
+}
+
+// hslToRGB converts a hue in degrees with saturation and lightness from 0 to 1 into rgb
+func hslToRGB(h, s, l float64) (int, int, int) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var red, green, blue float64
+	switch {
+	case h < 60:
+		red, green = c, x
+	case h < 120:
+		red, green = x, c
+	case h < 180:
+		green, blue = c, x
+	case h < 240:
+		green, blue = x, c
+	case h < 300:
+		red, blue = x, c
+	default:
+		red, blue = c, x
+	}
+
+	return int(math.Round((red + m) * 255)), int(math.Round((green + m) * 255)), int(math.Round((blue + m) * 255))
+}
+
+// ColorFormat will generate a random color string in the format passed,
+// name, safe, hex, hexshort, rgb as rgb(r, g, b) or hsl as hsl(h, s%, l%)
+func ColorFormat(format string) (string, error) { return colorFormat(globalFaker.ctx(), format) }
+
+// ColorFormat will generate a random color string in the format passed,
+// name, safe, hex, hexshort, rgb as rgb(r, g, b) or hsl as hsl(h, s%, l%)
+func (f *Faker) ColorFormat(format string) (string, error) { return colorFormat(f.ctx(), format) }
+
+func colorFormat(r fakerCtx, format string) (string, error) {
+	switch format {
+	case "name":
+		return colorFunc(r), nil
+	case "safe":
+		return safeColor(r), nil
+	case "hex":
+		return hexColor(r), nil
+	case "hexshort":
+		return hexColorShort(r), nil
+	case "rgb":
+		rgb := rgbColor(r)
+		return fmt.Sprintf("rgb(%d, %d, %d)", rgb[0], rgb[1], rgb[2]), nil
+	case "hsl":
+		h, s, l := hslColor(r)
+		return fmt.Sprintf("hsl(%d, %d%%, %d%%)", h, s, l), nil
+	}
+
+	return "", errors.New("Invalid color format " + format + ", must be name, safe, hex, hexshort, rgb or hsl")
 }
 
 func addColorLookup() {
@@ -37,8 +164,8 @@ func addColorLookup() {
 		Description: "Random color",
 		Example:     "MediumOrchid",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Color(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return colorFunc(r), nil
 		},
 	})
 
@@ -48,8 +175,8 @@ func addColorLookup() {
 		Description: "Random safe color",
 		Example:     "black",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return SafeColor(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return safeColor(r), nil
 		},
 	})
 
@@ -59,8 +186,8 @@ func addColorLookup() {
 		Description: "Random hex color",
 		Example:     "#a99fb4",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HexColor(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hexColor(r), nil
 		},
 	})
 
@@ -69,9 +196,73 @@ func addColorLookup() {
 		Category:    "color",
 		Description: "Random rgb color",
 		Example:     "[152 23 53]",
+		Output:      "[]int",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return rgbColor(r), nil
+		},
+	})
+
+	AddFuncLookup("hexcolorshort", Info{
+		Display:     "Hex Color Short",
+		Category:    "color",
+		Description: "Random 3 digit hex color",
+		Example:     "#b64",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return RGBColor(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hexColorShort(r), nil
+		},
+	})
+
+	AddFuncLookup("hslcolor", Info{
+		Display:     "HSL Color",
+		Category:    "color",
+		Description: "Random hsl color as hue, saturation and lightness",
+		Example:     "[120 68 65]",
+		Output:      "[]int",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			h, s, l := hslColor(r)
+			return []int{h, s, l}, nil
+		},
+	})
+
+	AddFuncLookup("colorpalette", Info{
+		Display:     "Color Palette",
+		Category:    "color",
+		Description: "Random harmonious hex colors with evenly spaced hues",
+		Example:     "[#d58320 #20d529 #2072d5 #d520cc]",
+		Output:      "[]string",
+		Params: []Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "5", Description: "Number of colors"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			count, err := info.GetInt(m, "count")
+			if err != nil {
+				return nil, err
+			}
+			if count <= 0 || count > 360 {
+				return nil, errors.New("Count must be between 1 and 360")
+			}
+
+			return colorPalette(r, count), nil
+		},
+	})
+
+	AddFuncLookup("colorformat", Info{
+		Display:     "Color Format",
+		Category:    "color",
+		Description: "Random color in the format passed",
+		Example:     "rgb(152, 23, 53)",
+		Output:      "string",
+		Params: []Param{
+			{Field: "format", Display: "Format", Type: "string", Default: "hex", Options: []string{"name", "safe", "hex", "hexshort", "rgb", "hsl"}, Description: "Format of the color"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			format, err := info.GetString(m, "format")
+			if err != nil {
+				return nil, err
+			}
+
+			return colorFormat(r, format)
 		},
 	})
 }
@@ -2,6 +2,9 @@ package gofakeit
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -52,3 +55,181 @@ func BenchmarkRGBColor(b *testing.B) {
 		RGBColor()
 	}
 }
+
+func TestHexColor(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		hex := HexColor()
+		if len(hex) != 7 || hex[0] != '#' || strings.ToLower(hex) != hex {
+			t.Fatalf("expected #rrggbb, got %s", hex)
+		}
+
+		// Parse each channel back
+		for c := 0; c < 3; c++ {
+			v, err := strconv.ParseUint(hex[1+c*2:3+c*2], 16, 8)
+			if err != nil {
+				t.Fatalf("%s has an invalid channel: %s", hex, err)
+			}
+			if v > 255 {
+				t.Fatalf("%s channel out of range", hex)
+			}
+		}
+	}
+}
+
+func ExampleHexColorShort() {
+	Seed(11)
+	fmt.Println(HexColorShort())
+	// Output: #b64
+}
+
+func TestHexColorShort(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		hex := HexColorShort()
+		if len(hex) != 4 || hex[0] != '#' {
+			t.Fatalf("expected #rgb, got %s", hex)
+		}
+		if _, err := strconv.ParseUint(hex[1:], 16, 16); err != nil {
+			t.Fatalf("%s is not valid hex: %s", hex, err)
+		}
+	}
+}
+
+func BenchmarkHexColorShort(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		HexColorShort()
+	}
+}
+
+func ExampleHSLColor() {
+	Seed(11)
+	fmt.Println(HSLColor())
+	// Output: 120 68 65
+}
+
+func TestHSLColor(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		h, s, l := HSLColor()
+		if h < 0 || h > 359 || s < 0 || s > 100 || l < 0 || l > 100 {
+			t.Fatalf("hsl out of range %d %d %d", h, s, l)
+		}
+	}
+}
+
+func BenchmarkHSLColor(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		HSLColor()
+	}
+}
+
+func ExampleColorFormat() {
+	Seed(11)
+	value, err := ColorFormat("rgb")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: rgb(152, 23, 53)
+}
+
+func TestColorFormat(t *testing.T) {
+	for _, format := range []string{"name", "safe", "hex", "hexshort", "rgb", "hsl"} {
+		value, err := ColorFormat(format)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		switch format {
+		case "hex":
+			if len(value) != 7 || value[0] != '#' {
+				t.Errorf("expected #rrggbb, got %s", value)
+			}
+		case "hexshort":
+			if len(value) != 4 || value[0] != '#' {
+				t.Errorf("expected #rgb, got %s", value)
+			}
+		case "rgb":
+			var r, g, b int
+			if _, err := fmt.Sscanf(value, "rgb(%d, %d, %d)", &r, &g, &b); err != nil {
+				t.Errorf("could not parse %s: %s", value, err)
+			}
+			for _, c := range []int{r, g, b} {
+				if c < 0 || c > 255 {
+					t.Errorf("%s channel out of range", value)
+				}
+			}
+		case "hsl":
+			var h, s, l int
+			if _, err := fmt.Sscanf(value, "hsl(%d, %d%%, %d%%)", &h, &s, &l); err != nil {
+				t.Errorf("could not parse %s: %s", value, err)
+			}
+		}
+	}
+
+	if _, err := ColorFormat("cmyk"); err == nil {
+		t.Error("expected error for invalid format")
+	}
+}
+
+func BenchmarkColorFormat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ColorFormat("hsl")
+	}
+}
+
+func ExampleColorPalette() {
+	Seed(11)
+	fmt.Println(ColorPalette(4))
+	// Output: [#d58320 #20d529 #2072d5 #d520cc]
+}
+
+func TestColorPalette(t *testing.T) {
+	hex := regexp.MustCompile(`^#[0-9a-f]{6}$`)
+	for _, n := range []int{1, 2, 3, 5, 8, 12, 36} {
+		for i := 0; i < 100; i++ {
+			palette := ColorPalette(n)
+			if len(palette) != n {
+				t.Fatalf("expected %d colors got %d", n, len(palette))
+			}
+
+			seen := map[string]bool{}
+			for _, color := range palette {
+				if !hex.MatchString(color) {
+					t.Fatalf("expected #rrggbb got %s", color)
+				}
+				if seen[color] {
+					t.Fatalf("expected distinct colors got %v", palette)
+				}
+				seen[color] = true
+			}
+		}
+	}
+
+	if len(ColorPalette(0)) != 0 {
+		t.Error("expected no colors for a count of 0")
+	}
+}
+
+func TestHSLToRGB(t *testing.T) {
+	tests := []struct {
+		h, s, l float64
+		rgb     [3]int
+	}{
+		{0, 1, 0.5, [3]int{255, 0, 0}},
+		{120, 1, 0.5, [3]int{0, 255, 0}},
+		{240, 1, 0.5, [3]int{0, 0, 255}},
+		{60, 1, 0.25, [3]int{128, 128, 0}},
+		{0, 0, 1, [3]int{255, 255, 255}},
+	}
+	for _, test := range tests {
+		r, g, b := hslToRGB(test.h, test.s, test.l)
+		if [3]int{r, g, b} != test.rgb {
+			t.Errorf("hsl(%v, %v, %v) expected %v got %v", test.h, test.s, test.l, test.rgb, [3]int{r, g, b})
+		}
+	}
+}
+
+func BenchmarkColorPalette(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ColorPalette(5)
+	}
+}
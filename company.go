@@ -1,23 +1,48 @@
 package gofakeit
 
+import (
+	"fmt"
+	"strings"
+)
+
+// Company will generate a random company name string
+func Company() string { return company(globalFaker.ctx()) }
+
 // Company will generate a random company name string
-func Company() (company string) {
-	return getRandValue([]string{"company", "name"})
+func (f *Faker) Company() string { return company(f.ctx()) }
+
+func company(r fakerCtx) (company string) {
+	return getRandValue(r, []string{"company", "name"})
 }
 
 // CompanySuffix will generate a random company suffix string
-func CompanySuffix() string {
-	return getRandValue([]string{"company", "suffix"})
+func CompanySuffix() string { return companySuffix(globalFaker.ctx()) }
+
+// CompanySuffix will generate a random company suffix string
+func (f *Faker) CompanySuffix() string { return companySuffix(f.ctx()) }
+
+func companySuffix(r fakerCtx) string {
+	return getRandValue(r, []string{"company", "suffix"})
 }
 
 // BuzzWord will generate a random company buzz word string
-func BuzzWord() string {
-	return getRandValue([]string{"company", "buzzwords"})
+func BuzzWord() string { return buzzWord(globalFaker.ctx()) }
+
+// BuzzWord will generate a random company buzz word string
+func (f *Faker) BuzzWord() string { return buzzWord(f.ctx()) }
+
+func buzzWord(r fakerCtx) string {
+	return getRandValue(r, []string{"company", "buzzwords"})
 }
 
 // BS will generate a random company bs string
-func BS() string {
-	return getRandValue([]string{"company", "bs"})
+func BS() string { return bs(globalFaker.ctx()) }
+
+// BS will generate a random company bs string
+func (f *Faker) BS() string { return bs(f.ctx()) }
+
+func bs(r fakerCtx) string {
+	return getRandValue(r, []string{"company", "bs"})
 }
 
 // JobInfo is a struct of job information
@@ -29,28 +54,142 @@ type JobInfo struct {
 }
 
 // Job will generate a struct with random job information
-func Job() *JobInfo {
+func Job() *JobInfo { return job(globalFaker.ctx()) }
+
+// Job will generate a struct with random job information
+func (f *Faker) Job() *JobInfo { return job(f.ctx()) }
+
+func job(r fakerCtx) *JobInfo {
 	return &JobInfo{
-		Company:    Company(),
-		Title:      JobTitle(),
-		Descriptor: JobDescriptor(),
-		Level:      JobLevel(),
+		Company:    company(r),
+		Title:      jobTitle(r),
+		Descriptor: jobDescriptor(r),
+		Level:      jobLevel(r),
 	}
 }
 
 // JobTitle will generate a random job title string
-func JobTitle() string {
-	return getRandValue([]string{"job", "title"})
+func JobTitle() string { return jobTitle(globalFaker.ctx()) }
+
+// JobTitle will generate a random job title string
+func (f *Faker) JobTitle() string { return jobTitle(f.ctx()) }
+
+func jobTitle(r fakerCtx) string {
+	return getRandValue(r, []string{"job", "title"})
 }
 
 // JobDescriptor will generate a random job descriptor string
-func JobDescriptor() string {
-	return getRandValue([]string{"job", "descriptor"})
+func JobDescriptor() string { return jobDescriptor(globalFaker.ctx()) }
+
+// JobDescriptor will generate a random job descriptor string
+func (f *Faker) JobDescriptor() string { return jobDescriptor(f.ctx()) }
+
+func jobDescriptor(r fakerCtx) string {
+	return getRandValue(r, []string{"job", "descriptor"})
 }
 
 // JobLevel will generate a random job level string
-func JobLevel() string {
-	return getRandValue([]string{"job", "level"})
+func JobLevel() string { return jobLevel(globalFaker.ctx()) }
+
+// JobLevel will generate a random job level string
+func (f *Faker) JobLevel() string { return jobLevel(f.ctx()) }
+
+func jobLevel(r fakerCtx) string {
+	return getRandValue(r, []string{"job", "level"})
+}
+
+// JobDepartment will generate a random job department string
+func JobDepartment() string { return jobDepartment(globalFaker.ctx()) }
+
+// JobDepartment will generate a random job department string
+func (f *Faker) JobDepartment() string { return jobDepartment(f.ctx()) }
+
+func jobDepartment(r fakerCtx) string {
+	return getRandValue(r, []string{"job", "department"})
+}
+
+// EmployeeInfo is a struct of an employee whose email belongs to the company they work for
+type EmployeeInfo struct {
+	FirstName  string `json:"first_name" xml:"first_name"`
+	LastName   string `json:"last_name" xml:"last_name"`
+	Email      string `json:"email" xml:"email"`
+	Phone      string `json:"phone" xml:"phone"`
+	Company    string `json:"company" xml:"company"`
+	Domain     string `json:"domain" xml:"domain"`
+	Title      string `json:"title" xml:"title"`
+	Department string `json:"department" xml:"department"`
+}
+
+// Employee will generate a struct with a person working at a random company,
+// their email is first.last at a domain made from the company name
+func Employee() *EmployeeInfo { return employee(globalFaker.ctx()) }
+
+// Employee will generate a struct with a person working at a random company,
+// their email is first.last at a domain made from the company name
+func (f *Faker) Employee() *EmployeeInfo { return employee(f.ctx()) }
+
+func employee(r fakerCtx) *EmployeeInfo {
+	e := &EmployeeInfo{
+		FirstName:  firstName(r),
+		LastName:   lastName(r),
+		Company:    company(r),
+		Title:      jobTitle(r),
+		Department: jobDepartment(r),
+		Phone:      phone(r),
+	}
+	e.Domain = companyDomain(e.Company) + "." + domainSuffix(r)
+
+	local := emailLocal(e.FirstName) + "." + emailLocal(e.LastName)
+	local = strings.Trim(local, ".")
+	if local == "" {
+		local = strings.ToLower(username(r))
+	}
+	e.Email = local + "@" + e.Domain
+
+	return e
+}
+
+// companyDomain slugifies a company name into a domain name without its suffix,
+// "Moen, Pagac and Wuckert" becomes moen-pagac-and-wuckert
+func companyDomain(name string) string {
+	var sb strings.Builder
+	dash := false
+	for _, c := range strings.ToLower(name) {
+		if c >= 'a' && c <= 'z' || c >= '0' && c <= '9' {
+			if dash && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			sb.WriteRune(c)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	if sb.Len() == 0 {
+		return "company"
+	}
+	return sb.String()
+}
+
+// einPrefixes are the prefixes the IRS assigns employer identification numbers with
+var einPrefixes = []int{
+	1, 2, 3, 4, 5, 6, 10, 11, 12, 13, 14, 15, 16, 20, 21, 22, 23, 24, 25, 26, 27,
+	30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48,
+	50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68,
+	71, 72, 73, 74, 75, 76, 77, 80, 81, 82, 83, 84, 85, 86, 87, 88, 90, 91, 92, 93, 94, 95, 98, 99,
+}
+
+// EIN will generate a random Employer Identification Number with a prefix the IRS assigns.
+// Format: xx-xxxxxxx
+func EIN() string { return ein(globalFaker.ctx()) }
+
+// EIN will generate a random Employer Identification Number with a prefix the IRS assigns.
+// Format: xx-xxxxxxx
+func (f *Faker) EIN() string { return ein(f.ctx()) }
+
+func ein(r fakerCtx) string {
+	return fmt.Sprintf("%02d-%07d", einPrefixes[r.Intn(len(einPrefixes))], r.Intn(10000000))
 }
 
 func addCompanyLookup() {
@@ -60,8 +199,8 @@ func addCompanyLookup() {
 		Description: "Random company name",
 		Example:     "Moen, Pagac and Wuckert",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Company(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return company(r), nil
 		},
 	})
 
@@ -71,8 +210,8 @@ func addCompanyLookup() {
 		Description: "Random company name suffix",
 		Example:     "Inc",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CompanySuffix(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return companySuffix(r), nil
 		},
 	})
 
@@ -82,8 +221,8 @@ func addCompanyLookup() {
 		Description: "Random bs company word",
 		Example:     "front-end",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BS(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return bs(r), nil
 		},
 	})
 
@@ -93,8 +232,8 @@ func addCompanyLookup() {
 		Description: "Random company buzzwords",
 		Example:     "disintermediate",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BuzzWord(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return buzzWord(r), nil
 		},
 	})
 
@@ -104,8 +243,8 @@ func addCompanyLookup() {
 		Description: "Random job data set",
 		Example:     `{company: "Moen, Pagac and Wuckert", title: "Director", descriptor: "Central", level: "Assurance"}`,
 		Output:      "map[string]string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Job(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return job(r), nil
 		},
 	})
 
@@ -115,8 +254,8 @@ func addCompanyLookup() {
 		Description: "Random job title",
 		Example:     "Director",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return JobTitle(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return jobTitle(r), nil
 		},
 	})
 
@@ -126,8 +265,8 @@ func addCompanyLookup() {
 		Description: "Random job descriptor",
 		Example:     "Central",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return JobDescriptor(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return jobDescriptor(r), nil
 		},
 	})
 
@@ -137,8 +276,40 @@ func addCompanyLookup() {
 		Description: "Random job level",
 		Example:     "Assurance",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return JobLevel(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return jobLevel(r), nil
+		},
+	})
+
+	AddFuncLookup("ein", Info{
+		Display:     "EIN",
+		Category:    "company",
+		Description: "Random employer identification number",
+		Example:     "12-3456789",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return ein(r), nil
+		},
+	})
+	AddFuncLookup("jobdepartment", Info{
+		Display:     "Job Department",
+		Category:    "company",
+		Description: "Random job department",
+		Example:     "Engineering",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return jobDepartment(r), nil
+		},
+	})
+
+	AddFuncLookup("employee", Info{
+		Display:     "Employee",
+		Category:    "company",
+		Description: "Random employee with an email at the domain of their company",
+		Example:     `{first_name: "Markus", last_name: "Moen", email: "markus.moen@moen-pagac-and-wuckert.com", phone: "6136459948", company: "Moen, Pagac and Wuckert", domain: "moen-pagac-and-wuckert.com", title: "Director", department: "Engineering"}`,
+		Output:      "map[string]string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return employee(r), nil
 		},
 	})
 }
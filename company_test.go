@@ -2,6 +2,8 @@ package gofakeit
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -113,3 +115,102 @@ func BenchmarkJobLevel(b *testing.B) {
 		JobLevel()
 	}
 }
+
+func ExampleEIN() {
+	Seed(11)
+	fmt.Println(EIN())
+	// Output: 61-8572951
+}
+
+func TestEIN(t *testing.T) {
+	format := regexp.MustCompile(`^\d{2}-\d{7}$`)
+	invalid := map[string]bool{"00": true, "07": true, "08": true, "09": true, "17": true, "18": true, "19": true,
+		"28": true, "29": true, "49": true, "69": true, "70": true, "78": true, "79": true, "89": true, "96": true, "97": true}
+	for i := 0; i < 10000; i++ {
+		ein := EIN()
+		if !format.MatchString(ein) {
+			t.Fatalf("%s does not match the ein format", ein)
+		}
+		if invalid[ein[:2]] {
+			t.Fatalf("%s has a prefix the irs does not assign", ein)
+		}
+	}
+}
+
+func BenchmarkEIN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		EIN()
+	}
+}
+
+func ExampleJobDepartment() {
+	Seed(11)
+	fmt.Println(JobDepartment())
+	// Output: Marketing
+}
+
+func BenchmarkJobDepartment(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		JobDepartment()
+	}
+}
+
+func ExampleEmployee() {
+	Seed(11)
+	employee := Employee()
+	fmt.Println(employee.FirstName)
+	fmt.Println(employee.LastName)
+	fmt.Println(employee.Email)
+	fmt.Println(employee.Company)
+	fmt.Println(employee.Domain)
+	fmt.Println(employee.Title)
+	fmt.Println(employee.Department)
+	// Output: Markus
+	// Moen
+	// markus.moen@loqate-inc.com
+	// Loqate, Inc.
+	// loqate-inc.com
+	// Engineer
+	// Information Technology
+}
+
+func TestEmployee(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		e := Employee()
+
+		at := strings.LastIndex(e.Email, "@")
+		if at <= 0 {
+			t.Fatalf("invalid email %s", e.Email)
+		}
+		if e.Email[at+1:] != e.Domain {
+			t.Fatalf("expected email %s to be at domain %s", e.Email, e.Domain)
+		}
+		if !strings.HasPrefix(e.Domain, companyDomain(e.Company)+".") {
+			t.Fatalf("expected domain %s to come from company %s", e.Domain, e.Company)
+		}
+		if e.Title == "" || e.Department == "" || e.Phone == "" {
+			t.Fatalf("expected a title, department and phone got %+v", e)
+		}
+	}
+}
+
+func TestCompanyDomain(t *testing.T) {
+	tests := map[string]string{
+		"Moen, Pagac and Wuckert": "moen-pagac-and-wuckert",
+		"Smith & Sons LLC":        "smith-sons-llc",
+		"  --Acme--  ":            "acme",
+		"3M":                      "3m",
+		"!!!":                     "company",
+	}
+	for name, want := range tests {
+		if got := companyDomain(name); got != want {
+			t.Errorf("companyDomain(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func BenchmarkEmployee(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Employee()
+	}
+}
@@ -0,0 +1,93 @@
+package conformance
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5"
+	"github.com/linkedin/goavro/v2"
+)
+
+var avroFields = []gofakeit.Field{
+	{Name: "id", Function: "autoincrement"},
+	{Name: "first_name", Function: "firstname"},
+	{Name: "price", Function: "price"},
+	{Name: "active", Function: "bool"},
+	{Name: "age", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"99"}}},
+	{Name: "email", Function: "email", NullProbability: 0.5},
+}
+
+func TestAvro(t *testing.T) {
+	// More than one block of 1000 records
+	value, schema, err := gofakeit.New(rand.NewSource(11)).Avro(&gofakeit.AvroOptions{Name: "Person", RowCount: 2500, Fields: avroFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := goavro.NewCodec(string(schema)); err != nil {
+		t.Fatalf("goavro could not parse the schema: %s\n%s", err, schema)
+	}
+
+	ocf, err := goavro.NewOCFReader(bytes.NewReader(value))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ocf.Codec().Schema() != string(schema) {
+		t.Errorf("expected container schema %s, got %s", schema, ocf.Codec().Schema())
+	}
+
+	var records []map[string]interface{}
+	for ocf.Scan() {
+		record, err := ocf.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, record.(map[string]interface{}))
+	}
+	if err := ocf.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2500 {
+		t.Fatalf("expected 2500 records, got %d", len(records))
+	}
+
+	nulls := 0
+	for i, record := range records {
+		if len(record) != len(avroFields) {
+			t.Fatalf("record %d expected %d fields, got %d", i, len(avroFields), len(record))
+		}
+
+		if id, ok := record["id"].(int64); !ok || id != int64(i+1) {
+			t.Errorf("record %d expected id %d, got %v", i, i+1, record["id"])
+		}
+		if name, ok := record["first_name"].(string); !ok || name == "" {
+			t.Errorf("record %d expected a first name string, got %v", i, record["first_name"])
+		}
+		if price, ok := record["price"].(float64); !ok || price <= 0 {
+			t.Errorf("record %d expected a positive price double, got %v", i, record["price"])
+		}
+		if _, ok := record["active"].(bool); !ok {
+			t.Errorf("record %d expected an active boolean, got %v", i, record["active"])
+		}
+		if age, ok := record["age"].(int64); !ok || age < 1 || age > 99 {
+			t.Errorf("record %d expected an age long between 1 and 99, got %v", i, record["age"])
+		}
+
+		// goavro decodes a non null union value as a map of its type to the value
+		switch email := record["email"].(type) {
+		case nil:
+			nulls++
+		case map[string]interface{}:
+			if s, ok := email["string"].(string); !ok || !strings.Contains(s, "@") {
+				t.Errorf("record %d expected an email string, got %v", i, email)
+			}
+		default:
+			t.Errorf("record %d expected a null or string union email, got %T", i, email)
+		}
+	}
+	if nulls == 0 || nulls == len(records) {
+		t.Errorf("expected some null emails, got %d of %d", nulls, len(records))
+	}
+}
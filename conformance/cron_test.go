@@ -0,0 +1,51 @@
+package conformance
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v5"
+	"github.com/robfig/cron/v3"
+)
+
+func TestCronExpression(t *testing.T) {
+	f := gofakeit.New(rand.NewSource(11))
+	for i := 0; i < 10000; i++ {
+		expr := f.CronExpression()
+		if _, err := cron.ParseStandard(expr); err != nil {
+			t.Fatalf("%s is not a valid cron expression: %s", expr, err)
+		}
+	}
+}
+
+func TestCronExpressionRange(t *testing.T) {
+	f := gofakeit.New(rand.NewSource(11))
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, pattern := range []string{"minutes", "hourly", "daily", "weekly", "monthly"} {
+		for i := 0; i < 1000; i++ {
+			expr, err := f.CronExpressionRange(&gofakeit.CronOptions{Patterns: []string{pattern}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			schedule, err := cron.ParseStandard(expr)
+			if err != nil {
+				t.Fatalf("%s is not a valid cron expression: %s", expr, err)
+			}
+
+			// Two runs in a row are at most the patterns period apart
+			first := schedule.Next(start)
+			gap := schedule.Next(first).Sub(first)
+			max := map[string]time.Duration{
+				"minutes": time.Hour,
+				"hourly":  time.Hour,
+				"daily":   24 * time.Hour,
+				"weekly":  7 * 24 * time.Hour,
+				"monthly": 31 * 24 * time.Hour,
+			}[pattern]
+			if first.IsZero() || gap <= 0 || gap > max {
+				t.Fatalf("%s runs %s apart, expected %s pattern to run at most %s apart", expr, gap, pattern, max)
+			}
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package conformance checks gofakeit file outputs against reference decoders.
+//
+// It is its own module so gofakeit stays free of dependencies, run the checks with
+//
+//	cd conformance && go test ./...
+package conformance
@@ -0,0 +1,90 @@
+package conformance
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// htmlVoid are the elements used by HTMLDocument that have no end tag
+var htmlVoid = map[atom.Atom]bool{atom.Meta: true, atom.Img: true}
+
+func TestHTMLDocument(t *testing.T) {
+	f := gofakeit.New(rand.NewSource(11))
+	for i := 0; i < 200; i++ {
+		value, err := f.HTMLDocument(&gofakeit.HTMLOptions{Paragraphs: 4, ListItems: 6})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		htmlCheckTags(t, value)
+
+		doc, err := html.Parse(bytes.NewReader(value))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Count elements by their parent as the html5 parser built the tree
+		counts := map[string]int{}
+		var walk func(n *html.Node)
+		walk = func(n *html.Node) {
+			if n.Type == html.ElementNode && n.Parent != nil && n.Parent.Type == html.ElementNode {
+				counts[n.Parent.Data+">"+n.Data]++
+			}
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				walk(c)
+			}
+		}
+		walk(doc)
+
+		expected := map[string]int{"html>head": 1, "html>body": 1, "head>meta": 1, "head>title": 1, "body>h1": 1, "body>img": 1, "body>p": 4, "body>ul": 1, "ul>li": 6}
+		if len(counts) != len(expected) {
+			t.Fatalf("expected elements %v, got %v\n%s", expected, counts, value)
+		}
+		for name, count := range expected {
+			if counts[name] != count {
+				t.Fatalf("expected %d %s elements, got %d\n%s", count, name, counts[name], value)
+			}
+		}
+	}
+}
+
+// htmlCheckTags tokenizes the document and checks every element is closed in order
+func htmlCheckTags(t *testing.T, value []byte) {
+	var stack []atom.Atom
+	z := html.NewTokenizer(bytes.NewReader(value))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() != io.EOF {
+				t.Fatal(z.Err())
+			}
+			if len(stack) > 0 {
+				t.Fatalf("unclosed elements %v\n%s", stack, value)
+			}
+			return
+		case html.StartTagToken:
+			tok := z.Token()
+			if htmlVoid[tok.DataAtom] {
+				t.Fatalf("void element %s should be self closing\n%s", tok.Data, value)
+			}
+			stack = append(stack, tok.DataAtom)
+		case html.EndTagToken:
+			tok := z.Token()
+			if len(stack) == 0 || stack[len(stack)-1] != tok.DataAtom {
+				t.Fatalf("unexpected end tag %s, open elements %v\n%s", tok.Data, stack, value)
+			}
+			stack = stack[:len(stack)-1]
+		case html.SelfClosingTagToken:
+			if tok := z.Token(); !htmlVoid[tok.DataAtom] {
+				t.Fatalf("element %s can't be self closing\n%s", tok.Data, value)
+			}
+		}
+	}
+}
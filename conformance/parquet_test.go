@@ -0,0 +1,121 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5"
+	"github.com/parquet-go/parquet-go"
+)
+
+var parquetFields = []gofakeit.Field{
+	{Name: "id", Function: "autoincrement"},
+	{Name: "first_name", Function: "firstname"},
+	{Name: "price", Function: "price"},
+	{Name: "active", Function: "bool"},
+	{Name: "age", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"99"}}},
+}
+
+func TestParquet(t *testing.T) {
+	value, err := gofakeit.New(rand.NewSource(11)).Parquet(&gofakeit.ParquetOptions{RowCount: 25, Fields: parquetFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := parquet.OpenFile(bytes.NewReader(value), int64(len(value)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.NumRows() != 25 {
+		t.Errorf("expected 25 rows, got %d", f.NumRows())
+	}
+
+	expected := map[string]parquet.Kind{
+		"id":         parquet.Int64,
+		"first_name": parquet.ByteArray,
+		"price":      parquet.Double,
+		"active":     parquet.Boolean,
+		"age":        parquet.Int64,
+	}
+	columns := f.Schema().Fields()
+	if len(columns) != len(parquetFields) {
+		t.Fatalf("expected %d columns, got %d", len(parquetFields), len(columns))
+	}
+	for i, column := range columns {
+		if column.Name() != parquetFields[i].Name {
+			t.Errorf("expected column %d to be %s, got %s", i, parquetFields[i].Name, column.Name())
+		}
+		if kind := column.Type().Kind(); kind != expected[column.Name()] {
+			t.Errorf("expected column %s to be %s, got %s", column.Name(), expected[column.Name()], kind)
+		}
+	}
+
+	rows := parquetRows(t, f)
+
+	// The same seed draws the same values in json
+	j, err := gofakeit.New(rand.NewSource(11)).JSON(&gofakeit.JSONOptions{Type: "array", RowCount: 25, Fields: parquetFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var jsonRows []map[string]interface{}
+	if err := json.Unmarshal(j, &jsonRows); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rows) != len(jsonRows) {
+		t.Fatalf("expected %d rows, got %d", len(jsonRows), len(rows))
+	}
+	for i, row := range rows {
+		if len(row) != len(parquetFields) {
+			t.Fatalf("row %d expected %d values, got %d", i, len(parquetFields), len(row))
+		}
+		for ii, field := range parquetFields {
+			got, want := row[ii], jsonRows[i][field.Name]
+			if got != want {
+				t.Errorf("row %d column %s expected %v, got %v", i, field.Name, want, got)
+			}
+		}
+	}
+}
+
+// parquetRows reads every row of the file, with numbers as float64 to compare with decoded json
+func parquetRows(t *testing.T, f *parquet.File) [][]interface{} {
+	var rows [][]interface{}
+	for _, rg := range f.RowGroups() {
+		reader := rg.Rows()
+		buf := make([]parquet.Row, 10)
+		for {
+			n, err := reader.ReadRows(buf)
+			for _, row := range buf[:n] {
+				values := make([]interface{}, len(row))
+				for _, v := range row {
+					switch v.Kind() {
+					case parquet.Int64:
+						values[v.Column()] = float64(v.Int64())
+					case parquet.Double:
+						values[v.Column()] = v.Double()
+					case parquet.Boolean:
+						values[v.Column()] = v.Boolean()
+					case parquet.ByteArray:
+						values[v.Column()] = string(v.ByteArray())
+					default:
+						t.Fatalf("unexpected value kind %s", v.Kind())
+					}
+				}
+				rows = append(rows, values)
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		reader.Close()
+	}
+
+	return rows
+}
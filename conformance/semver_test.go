@@ -0,0 +1,65 @@
+package conformance
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/brianvoe/gofakeit/v5"
+)
+
+func TestSemVer(t *testing.T) {
+	f := gofakeit.New(rand.NewSource(11))
+	for i := 0; i < 10000; i++ {
+		if v := f.SemVer(); !semverValid(v) {
+			t.Fatalf("%s is not a valid semantic version", v)
+		}
+	}
+}
+
+func TestSemVerRange(t *testing.T) {
+	f := gofakeit.New(rand.NewSource(11))
+	pairs := [][2]string{
+		{"0.0.0", "0.0.0"},
+		{"1.2.3", "1.2.9"},
+		{"1.9.5", "2.0.1"},
+		{"1.0.0-alpha", "1.0.0"},
+		{"1.0.0-alpha", "1.0.0-beta"},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta"},
+		{"1.4.0", "2.0.0-rc.1"},
+		{"1.0.0+build.5", "1.0.1+build.1"},
+	}
+
+	// Random bounds ordered by the library
+	for i := 0; i < 200; i++ {
+		a, b := semver.MustParse(f.SemVer()), semver.MustParse(f.SemVer())
+		if a.GreaterThan(b) {
+			a, b = b, a
+		}
+		pairs = append(pairs, [2]string{a.Original(), b.Original()})
+	}
+
+	for _, pair := range pairs {
+		min, max := semver.MustParse(pair[0]), semver.MustParse(pair[1])
+		for i := 0; i < 100; i++ {
+			value, err := f.SemVerRange(pair[0], pair[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !semverValid(value) {
+				t.Fatalf("%s is not a valid semantic version", value)
+			}
+
+			v := semver.MustParse(value)
+			if v.LessThan(min) || v.GreaterThan(max) {
+				t.Fatalf("%s is outside of %s to %s", value, pair[0], pair[1])
+			}
+		}
+	}
+}
+
+// semverValid parses v the strict way, without a v prefix or missing parts
+func semverValid(v string) bool {
+	_, err := semver.StrictNewVersion(v)
+	return err == nil
+}
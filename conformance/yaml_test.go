@@ -0,0 +1,113 @@
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5"
+	"gopkg.in/yaml.v3"
+)
+
+var yamlFields = []gofakeit.Field{
+	{Name: "id", Function: "autoincrement"},
+	{Name: "first_name", Function: "firstname"},
+	{Name: "sentence", Function: "sentence"},
+	{Name: "price", Function: "price"},
+	{Name: "active", Function: "bool"},
+	{Name: "nothing", Function: "firstname", NullProbability: 1},
+	{Name: "words", Function: "randomstring", Params: map[string][]string{"strs": {
+		"true", "no", "Off", "y", "~", "2", "1e3", "0x1F", "a: b", " pad", "#hash", "quote\"d", "-dash", "[list]", "{map}", "new\nline", "tab\tbed", "ünïcode", "",
+	}}},
+	{Name: "tags", Function: "array", Params: map[string][]string{
+		"rowcount": {"2"},
+		"fields":   {`{"name":"sku","function":"uuid"}`},
+	}},
+	{Name: "address", Function: "address"},
+}
+
+func TestYAMLArray(t *testing.T) {
+	value, err := gofakeit.New(rand.NewSource(11)).YAML(&gofakeit.YAMLOptions{Type: "array", RowCount: 50, Fields: yamlFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(value, &doc); err != nil {
+		t.Fatalf("yaml.v3 could not parse the output: %s\n%s", err, value)
+	}
+	seq := doc.Content[0]
+	if seq.Kind != yaml.SequenceNode || len(seq.Content) != 50 {
+		t.Fatalf("expected a sequence of 50 rows, got kind %d with %d items", seq.Kind, len(seq.Content))
+	}
+	for i, row := range seq.Content {
+		yamlCheckKeys(t, fmt.Sprintf("row %d", i), row)
+	}
+
+	var rows []interface{}
+	if err := yaml.Unmarshal(value, &rows); err != nil {
+		t.Fatal(err)
+	}
+
+	// The same seed draws the same values in json
+	j, err := gofakeit.New(rand.NewSource(11)).JSON(&gofakeit.JSONOptions{Type: "array", RowCount: 50, Fields: yamlFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []interface{}
+	if err := json.Unmarshal(j, &expected); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range rows {
+		got, want := fmt.Sprintf("%#v", yamlGeneric(rows[i])), fmt.Sprintf("%#v", expected[i])
+		if got != want {
+			t.Errorf("row %d does not match json output\ngot:  %s\nwant: %s", i, got, want)
+		}
+	}
+}
+
+func TestYAMLObject(t *testing.T) {
+	value, err := gofakeit.New(rand.NewSource(11)).YAML(&gofakeit.YAMLOptions{Type: "object", Fields: yamlFields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(value, &doc); err != nil {
+		t.Fatalf("yaml.v3 could not parse the output: %s\n%s", err, value)
+	}
+	yamlCheckKeys(t, "object", doc.Content[0])
+}
+
+// yamlCheckKeys checks node is a mapping with the field names as keys in order
+func yamlCheckKeys(t *testing.T, name string, node *yaml.Node) {
+	if node.Kind != yaml.MappingNode || len(node.Content) != len(yamlFields)*2 {
+		t.Fatalf("%s expected a mapping of %d keys, got kind %d with %d nodes", name, len(yamlFields), node.Kind, len(node.Content))
+	}
+	for i, field := range yamlFields {
+		if key := node.Content[i*2].Value; key != field.Name {
+			t.Errorf("%s expected key %d to be %s, got %s", name, i, field.Name, key)
+		}
+	}
+}
+
+// yamlGeneric converts a yaml.v3 decoded value into the shapes encoding/json decodes into
+func yamlGeneric(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = yamlGeneric(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = yamlGeneric(item)
+		}
+		return val
+	case int:
+		return float64(val)
+	}
+	return v
+}
@@ -0,0 +1,133 @@
+package gofakeit
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// CronOptions defines values needed for cron expression generation
+type CronOptions struct {
+	Patterns []string `json:"patterns" xml:"patterns"` // minutes, hourly, daily, weekly or monthly, empty for all
+}
+
+var cronPatterns = []string{"minutes", "hourly", "daily", "weekly", "monthly"}
+
+// cronFields are the minimum and maximum values of the minute, hour, day of month, month and day of week fields
+var cronFields = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}}
+
+// CronExpression will generate a random 5 field crontab expression
+func CronExpression() string { return cronExpression(globalFaker.ctx()) }
+
+// CronExpression will generate a random 5 field crontab expression
+func (f *Faker) CronExpression() string { return cronExpression(f.ctx()) }
+
+func cronExpression(r fakerCtx) string {
+	fields := make([]string, len(cronFields))
+	for i, minMax := range cronFields {
+		fields[i] = cronField(r, minMax[0], minMax[1])
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// cronField generates a wildcard, value, range, step or list within min and max
+func cronField(r fakerCtx, min, max int) string {
+	switch randIntRange(r, 1, 6) {
+	case 1, 2:
+		return "*"
+	case 3:
+		return strconv.Itoa(randIntRange(r, min, max))
+	case 4:
+		start := randIntRange(r, min, max-1)
+		return strconv.Itoa(start) + "-" + strconv.Itoa(randIntRange(r, start+1, max))
+	case 5:
+		return "*/" + strconv.Itoa(randIntRange(r, 2, (max-min+1)/2))
+	default:
+		// List of up to 3 distinct values in ascending order
+		count := randIntRange(r, 2, 3)
+		values := []string{}
+		value := min - 1
+		for i := 0; i < count && value < max; i++ {
+			value = randIntRange(r, value+1, max-(count-i-1))
+			values = append(values, strconv.Itoa(value))
+		}
+		return strings.Join(values, ",")
+	}
+}
+
+// CronExpressionRange will generate a 5 field crontab expression following one of the common patterns,
+// every n minutes, hourly, daily, weekly or monthly
+func CronExpressionRange(co *CronOptions) (string, error) {
+	return cronExpressionRange(globalFaker.ctx(), co)
+}
+
+// CronExpressionRange will generate a 5 field crontab expression following one of the common patterns,
+// every n minutes, hourly, daily, weekly or monthly
+func (f *Faker) CronExpressionRange(co *CronOptions) (string, error) {
+	return cronExpressionRange(f.ctx(), co)
+}
+
+func cronExpressionRange(r fakerCtx, co *CronOptions) (string, error) {
+	patterns := cronPatterns
+	if co != nil && len(co.Patterns) > 0 {
+		for _, pattern := range co.Patterns {
+			if !stringInSlice(pattern, cronPatterns) {
+				return "", errors.New("Invalid cron pattern " + pattern + ", must be minutes, hourly, daily, weekly or monthly")
+			}
+		}
+		patterns = co.Patterns
+	}
+
+	minute := strconv.Itoa(r.Intn(60))
+	hour := strconv.Itoa(r.Intn(24))
+
+	switch randomString(r, patterns) {
+	case "minutes":
+		return "*/" + randomString(r, []string{"2", "5", "10", "15", "20", "30"}) + " * * * *", nil
+	case "hourly":
+		return minute + " * * * *", nil
+	case "daily":
+		return minute + " " + hour + " * * *", nil
+	case "weekly":
+		return minute + " " + hour + " * * " + strconv.Itoa(r.Intn(7)), nil
+	default:
+		return minute + " " + hour + " " + strconv.Itoa(randIntRange(r, 1, 28)) + " * *", nil
+	}
+}
+
+func addCronLookup() {
+	AddFuncLookup("cron", Info{
+		Display:     "Cron Expression",
+		Category:    "time",
+		Description: "Random 5 field crontab expression",
+		Example:     "* 18,21,23 * 5,6 *",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return cronExpression(r), nil
+		},
+	})
+
+	AddFuncLookup("cronrange", Info{
+		Display:     "Cron Expression Range",
+		Category:    "time",
+		Description: "Random 5 field crontab expression following a common schedule pattern",
+		Example:     "0 23 * * 1",
+		Output:      "string",
+		Params: []Param{
+			{Field: "patterns", Display: "Patterns", Type: "[]string", Default: "all", Options: []string{"all", "minutes", "hourly", "daily", "weekly", "monthly"}, Description: "Schedule patterns to pick from"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			patterns, err := info.GetStringArray(m, "patterns")
+			if err != nil {
+				return nil, err
+			}
+
+			if len(patterns) == 1 && patterns[0] == "all" {
+				patterns = nil
+			}
+
+			return cronExpressionRange(r, &CronOptions{Patterns: patterns})
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package gofakeit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func ExampleCronExpression() {
+	Seed(11)
+	fmt.Println(CronExpression())
+	// Output: * 18,21,23 * 5,6 *
+}
+
+func TestCronExpression(t *testing.T) {
+	for i := 0; i < 10000; i++ {
+		expr := CronExpression()
+		if err := cronTestParse(expr); err != nil {
+			t.Fatalf("%s is not a valid cron expression: %s", expr, err)
+		}
+	}
+}
+
+func BenchmarkCronExpression(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CronExpression()
+	}
+}
+
+func ExampleCronExpressionRange() {
+	Seed(11)
+	value, err := CronExpressionRange(&CronOptions{Patterns: []string{"weekly"}})
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 0 23 * * 1
+}
+
+func TestCronExpressionRange(t *testing.T) {
+	for _, pattern := range []string{"minutes", "hourly", "daily", "weekly", "monthly", ""} {
+		co := &CronOptions{}
+		if pattern != "" {
+			co.Patterns = []string{pattern}
+		}
+
+		for i := 0; i < 1000; i++ {
+			expr, err := CronExpressionRange(co)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := cronTestParse(expr); err != nil {
+				t.Fatalf("%s is not a valid cron expression: %s", expr, err)
+			}
+
+			fields := strings.Fields(expr)
+			var ok bool
+			switch pattern {
+			case "minutes":
+				ok = strings.HasPrefix(fields[0], "*/") && strings.Join(fields[1:], " ") == "* * * *"
+			case "hourly":
+				ok = strings.Join(fields[1:], " ") == "* * * *"
+			case "daily":
+				ok = strings.Join(fields[2:], " ") == "* * *"
+			case "weekly":
+				ok = fields[2] == "*" && fields[3] == "*" && fields[4] != "*"
+			case "monthly":
+				ok = fields[2] != "*" && fields[3] == "*" && fields[4] == "*"
+			default:
+				ok = true
+			}
+			if !ok {
+				t.Fatalf("%s does not follow the %s pattern", expr, pattern)
+			}
+		}
+	}
+
+	if _, err := CronExpressionRange(&CronOptions{Patterns: []string{"yearly"}}); err == nil {
+		t.Error("expected error for unknown pattern")
+	}
+}
+
+func BenchmarkCronExpressionRange(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CronExpressionRange(&CronOptions{})
+	}
+}
+
+// cronTestParse validates a 5 field crontab expression, lists of values,
+// ranges and steps as accepted by the standard cron daemons
+func cronTestParse(expr string) error {
+	fields := strings.Split(expr, " ")
+	if len(fields) != 5 {
+		return fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	for i, field := range fields {
+		min, max := bounds[i][0], bounds[i][1]
+		for _, item := range strings.Split(field, ",") {
+			rangePart, step := item, ""
+			if index := strings.Index(item, "/"); index != -1 {
+				rangePart, step = item[:index], item[index+1:]
+				n, err := strconv.Atoi(step)
+				if err != nil || n < 1 || n > max {
+					return fmt.Errorf("invalid step %q", item)
+				}
+			}
+
+			if rangePart == "*" {
+				continue
+			}
+
+			bounds := strings.Split(rangePart, "-")
+			if len(bounds) > 2 {
+				return fmt.Errorf("invalid range %q", item)
+			}
+			values := []int{}
+			for _, b := range bounds {
+				n, err := strconv.Atoi(b)
+				if err != nil {
+					return fmt.Errorf("invalid value %q", item)
+				}
+				if n < min || n > max {
+					return fmt.Errorf("value %d out of range in %q", n, item)
+				}
+				values = append(values, n)
+			}
+			if len(values) == 2 && values[0] > values[1] {
+				return errors.New("range start after end in " + item)
+			}
+			if len(values) == 1 && step != "" {
+				return fmt.Errorf("step without range in %q", item)
+			}
+		}
+	}
+
+	return nil
+}
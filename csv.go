@@ -1,23 +1,252 @@
 package gofakeit
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"runtime"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 )
 
 // CSVOptions defines values needed for csv generation
 type CSVOptions struct {
-	Delimiter string  `json:"delimiter" xml:"delimiter"`
-	RowCount  int     `json:"row_count" xml:"row_count"`
-	Fields    []Field `json:"fields" xml:"fields"`
+	Delimiter   string  `json:"delimiter" xml:"delimiter"`
+	RowCount    int     `json:"row_count" xml:"row_count"`
+	Fields      []Field `json:"fields" xml:"fields"`
+	AlwaysQuote bool    `json:"always_quote" xml:"always_quote"`
+	NoHeader    bool    `json:"no_header" xml:"no_header"`
+	TypeRow     bool    `json:"type_row" xml:"type_row"`         // second header row with the output type of each field
+	SliceFormat string  `json:"slice_format" xml:"slice_format"` // json, the default, or space separated values
+	BOM         bool    `json:"bom" xml:"bom"`                   // start with a utf-8 byte order mark for excel
 }
 
+// csvFlushRows is the number of rows written between flushes when streaming
+const csvFlushRows = 1000
+
+// csvBOM is the utf-8 byte order mark excel uses to detect the encoding
+var csvBOM = []byte("\xEF\xBB\xBF")
+
 // CSV generates an object or an array of objects in json format
 func CSV(co *CSVOptions) ([]byte, error) {
+	return csvFunc(globalFaker.ctx(), globalFaker.csvOptions(co))
+}
+
+// CSV generates an object or an array of objects in json format
+func (f *Faker) CSV(co *CSVOptions) ([]byte, error) { return csvFunc(f.ctx(), f.csvOptions(co)) }
+
+func csvFunc(r fakerCtx, co *CSVOptions) ([]byte, error) {
+	b := &bytes.Buffer{}
+	if err := csvWriter(r, b, co); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// CSVWriter generates rows in csv format and writes them directly to w.
+// Rows are flushed periodically rather than buffered in memory, so large
+// row counts can be streamed to an os.Stdout, file or http.ResponseWriter.
+// Set NoHeader to append more rows to a file that already has its header,
+// along with BOM false as the byte order mark belongs at the start of the file
+func CSVWriter(w io.Writer, co *CSVOptions) error {
+	return csvWriter(globalFaker.ctx(), w, globalFaker.csvOptions(co))
+}
+
+// CSVWriter generates rows in csv format and writes them directly to w.
+// Rows are flushed periodically rather than buffered in memory, so large
+// row counts can be streamed to an os.Stdout, file or http.ResponseWriter.
+// Set NoHeader to append more rows to a file that already has its header,
+// along with BOM false as the byte order mark belongs at the start of the file
+func (f *Faker) CSVWriter(w io.Writer, co *CSVOptions) error {
+	return csvWriter(f.ctx(), w, f.csvOptions(co))
+}
+
+func csvWriter(r fakerCtx, w io.Writer, co *CSVOptions) error {
+	refOrder, err := csvCheck(co)
+	if err != nil {
+		return err
+	}
+
+	if err := csvWriteBOM(w, co); err != nil {
+		return err
+	}
+
+	// Add header row
+	if !co.NoHeader {
+		rw := newCSVRowWriter(w, co)
+		if err := csvWriteHeader(rw, co); err != nil {
+			return err
+		}
+		if err := rw.flush(); err != nil {
+			return err
+		}
+	}
+
+	// Rows are generated in the same chunks as CSVParallel so both give the same output,
+	// each chunk is flushed once written
+	base := r.Int63()
+	for c := 0; c*csvFlushRows < co.RowCount; c++ {
+		if err := csvChunk(csvChunkRand(r, base, c), w, co, refOrder, c*csvFlushRows+1, (c+1)*csvFlushRows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CSVParallel generates rows in csv format using workers goroutines, or one per cpu if workers is less than 1.
+// Rows are generated in chunks that each get a source seeded from a single base seed, so the output
+// is the same for any number of workers and byte for byte the same as CSV for the same seed
+func CSVParallel(co *CSVOptions, workers int) ([]byte, error) {
+	return csvParallel(globalFaker.ctx(), globalFaker.csvOptions(co), workers)
+}
+
+// CSVParallel generates rows in csv format using workers goroutines, or one per cpu if workers is less than 1.
+// Rows are generated in chunks that each get a source seeded from a single base seed, so the output
+// is the same for any number of workers and byte for byte the same as CSV for the same seed
+func (f *Faker) CSVParallel(co *CSVOptions, workers int) ([]byte, error) {
+	return csvParallel(f.ctx(), f.csvOptions(co), workers)
+}
+
+func csvParallel(r fakerCtx, co *CSVOptions, workers int) ([]byte, error) {
+	refOrder, err := csvCheck(co)
+	if err != nil {
+		return nil, err
+	}
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	// Every chunk seeds its own source from base so chunks dont depend on each other
+	base := r.Int63()
+	chunkCount := (co.RowCount + csvFlushRows - 1) / csvFlushRows
+	chunks := make([]bytes.Buffer, chunkCount)
+	errs := make([]error, chunkCount)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				errs[c] = csvChunk(csvChunkRand(r, base, c), &chunks[c], co, refOrder, c*csvFlushRows+1, (c+1)*csvFlushRows)
+			}
+		}()
+	}
+	for c := 0; c < chunkCount; c++ {
+		jobs <- c
+	}
+	close(jobs)
+	wg.Wait()
+
+	b := &bytes.Buffer{}
+	csvWriteBOM(b, co)
+	if !co.NoHeader {
+		rw := newCSVRowWriter(b, co)
+		if err := csvWriteHeader(rw, co); err != nil {
+			return nil, err
+		}
+		if err := rw.flush(); err != nil {
+			return nil, err
+		}
+	}
+	for c := range chunks {
+		if errs[c] != nil {
+			return nil, errs[c]
+		}
+		b.Write(chunks[c].Bytes())
+	}
+
+	return b.Bytes(), nil
+}
+
+// csvChunkRand returns the source of chunk, seeded from base and keeping the data overrides of r
+func csvChunkRand(r fakerCtx, base int64, chunk int) fakerCtx {
+	return fakerCtx{Rand: rand.New(rand.NewSource(csvChunkSeed(base, chunk))), data: r.data}
+}
+
+// csvChunkSeed mixes base and chunk with splitmix64, so sources of neighbouring chunks or
+// base seeds dont start from overlapping seeds
+func csvChunkSeed(base int64, chunk int) int64 {
+	z := uint64(base) + uint64(chunk+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// csvChunk writes the rows from first to last, capped at the row count
+func csvChunk(r fakerCtx, w io.Writer, co *CSVOptions, refOrder []int, first int, last int) error {
+	if last > co.RowCount {
+		last = co.RowCount
+	}
+
+	rw := newCSVRowWriter(w, co)
+	for i := first; i <= last; i++ {
+		vr, err := csvRow(r, co, refOrder, i)
+		if err != nil {
+			return err
+		}
+		if err := rw.write(vr); err != nil {
+			return err
+		}
+	}
+
+	return rw.flush()
+}
+
+// CSVHeaderOnly generates just the header row, and the type row when set, quoted and delimited the same as CSV.
+// Combined with NoHeader it lets chunks be generated separately and appended to one file
+func CSVHeaderOnly(co *CSVOptions) ([]byte, error) { return csvHeaderOnly(globalFaker.csvOptions(co)) }
+
+// CSVHeaderOnly generates just the header row, and the type row when set, quoted and delimited the same as CSV.
+// Combined with NoHeader it lets chunks be generated separately and appended to one file
+func (f *Faker) CSVHeaderOnly(co *CSVOptions) ([]byte, error) { return csvHeaderOnly(f.csvOptions(co)) }
+
+func csvHeaderOnly(co *CSVOptions) ([]byte, error) {
+	if err := csvCheckFields(co); err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	csvWriteBOM(b, co)
+	rw := newCSVRowWriter(b, co)
+	if err := csvWriteHeader(rw, co); err != nil {
+		return nil, err
+	}
+	if err := rw.flush(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// csvCheck validates the options, setting the default delimiter, and returns the ref field order
+func csvCheck(co *CSVOptions) ([]int, error) {
+	if err := csvCheckFields(co); err != nil {
+		return nil, err
+	}
+
+	// Make sure you set a row count
+	if co.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	// Ref fields are filled in after the rest of the row
+	return refFieldOrder(co.Fields)
+}
+
+// csvCheckFields validates the delimiter, setting the default, and the fields
+func csvCheckFields(co *CSVOptions) error {
 	// Check delimiter
 	if co.Delimiter == "" {
 		co.Delimiter = ","
@@ -25,66 +254,241 @@ func CSV(co *CSVOptions) ([]byte, error) {
 	if strings.ToLower(co.Delimiter) == "tab" {
 		co.Delimiter = "\t"
 	}
-	if co.Delimiter != "," && co.Delimiter != "\t" {
-		return nil, errors.New("Invalid delimiter type")
+	if utf8.RuneCountInString(co.Delimiter) != 1 {
+		return errors.New("Invalid delimiter, must be a single character or tab")
 	}
 
-	// Check fields
-	if co.Fields == nil || len(co.Fields) <= 0 {
-		return nil, errors.New("Must pass fields in order to build json object(s)")
+	// Same delimiters encoding/csv rejects, quoted values are written without its check
+	delimiter, _ := utf8.DecodeRuneInString(co.Delimiter)
+	if delimiter == 0 || delimiter == '"' || delimiter == '\r' || delimiter == '\n' || delimiter == utf8.RuneError {
+		return errors.New("Invalid delimiter, can't be a quote, new line or invalid character")
 	}
 
-	// Make sure you set a row count
-	if co.RowCount <= 0 {
-		return nil, errors.New("Must have row count")
+	// Check slice format
+	if co.SliceFormat == "" {
+		co.SliceFormat = "json"
+	}
+	if co.SliceFormat != "json" && co.SliceFormat != "space" {
+		return errors.New("Invalid slice format " + co.SliceFormat + ", must be json or space")
 	}
 
-	b := &bytes.Buffer{}
-	w := csv.NewWriter(b)
-	w.Comma = []rune(co.Delimiter)[0]
+	// Check fields
+	if co.Fields == nil || len(co.Fields) <= 0 {
+		return errors.New("Must pass fields in order to build json object(s)")
+	}
 
-	// Add header row
+	// Report every invalid field before generating any rows
+	return ValidateFields(co.Fields)
+}
+
+func csvHeader(co *CSVOptions) []string {
 	header := make([]string, len(co.Fields))
 	for i, field := range co.Fields {
 		header[i] = field.Name
 	}
-	w.Write(header)
-
-	// Loop through row count and add fields
-	for i := 1; i < int(co.RowCount); i++ {
-		vr := make([]string, len(co.Fields))
+	return header
+}
 
-		// Loop through fields and add to them to map[string]interface{}
-		for ii, field := range co.Fields {
-			if field.Function == "autoincrement" {
-				vr[ii] = fmt.Sprintf("%d", i)
-				continue
+// csvTypes returns the output type of each field, like int or string
+func csvTypes(co *CSVOptions) []string {
+	types := make([]string, len(co.Fields))
+	for i, field := range co.Fields {
+		switch field.Function {
+		case "autoincrement":
+			types[i] = "int"
+		case "timeseries":
+			types[i] = "string"
+		default:
+			if info := GetFuncLookup(field.Function); info != nil {
+				types[i] = info.Output
 			}
+		}
+	}
+	return types
+}
+
+// csvWriteBOM writes the byte order mark when it is set
+func csvWriteBOM(w io.Writer, co *CSVOptions) error {
+	if !co.BOM {
+		return nil
+	}
+
+	_, err := w.Write(csvBOM)
+	return err
+}
+
+// csvWriteHeader writes the header row, followed by the type row when it is set
+func csvWriteHeader(rw *csvRowWriter, co *CSVOptions) error {
+	if err := rw.write(csvHeader(co)); err != nil {
+		return err
+	}
+	if co.TypeRow {
+		return rw.write(csvTypes(co))
+	}
+	return nil
+}
 
-			// Get function info
-			funcInfo := GetFuncLookup(field.Function)
-			if funcInfo == nil {
-				return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+// csvCell formats a value for a cell, slices are encoded as a json array
+// or space separated values so they can be read back
+func csvCell(co *CSVOptions, value interface{}) string {
+	v := reflect.ValueOf(value)
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() == reflect.Uint8 {
+		return fmt.Sprintf("%v", value)
+	}
+
+	if co.SliceFormat == "space" {
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = fmt.Sprintf("%v", v.Index(i).Interface())
+		}
+		return strings.Join(values, " ")
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+// csvRow generates the values of row number i
+func csvRow(r fakerCtx, co *CSVOptions, refOrder []int, i int) ([]string, error) {
+	vr := make([]string, len(co.Fields))
+
+	// Loop through fields and add to them to map[string]interface{}
+	for ii, field := range co.Fields {
+		if field.Function == "ref" {
+			continue
+		}
+
+		if fieldNull(r, &field) {
+			continue
+		}
+
+		if field.Function == "autoincrement" {
+			id, err := autoIncrement(&field, i)
+			if err != nil {
+				return nil, err
 			}
+			vr[ii] = fmt.Sprintf("%d", id)
+			continue
+		}
 
-			value, err := funcInfo.Call(&field.Params, funcInfo)
+		if field.Function == "timeseries" {
+			ts, err := timeSeries(r, &field, i)
 			if err != nil {
 				return nil, err
 			}
+			vr[ii] = ts
+			continue
+		}
+
+		// Get function info
+		funcInfo := GetFuncLookup(field.Function)
+		if funcInfo == nil {
+			return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+		}
+
+		value, err := fieldCall(r, &field, funcInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		vr[ii] = csvCell(co, value)
+	}
+
+	if len(refOrder) > 0 {
+		row := make(map[string]interface{}, len(co.Fields))
+		for ii, field := range co.Fields {
+			row[field.Name] = vr[ii]
+		}
+		err := refEvaluate(r, co.Fields, refOrder, row, func(ii int, value interface{}) {
+			if value != nil {
+				vr[ii] = value.(string)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
-			vr[ii] = fmt.Sprintf("%v", value)
+	return vr, nil
+}
+
+// csvRowWriter writes rows with the delimiter and quoting of the options
+type csvRowWriter struct {
+	bw    *bufio.Writer
+	cw    *csv.Writer
+	quote []bool // columns to always quote, nil when quoting is left to csv.Writer
+}
+
+func newCSVRowWriter(w io.Writer, co *CSVOptions) *csvRowWriter {
+	bw := bufio.NewWriter(w)
+	cw := csv.NewWriter(bw)
+	cw.Comma = []rune(co.Delimiter)[0]
+	rw := &csvRowWriter{bw: bw, cw: cw}
+
+	for i, field := range co.Fields {
+		if co.AlwaysQuote || field.ForceQuote {
+			if rw.quote == nil {
+				rw.quote = make([]bool, len(co.Fields))
+			}
+			rw.quote[i] = true
 		}
+	}
+	return rw
+}
 
-		w.Write(vr)
+func (rw *csvRowWriter) write(row []string) error {
+	// csv.Writer only quotes fields when it needs to,
+	// so write pre-quoted rows ourselves when columns are always quoted
+	if rw.quote != nil {
+		return csvWriteQuoted(rw.bw, rw.cw.Comma, row, rw.quote)
 	}
+	return rw.cw.Write(row)
+}
 
-	w.Flush()
+func (rw *csvRowWriter) flush() error {
+	rw.cw.Flush()
+	if err := rw.cw.Error(); err != nil {
+		return err
+	}
+	return rw.bw.Flush()
+}
 
-	if err := w.Error(); err != nil {
-		return nil, err
+// csvWriteQuoted writes a row with the fields of quote columns wrapped in double quotes,
+// the rest only when they need it, with any double quotes within a field escaped per RFC 4180
+func csvWriteQuoted(w io.Writer, comma rune, row []string, quote []bool) error {
+	var sb strings.Builder
+	for i, field := range row {
+		if i > 0 {
+			sb.WriteRune(comma)
+		}
+		if !quote[i] && !csvNeedsQuotes(field, comma) {
+			sb.WriteString(field)
+			continue
+		}
+		sb.WriteByte('"')
+		sb.WriteString(strings.Replace(field, `"`, `""`, -1))
+		sb.WriteByte('"')
 	}
+	sb.WriteByte('\n')
 
-	return b.Bytes(), nil
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// csvNeedsQuotes reports whether csv.Writer would quote field
+func csvNeedsQuotes(field string, comma rune) bool {
+	if field == "" {
+		return false
+	}
+	if field == `\.` || strings.ContainsRune(field, comma) || strings.ContainsAny(field, "\"\r\n") {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(field)
+	return unicode.IsSpace(r)
 }
 
 func addFileCSVLookup() {
@@ -101,9 +505,14 @@ func addFileCSVLookup() {
 		Params: []Param{
 			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows in JSON array"},
 			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
-			{Field: "delimiter", Display: "Delimiter", Type: "string", Default: ",", Description: "Separator in between row values"},
+			{Field: "delimiter", Display: "Delimiter", Type: "string", Default: ",", Description: "Single character separator in between row values, or tab"},
+			{Field: "alwaysquote", Display: "Always Quote", Type: "bool", Default: "false", Description: "Whether or not to quote every field value"},
+			{Field: "noheader", Display: "No Header", Type: "bool", Default: "false", Description: "Whether or not to skip the header row"},
+			{Field: "typerow", Display: "Type Row", Type: "bool", Default: "false", Description: "Whether or not to add a row with the type of each field after the header"},
+			{Field: "sliceformat", Display: "Slice Format", Type: "string", Default: "json", Options: []string{"json", "space"}, Description: "Format of values that are slices, a json array or space separated"},
+			{Field: "bom", Display: "BOM", Type: "bool", Default: "false", Description: "Whether or not to start with a utf-8 byte order mark for excel"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			co := CSVOptions{}
 
 			rowcount, err := info.GetInt(m, "rowcount")
@@ -136,7 +545,37 @@ func addFileCSVLookup() {
 			}
 			co.Delimiter = delimiter
 
-			csvOut, err := CSV(&co)
+			alwaysQuote, err := info.GetBool(m, "alwaysquote")
+			if err != nil {
+				return nil, err
+			}
+			co.AlwaysQuote = alwaysQuote
+
+			noHeader, err := info.GetBool(m, "noheader")
+			if err != nil {
+				return nil, err
+			}
+			co.NoHeader = noHeader
+
+			typeRow, err := info.GetBool(m, "typerow")
+			if err != nil {
+				return nil, err
+			}
+			co.TypeRow = typeRow
+
+			sliceFormat, err := info.GetString(m, "sliceformat")
+			if err != nil {
+				return nil, err
+			}
+			co.SliceFormat = sliceFormat
+
+			bom, err := info.GetBool(m, "bom")
+			if err != nil {
+				return nil, err
+			}
+			co.BOM = bom
+
+			csvOut, err := csvFunc(r, &co)
 			if err != nil {
 				return nil, err
 			}
@@ -1,8 +1,16 @@
 package gofakeit
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func ExampleCSV_array() {
@@ -25,8 +33,37 @@ func ExampleCSV_array() {
 
 	// Output:
 	// id,first_name,last_name,password
-	// 1,Markus,Moen,Dc0VYXjkWABx
-	// 2,Osborne,Hilll,XPJ9OVNbs5lm
+	// 1,Santos,Rohan,46ow423l5M7q
+	// 2,Ashly,Douglas,f7Bs17Jbw9l5
+	// 3,Rosalee,Pfeffer,D4eu6LqdUTwJ
+}
+
+func TestCSVRowCount(t *testing.T) {
+	for _, count := range []int{1, 2, 10, 100} {
+		value, err := CSV(&CSVOptions{
+			RowCount: count,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+		if len(lines) != count+1 {
+			t.Fatalf("expected %d lines got %d", count+1, len(lines))
+		}
+
+		// Make sure autoincrement starts at 1 and ends at row count
+		if !strings.HasPrefix(lines[1], "1,") {
+			t.Errorf("expected first row id to be 1 got %s", lines[1])
+		}
+		if !strings.HasPrefix(lines[len(lines)-1], strconv.Itoa(count)+",") {
+			t.Errorf("expected last row id to be %d got %s", count, lines[len(lines)-1])
+		}
+	}
 }
 
 func TestCSVLookup(t *testing.T) {
@@ -40,7 +77,7 @@ func TestCSVLookup(t *testing.T) {
 			`{"name":"password","function":"password","params":{"special":["false"],"length":["20"]}}`,
 		},
 	}
-	_, err := info.Call(&m, info)
+	_, err := info.call(globalFaker.ctx(), &m)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -62,7 +99,7 @@ func BenchmarkCSVLookup100(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -83,7 +120,7 @@ func BenchmarkCSVLookup1000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -104,7 +141,7 @@ func BenchmarkCSVLookup10000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -125,9 +162,800 @@ func BenchmarkCSVLookup100000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
 	}
 }
+
+func TestCSVEmbeddedQuotes(t *testing.T) {
+	fields := []Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "quote", Function: "generate", Params: map[string][]string{"str": {`He said "hi", then left`}}},
+	}
+
+	value, err := CSV(&CSVOptions{RowCount: 2, Fields: fields})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := "id,quote\n1,\"He said \"\"hi\"\", then left\"\n2,\"He said \"\"hi\"\", then left\"\n"
+	if string(value) != expected {
+		t.Fatalf("expected %q got %q", expected, string(value))
+	}
+
+	value, err = CSV(&CSVOptions{RowCount: 2, Fields: fields, AlwaysQuote: true})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected = "\"id\",\"quote\"\n\"1\",\"He said \"\"hi\"\", then left\"\n\"2\",\"He said \"\"hi\"\", then left\"\n"
+	if string(value) != expected {
+		t.Fatalf("expected %q got %q", expected, string(value))
+	}
+
+	// Make sure it reads back the same values
+	records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if records[1][1] != `He said "hi", then left` {
+		t.Errorf("expected value to round trip got %s", records[1][1])
+	}
+}
+
+func TestCSVNoHeader(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 2,
+		NoHeader: true,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines got %d", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "1,") {
+		t.Errorf("expected first line to be a data row got %s", lines[0])
+	}
+}
+
+func ExampleCSVHeaderOnly() {
+	value, err := CSVHeaderOnly(&CSVOptions{
+		Delimiter: ";",
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(string(value))
+	// Output: id;first_name
+}
+
+func TestCSVHeaderOnlyAppend(t *testing.T) {
+	co := &CSVOptions{
+		RowCount: 3,
+		NoHeader: true,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "email", Function: "email", ForceQuote: true},
+		},
+	}
+
+	b := &bytes.Buffer{}
+	header, err := CSVHeaderOnly(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	b.Write(header)
+
+	f := New(rand.NewSource(11))
+	for i := 0; i < 2; i++ {
+		if err := f.CSVWriter(b, co); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	records, err := csv.NewReader(b).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(records) != 7 {
+		t.Fatalf("expected a header and 6 rows got %d records", len(records))
+	}
+	if strings.Join(records[0], ",") != "id,first_name,email" {
+		t.Errorf("expected header row got %v", records[0])
+	}
+	for _, record := range records[1:] {
+		if record[0] == "id" {
+			t.Errorf("expected the header only once got %v", records)
+		}
+	}
+
+	if _, err := CSVHeaderOnly(&CSVOptions{}); err == nil {
+		t.Error("expected an error without fields")
+	}
+	if _, err := CSVHeaderOnly(&CSVOptions{Fields: []Field{{Name: "a", Function: "notafunction"}}}); err == nil {
+		t.Error("expected an error for an invalid field")
+	}
+}
+
+func TestCSVTypeRow(t *testing.T) {
+	co := &CSVOptions{
+		RowCount: 3,
+		TypeRow:  true,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price", Params: map[string][]string{"min": {"1"}, "max": {"10"}}},
+			{Name: "active", Function: "bool"},
+			{Name: "created", Function: "timeseries"},
+		},
+	}
+	value, err := CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(records) != 5 {
+		t.Fatalf("expected a header, type row and 3 rows got %d records", len(records))
+	}
+	if strings.Join(records[1], ",") != "int,string,float64,bool,string" {
+		t.Errorf("expected type row aligned to %v got %v", records[0], records[1])
+	}
+	if records[2][0] != "1" {
+		t.Errorf("expected rows to follow the type row got %v", records[2])
+	}
+
+	// The type row belongs to the header so it is skipped along with it
+	co.NoHeader = true
+	value, err = CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if strings.HasPrefix(string(value), "int,") {
+		t.Errorf("expected no type row without a header got %s", value)
+	}
+
+	header, err := CSVHeaderOnly(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(header) != "id,first_name,price,active,created\nint,string,float64,bool,string\n" {
+		t.Errorf("expected header only to include the type row got %s", header)
+	}
+
+	parallel, err := CSVParallel(&CSVOptions{RowCount: 2, TypeRow: true, Fields: co.Fields}, 2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if lines := strings.Split(string(parallel), "\n"); lines[1] != "int,string,float64,bool,string" {
+		t.Errorf("expected parallel type row got %s", lines[1])
+	}
+}
+
+func TestCSVSliceFormat(t *testing.T) {
+	AddFuncLookup("csvtags", Info{
+		Category: "custom",
+		Output:   "[]string",
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			return []string{noun(fakerCtx{Rand: r}), "hard drive", `say "hi"`}, nil
+		},
+	})
+	defer RemoveFuncLookup("csvtags")
+
+	co := &CSVOptions{
+		RowCount: 10,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "tags", Function: "csvtags"},
+			{Name: "rgb", Function: "rgbcolor"},
+		},
+	}
+	value, err := CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, record := range records[1:] {
+		tags := []string{}
+		if err := json.Unmarshal([]byte(record[1]), &tags); err != nil {
+			t.Fatalf("expected tags cell %s to be a json array: %s", record[1], err)
+		}
+		if len(tags) != 3 || tags[1] != "hard drive" || tags[2] != `say "hi"` {
+			t.Fatalf("expected tags to round trip got %v", tags)
+		}
+
+		rgb := []int{}
+		if err := json.Unmarshal([]byte(record[2]), &rgb); err != nil || len(rgb) != 3 {
+			t.Fatalf("expected rgb cell %s to be a json array of 3 numbers", record[2])
+		}
+	}
+
+	co.SliceFormat = "space"
+	value, err = CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	records, err = csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if fields := strings.Fields(records[1][2]); len(fields) != 3 || strings.ContainsAny(records[1][2], "[]") {
+		t.Errorf("expected space separated rgb values got %s", records[1][2])
+	}
+
+	co.SliceFormat = "yaml"
+	if _, err := CSV(co); err == nil {
+		t.Error("expected an error for an invalid slice format")
+	}
+}
+
+func TestCSVBOM(t *testing.T) {
+	bom := []byte{0xEF, 0xBB, 0xBF}
+	co := &CSVOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "first_name", Function: "firstname"},
+			{Name: "city", Function: "city"},
+		},
+	}
+
+	value, err := CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if bytes.HasPrefix(value, bom) {
+		t.Error("expected no byte order mark by default")
+	}
+
+	co.BOM = true
+	value, err = CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.HasPrefix(value, bom) || !bytes.HasPrefix(value[3:], []byte("first_name,city\n")) {
+		t.Errorf("expected a byte order mark before the header got %q", value[:10])
+	}
+	if bytes.Count(value, bom) != 1 {
+		t.Error("expected a single byte order mark")
+	}
+
+	parallel, err := CSVParallel(&CSVOptions{RowCount: 2500, BOM: true, Fields: co.Fields}, 4)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.HasPrefix(parallel, bom) || bytes.Count(parallel, bom) != 1 {
+		t.Error("expected parallel output to start with a single byte order mark")
+	}
+
+	header, err := CSVHeaderOnly(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(header) != "\xEF\xBB\xBFfirst_name,city\n" {
+		t.Errorf("expected header only to start with a byte order mark got %q", header)
+	}
+
+	info := GetFuncLookup("csv")
+	m := map[string][]string{
+		"rowcount": {"2"},
+		"fields":   {`{"name":"first_name","function":"firstname"}`},
+		"bom":      {"true"},
+	}
+	lookup, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.HasPrefix(lookup.([]byte), bom) {
+		t.Error("expected the lookup to pass the bom param")
+	}
+}
+
+func TestCSVLookupQuoting(t *testing.T) {
+	info := GetFuncLookup("csv")
+
+	m := map[string][]string{
+		"rowcount":    {"3"},
+		"alwaysquote": {"true"},
+		"noheader":    {"true"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+		},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := "\"1\"\n\"2\"\n\"3\"\n"
+	if string(value.([]byte)) != expected {
+		t.Fatalf("expected %q got %q", expected, string(value.([]byte)))
+	}
+}
+
+func TestCSVDelimiter(t *testing.T) {
+	for _, delimiter := range []string{";", "|", "tab"} {
+		value, err := CSV(&CSVOptions{
+			Delimiter: delimiter,
+			RowCount:  2,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		r := csv.NewReader(bytes.NewReader(value))
+		if delimiter == "tab" {
+			r.Comma = '\t'
+		} else {
+			r.Comma = []rune(delimiter)[0]
+		}
+		records, err := r.ReadAll()
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if len(records) != 3 || len(records[0]) != 2 {
+			t.Errorf("delimiter %s did not split rows correctly: %v", delimiter, records)
+		}
+	}
+
+	_, err := CSV(&CSVOptions{
+		Delimiter: "||",
+		RowCount:  2,
+		Fields:    []Field{{Name: "id", Function: "autoincrement"}},
+	})
+	if err == nil {
+		t.Error("expected error for multi character delimiter")
+	}
+}
+
+func TestCSVInvalidDelimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter string
+	}{
+		{"quote", `"`},
+		{"carriage return", "\r"},
+		{"line feed", "\n"},
+		{"null", "\x00"},
+		{"invalid utf-8", "\xff"},
+		{"replacement character", "\uFFFD"},
+	}
+
+	for _, test := range tests {
+		for _, alwaysQuote := range []bool{false, true} {
+			_, err := CSV(&CSVOptions{
+				Delimiter:   test.delimiter,
+				AlwaysQuote: alwaysQuote,
+				RowCount:    2,
+				Fields:      []Field{{Name: "first_name", Function: "firstname"}},
+			})
+			if err == nil {
+				t.Errorf("expected error for %s delimiter with always quote %t", test.name, alwaysQuote)
+			}
+		}
+	}
+}
+
+func ExampleCSVWriter() {
+	Seed(11)
+
+	err := CSVWriter(os.Stdout, &CSVOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// id,first_name,last_name,password
+	// 1,Santos,Rohan,46ow423l5M7q
+	// 2,Ashly,Douglas,f7Bs17Jbw9l5
+	// 3,Rosalee,Pfeffer,D4eu6LqdUTwJ
+}
+
+func TestCSVWriter(t *testing.T) {
+	b := &bytes.Buffer{}
+	err := CSVWriter(b, &CSVOptions{
+		RowCount: 2500,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "sentence", Function: "sentence"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	records, err := csv.NewReader(b).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(records) != 2501 {
+		t.Fatalf("expected 2501 records got %d", len(records))
+	}
+	if records[2500][0] != "2500" {
+		t.Errorf("expected last id to be 2500 got %s", records[2500][0])
+	}
+}
+
+func ExampleFaker_CSV() {
+	f := New(rand.NewSource(11))
+
+	value, err := f.CSV(&CSVOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output:
+	// id,first_name,last_name,password
+	// 1,Santos,Rohan,46ow423l5M7q
+	// 2,Ashly,Douglas,f7Bs17Jbw9l5
+	// 3,Rosalee,Pfeffer,D4eu6LqdUTwJ
+}
+
+func TestFakerCSVSeeded(t *testing.T) {
+	co := &CSVOptions{
+		RowCount: 50,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "name", Function: "name"},
+			{Name: "address", Function: "address"},
+			{Name: "sentence", Function: "sentence", Params: map[string][]string{"wordcount": {"8"}}},
+			{Name: "generated", Function: "generate", Params: map[string][]string{"str": {"{firstname} ### {number:1,100}"}}},
+		},
+	}
+
+	f1 := New(rand.NewSource(42))
+	f2 := New(rand.NewSource(42))
+
+	// Use the global source in between to make sure it does not bleed into the fakers
+	v1, err := f1.CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	Name()
+	v2, err := f2.CSV(co)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !bytes.Equal(v1, v2) {
+		t.Errorf("expected identically seeded fakers to generate identical csv\n%s\n%s", v1, v2)
+	}
+}
+
+func TestCSVAutoIncrementParams(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement", Params: map[string][]string{"start": {"1000"}, "step": {"5"}}},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "row", Function: "autoincrement"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for i, expected := range []string{"1000", "1005", "1010"} {
+		if records[i+1][0] != expected {
+			t.Errorf("expected id %s got %s", expected, records[i+1][0])
+		}
+		if records[i+1][1] == "" {
+			t.Errorf("expected first name to be generated on row %d", i+1)
+		}
+		if records[i+1][2] != strconv.Itoa(i+1) {
+			t.Errorf("expected default autoincrement %d got %s", i+1, records[i+1][2])
+		}
+	}
+
+	_, err = CSV(&CSVOptions{
+		RowCount: 1,
+		Fields:   []Field{{Name: "id", Function: "autoincrement", Params: map[string][]string{"step": {"five"}}}},
+	})
+	if err == nil {
+		t.Error("expected error for invalid step param")
+	}
+}
+
+func TestCSVNullProbability(t *testing.T) {
+	for _, prob := range []float32{1, 0} {
+		value, err := CSV(&CSVOptions{
+			RowCount: 100,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname", NullProbability: prob},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rows, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, row := range rows[1:] {
+			if prob == 1 && row[1] != "" {
+				t.Fatalf("expected empty value with probability 1, got %s", row[1])
+			}
+			if prob == 0 && row[1] == "" {
+				t.Fatal("expected value with probability 0")
+			}
+			if row[0] == "" {
+				t.Fatal("expected id to never be empty")
+			}
+		}
+	}
+}
+
+func TestCSVNullProbabilitySeeded(t *testing.T) {
+	opts := func() *CSVOptions {
+		return &CSVOptions{
+			RowCount: 50,
+			Fields:   []Field{{Name: "first_name", Function: "firstname", NullProbability: 0.5}},
+		}
+	}
+
+	a, err := New(rand.NewSource(11)).CSV(opts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(rand.NewSource(11)).CSV(opts())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(a) != string(b) {
+		t.Error("expected the same nulls for the same seed")
+	}
+}
+
+func TestCSVParallel(t *testing.T) {
+	fields := []Field{
+		{Name: "id", Function: "autoincrement", Params: map[string][]string{"start": {"100"}}},
+		{Name: "first_name", Function: "firstname"},
+		{Name: "email", Function: "email", NullProbability: 0.2},
+		{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hi {first_name}"}}},
+	}
+
+	// The serial output from CSV and CSVWriter is what every worker count has to match
+	Seed(11)
+	serial, err := CSV(&CSVOptions{RowCount: 2500, Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	Seed(11)
+	var w bytes.Buffer
+	if err := CSVWriter(&w, &CSVOptions{RowCount: 2500, Fields: fields}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(serial, w.Bytes()) {
+		t.Fatal("expected csv writer to match csv")
+	}
+
+	for _, workers := range []int{1, 2, 3, 8, 0} {
+		Seed(11)
+		parallel, err := CSVParallel(&CSVOptions{RowCount: 2500, Fields: fields}, workers)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(serial, parallel) {
+			t.Fatalf("expected %d workers to match the serial output", workers)
+		}
+	}
+
+	// Auto increment keeps counting across chunks
+	records, err := csv.NewReader(bytes.NewReader(serial)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2501 {
+		t.Fatalf("expected header and 2500 rows, got %d", len(records))
+	}
+	for i, record := range records[1:] {
+		if record[0] != strconv.Itoa(100+i) {
+			t.Fatalf("expected id %d, got %s", 100+i, record[0])
+		}
+		if record[3] != "hi "+record[1] {
+			t.Fatalf("expected greeting to reference first_name, got %s", record[3])
+		}
+	}
+}
+
+func TestCSVChunkSeed(t *testing.T) {
+	// Neighbouring base seeds and chunks cant share a seed like base+chunk would
+	seen := map[int64]bool{}
+	for base := int64(0); base < 100; base++ {
+		for chunk := 0; chunk < 100; chunk++ {
+			seed := csvChunkSeed(base, chunk)
+			if seen[seed] {
+				t.Fatalf("expected a unique seed for base %d chunk %d", base, chunk)
+			}
+			seen[seed] = true
+		}
+	}
+}
+
+func TestCSVParallelSetData(t *testing.T) {
+	f := New(rand.NewSource(11))
+	f.SetData("firstname", []string{"Ada"})
+	defer f.SetData("firstname", nil)
+
+	value, err := f.CSVParallel(&CSVOptions{RowCount: 1500, NoHeader: true, Fields: []Field{{Name: "first_name", Function: "firstname"}}}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(string(value), "Ada\n") != 1500 {
+		t.Error("expected every worker to use the fakers data overrides")
+	}
+}
+
+func TestCSVParallelErrors(t *testing.T) {
+	if _, err := CSVParallel(&CSVOptions{RowCount: 10}, 2); err == nil {
+		t.Error("expected error for missing fields")
+	}
+	if _, err := CSVParallel(&CSVOptions{RowCount: 2000, Fields: []Field{{Name: "a", Function: "notafunction"}}}, 2); err == nil {
+		t.Error("expected error for invalid function")
+	}
+}
+
+func BenchmarkCSVParallel100000(b *testing.B) {
+	co := &CSVOptions{
+		RowCount: 100000,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password"},
+		},
+	}
+	for i := 0; i < b.N; i++ {
+		CSVParallel(co, 0)
+	}
+}
+
+func TestCSVTimeSeries(t *testing.T) {
+	co := &CSVOptions{
+		RowCount: 2500,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "at", Function: "timeseries", Params: map[string][]string{"start": {"2021-03-04T05:06:07Z"}, "interval": {"1s"}, "jitter": {"250ms"}}},
+		},
+	}
+
+	// Parallel chunks get the same row numbers as a single pass
+	for _, generate := range []func() ([]byte, error){
+		func() ([]byte, error) { return CSV(co) },
+		func() ([]byte, error) { return CSVParallel(co, 4) },
+	} {
+		value, err := generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		start := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+		var last time.Time
+		for i, record := range records[1:] {
+			at, err := time.Parse(time.RFC3339Nano, record[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			base := start.Add(time.Duration(i) * time.Second)
+			if at.Before(base) || !at.Before(base.Add(250*time.Millisecond)) {
+				t.Fatalf("expected row %d between %s and 250ms later, got %s", i+1, base, at)
+			}
+			if i > 0 && !at.After(last) {
+				t.Fatalf("expected timestamps to strictly increase, got %s after %s", at, last)
+			}
+			last = at
+		}
+	}
+}
+
+func TestCSVTimeSeriesErrors(t *testing.T) {
+	for _, params := range []map[string][]string{
+		{"start": {"yesterday"}},
+		{"interval": {"0s"}},
+		{"interval": {"fast"}},
+		{"interval": {"1s"}, "jitter": {"1s"}},
+		{"jitter": {"-1ms"}},
+	} {
+		_, err := CSV(&CSVOptions{RowCount: 1, Fields: []Field{{Name: "at", Function: "timeseries", Params: params}}})
+		if err == nil {
+			t.Errorf("expected error for params %v", params)
+		}
+	}
+}
+
+func TestCSVForceQuote(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 2,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "notes", Function: "generate", Params: map[string][]string{"str": {"plain"}}, ForceQuote: true},
+			{Name: "quote", Function: "generate", Params: map[string][]string{"str": {`say "hi"`}}},
+			{Name: "count", Function: "generate", Params: map[string][]string{"str": {"42"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `id,"notes",quote,count
+1,"plain","say ""hi""",42
+2,"plain","say ""hi""",42
+`
+	if string(value) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, value)
+	}
+}
+
+func TestCSVNeedsQuotes(t *testing.T) {
+	// Unforced columns are written the same as csv.Writer would
+	for _, field := range []string{"", "a", "a b", " a", "\ta", "a,b", "a;b", `a"b`, "a\nb", "a\rb", `\.`, "é", " a"} {
+		for _, comma := range []rune{',', ';', '\t'} {
+			b := &bytes.Buffer{}
+			cw := csv.NewWriter(b)
+			cw.Comma = comma
+			cw.Write([]string{field})
+			cw.Flush()
+
+			q := &bytes.Buffer{}
+			csvWriteQuoted(q, comma, []string{field}, []bool{false})
+			if b.String() != q.String() {
+				t.Errorf("expected %q with comma %q to be written as %q, got %q", field, comma, b.String(), q.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package data
+
+// USCity is a city with the state it is in, the first three digits of its zip codes and its coordinates
+type USCity struct {
+	City      string
+	State     string
+	StateAbr  string
+	ZipPrefix string
+	Latitude  float64
+	Longitude float64
+}
+
+// USCities consists of cities for every state, used to generate addresses where the city, state and zip agree
+var USCities = []USCity{
+	{City: "Birmingham", State: "Alabama", StateAbr: "AL", ZipPrefix: "352", Latitude: 33.52, Longitude: -86.80},
+	{City: "Montgomery", State: "Alabama", StateAbr: "AL", ZipPrefix: "361", Latitude: 32.38, Longitude: -86.30},
+	{City: "Anchorage", State: "Alaska", StateAbr: "AK", ZipPrefix: "995", Latitude: 61.22, Longitude: -149.90},
+	{City: "Juneau", State: "Alaska", StateAbr: "AK", ZipPrefix: "998", Latitude: 58.30, Longitude: -134.42},
+	{City: "Phoenix", State: "Arizona", StateAbr: "AZ", ZipPrefix: "850", Latitude: 33.45, Longitude: -112.07},
+	{City: "Tucson", State: "Arizona", StateAbr: "AZ", ZipPrefix: "857", Latitude: 32.22, Longitude: -110.97},
+	{City: "Little Rock", State: "Arkansas", StateAbr: "AR", ZipPrefix: "722", Latitude: 34.75, Longitude: -92.29},
+	{City: "Fayetteville", State: "Arkansas", StateAbr: "AR", ZipPrefix: "727", Latitude: 36.06, Longitude: -94.16},
+	{City: "Los Angeles", State: "California", StateAbr: "CA", ZipPrefix: "900", Latitude: 34.05, Longitude: -118.24},
+	{City: "San Francisco", State: "California", StateAbr: "CA", ZipPrefix: "941", Latitude: 37.77, Longitude: -122.42},
+	{City: "San Diego", State: "California", StateAbr: "CA", ZipPrefix: "921", Latitude: 32.72, Longitude: -117.16},
+	{City: "Sacramento", State: "California", StateAbr: "CA", ZipPrefix: "958", Latitude: 38.58, Longitude: -121.49},
+	{City: "Denver", State: "Colorado", StateAbr: "CO", ZipPrefix: "802", Latitude: 39.74, Longitude: -104.99},
+	{City: "Colorado Springs", State: "Colorado", StateAbr: "CO", ZipPrefix: "809", Latitude: 38.83, Longitude: -104.82},
+	{City: "Hartford", State: "Connecticut", StateAbr: "CT", ZipPrefix: "061", Latitude: 41.76, Longitude: -72.67},
+	{City: "New Haven", State: "Connecticut", StateAbr: "CT", ZipPrefix: "065", Latitude: 41.31, Longitude: -72.92},
+	{City: "Wilmington", State: "Delaware", StateAbr: "DE", ZipPrefix: "198", Latitude: 39.74, Longitude: -75.55},
+	{City: "Dover", State: "Delaware", StateAbr: "DE", ZipPrefix: "199", Latitude: 39.16, Longitude: -75.52},
+	{City: "Miami", State: "Florida", StateAbr: "FL", ZipPrefix: "331", Latitude: 25.76, Longitude: -80.19},
+	{City: "Orlando", State: "Florida", StateAbr: "FL", ZipPrefix: "328", Latitude: 28.54, Longitude: -81.38},
+	{City: "Tampa", State: "Florida", StateAbr: "FL", ZipPrefix: "336", Latitude: 27.95, Longitude: -82.46},
+	{City: "Atlanta", State: "Georgia", StateAbr: "GA", ZipPrefix: "303", Latitude: 33.75, Longitude: -84.39},
+	{City: "Savannah", State: "Georgia", StateAbr: "GA", ZipPrefix: "314", Latitude: 32.08, Longitude: -81.09},
+	{City: "Honolulu", State: "Hawaii", StateAbr: "HI", ZipPrefix: "968", Latitude: 21.31, Longitude: -157.86},
+	{City: "Hilo", State: "Hawaii", StateAbr: "HI", ZipPrefix: "967", Latitude: 19.72, Longitude: -155.08},
+	{City: "Boise", State: "Idaho", StateAbr: "ID", ZipPrefix: "837", Latitude: 43.62, Longitude: -116.20},
+	{City: "Idaho Falls", State: "Idaho", StateAbr: "ID", ZipPrefix: "834", Latitude: 43.49, Longitude: -112.03},
+	{City: "Chicago", State: "Illinois", StateAbr: "IL", ZipPrefix: "606", Latitude: 41.88, Longitude: -87.63},
+	{City: "Springfield", State: "Illinois", StateAbr: "IL", ZipPrefix: "627", Latitude: 39.78, Longitude: -89.65},
+	{City: "Indianapolis", State: "Indiana", StateAbr: "IN", ZipPrefix: "462", Latitude: 39.77, Longitude: -86.16},
+	{City: "Fort Wayne", State: "Indiana", StateAbr: "IN", ZipPrefix: "468", Latitude: 41.08, Longitude: -85.14},
+	{City: "Des Moines", State: "Iowa", StateAbr: "IA", ZipPrefix: "503", Latitude: 41.59, Longitude: -93.62},
+	{City: "Cedar Rapids", State: "Iowa", StateAbr: "IA", ZipPrefix: "524", Latitude: 41.98, Longitude: -91.67},
+	{City: "Wichita", State: "Kansas", StateAbr: "KS", ZipPrefix: "672", Latitude: 37.69, Longitude: -97.34},
+	{City: "Topeka", State: "Kansas", StateAbr: "KS", ZipPrefix: "666", Latitude: 39.05, Longitude: -95.68},
+	{City: "Louisville", State: "Kentucky", StateAbr: "KY", ZipPrefix: "402", Latitude: 38.25, Longitude: -85.76},
+	{City: "Lexington", State: "Kentucky", StateAbr: "KY", ZipPrefix: "405", Latitude: 38.04, Longitude: -84.50},
+	{City: "New Orleans", State: "Louisiana", StateAbr: "LA", ZipPrefix: "701", Latitude: 29.95, Longitude: -90.07},
+	{City: "Baton Rouge", State: "Louisiana", StateAbr: "LA", ZipPrefix: "708", Latitude: 30.45, Longitude: -91.15},
+	{City: "Portland", State: "Maine", StateAbr: "ME", ZipPrefix: "041", Latitude: 43.66, Longitude: -70.26},
+	{City: "Bangor", State: "Maine", StateAbr: "ME", ZipPrefix: "044", Latitude: 44.80, Longitude: -68.77},
+	{City: "Baltimore", State: "Maryland", StateAbr: "MD", ZipPrefix: "212", Latitude: 39.29, Longitude: -76.61},
+	{City: "Annapolis", State: "Maryland", StateAbr: "MD", ZipPrefix: "214", Latitude: 38.98, Longitude: -76.49},
+	{City: "Boston", State: "Massachusetts", StateAbr: "MA", ZipPrefix: "021", Latitude: 42.36, Longitude: -71.06},
+	{City: "Worcester", State: "Massachusetts", StateAbr: "MA", ZipPrefix: "016", Latitude: 42.26, Longitude: -71.80},
+	{City: "Detroit", State: "Michigan", StateAbr: "MI", ZipPrefix: "482", Latitude: 42.33, Longitude: -83.05},
+	{City: "Grand Rapids", State: "Michigan", StateAbr: "MI", ZipPrefix: "495", Latitude: 42.96, Longitude: -85.67},
+	{City: "Minneapolis", State: "Minnesota", StateAbr: "MN", ZipPrefix: "554", Latitude: 44.98, Longitude: -93.27},
+	{City: "Duluth", State: "Minnesota", StateAbr: "MN", ZipPrefix: "558", Latitude: 46.79, Longitude: -92.10},
+	{City: "Jackson", State: "Mississippi", StateAbr: "MS", ZipPrefix: "392", Latitude: 32.30, Longitude: -90.18},
+	{City: "Gulfport", State: "Mississippi", StateAbr: "MS", ZipPrefix: "395", Latitude: 30.37, Longitude: -89.09},
+	{City: "Kansas City", State: "Missouri", StateAbr: "MO", ZipPrefix: "641", Latitude: 39.10, Longitude: -94.58},
+	{City: "St. Louis", State: "Missouri", StateAbr: "MO", ZipPrefix: "631", Latitude: 38.63, Longitude: -90.20},
+	{City: "Billings", State: "Montana", StateAbr: "MT", ZipPrefix: "591", Latitude: 45.78, Longitude: -108.50},
+	{City: "Missoula", State: "Montana", StateAbr: "MT", ZipPrefix: "598", Latitude: 46.87, Longitude: -113.99},
+	{City: "Omaha", State: "Nebraska", StateAbr: "NE", ZipPrefix: "681", Latitude: 41.26, Longitude: -95.93},
+	{City: "Lincoln", State: "Nebraska", StateAbr: "NE", ZipPrefix: "685", Latitude: 40.81, Longitude: -96.70},
+	{City: "Las Vegas", State: "Nevada", StateAbr: "NV", ZipPrefix: "891", Latitude: 36.17, Longitude: -115.14},
+	{City: "Reno", State: "Nevada", StateAbr: "NV", ZipPrefix: "895", Latitude: 39.53, Longitude: -119.81},
+	{City: "Manchester", State: "New Hampshire", StateAbr: "NH", ZipPrefix: "031", Latitude: 42.99, Longitude: -71.46},
+	{City: "Concord", State: "New Hampshire", StateAbr: "NH", ZipPrefix: "033", Latitude: 43.21, Longitude: -71.54},
+	{City: "Newark", State: "New Jersey", StateAbr: "NJ", ZipPrefix: "071", Latitude: 40.74, Longitude: -74.17},
+	{City: "Trenton", State: "New Jersey", StateAbr: "NJ", ZipPrefix: "086", Latitude: 40.22, Longitude: -74.76},
+	{City: "Albuquerque", State: "New Mexico", StateAbr: "NM", ZipPrefix: "871", Latitude: 35.08, Longitude: -106.65},
+	{City: "Santa Fe", State: "New Mexico", StateAbr: "NM", ZipPrefix: "875", Latitude: 35.69, Longitude: -105.94},
+	{City: "New York", State: "New York", StateAbr: "NY", ZipPrefix: "100", Latitude: 40.71, Longitude: -74.01},
+	{City: "Buffalo", State: "New York", StateAbr: "NY", ZipPrefix: "142", Latitude: 42.89, Longitude: -78.88},
+	{City: "Albany", State: "New York", StateAbr: "NY", ZipPrefix: "122", Latitude: 42.65, Longitude: -73.75},
+	{City: "Charlotte", State: "North Carolina", StateAbr: "NC", ZipPrefix: "282", Latitude: 35.23, Longitude: -80.84},
+	{City: "Raleigh", State: "North Carolina", StateAbr: "NC", ZipPrefix: "276", Latitude: 35.78, Longitude: -78.64},
+	{City: "Fargo", State: "North Dakota", StateAbr: "ND", ZipPrefix: "581", Latitude: 46.88, Longitude: -96.79},
+	{City: "Bismarck", State: "North Dakota", StateAbr: "ND", ZipPrefix: "585", Latitude: 46.81, Longitude: -100.78},
+	{City: "Columbus", State: "Ohio", StateAbr: "OH", ZipPrefix: "432", Latitude: 39.96, Longitude: -83.00},
+	{City: "Cleveland", State: "Ohio", StateAbr: "OH", ZipPrefix: "441", Latitude: 41.50, Longitude: -81.69},
+	{City: "Cincinnati", State: "Ohio", StateAbr: "OH", ZipPrefix: "452", Latitude: 39.10, Longitude: -84.51},
+	{City: "Oklahoma City", State: "Oklahoma", StateAbr: "OK", ZipPrefix: "731", Latitude: 35.47, Longitude: -97.52},
+	{City: "Tulsa", State: "Oklahoma", StateAbr: "OK", ZipPrefix: "741", Latitude: 36.15, Longitude: -95.99},
+	{City: "Portland", State: "Oregon", StateAbr: "OR", ZipPrefix: "972", Latitude: 45.52, Longitude: -122.68},
+	{City: "Eugene", State: "Oregon", StateAbr: "OR", ZipPrefix: "974", Latitude: 44.05, Longitude: -123.09},
+	{City: "Philadelphia", State: "Pennsylvania", StateAbr: "PA", ZipPrefix: "191", Latitude: 39.95, Longitude: -75.17},
+	{City: "Pittsburgh", State: "Pennsylvania", StateAbr: "PA", ZipPrefix: "152", Latitude: 40.44, Longitude: -80.00},
+	{City: "Providence", State: "Rhode Island", StateAbr: "RI", ZipPrefix: "029", Latitude: 41.82, Longitude: -71.41},
+	{City: "Newport", State: "Rhode Island", StateAbr: "RI", ZipPrefix: "028", Latitude: 41.49, Longitude: -71.31},
+	{City: "Columbia", State: "South Carolina", StateAbr: "SC", ZipPrefix: "292", Latitude: 34.00, Longitude: -81.03},
+	{City: "Charleston", State: "South Carolina", StateAbr: "SC", ZipPrefix: "294", Latitude: 32.78, Longitude: -79.93},
+	{City: "Sioux Falls", State: "South Dakota", StateAbr: "SD", ZipPrefix: "571", Latitude: 43.54, Longitude: -96.73},
+	{City: "Rapid City", State: "South Dakota", StateAbr: "SD", ZipPrefix: "577", Latitude: 44.08, Longitude: -103.23},
+	{City: "Nashville", State: "Tennessee", StateAbr: "TN", ZipPrefix: "372", Latitude: 36.16, Longitude: -86.78},
+	{City: "Memphis", State: "Tennessee", StateAbr: "TN", ZipPrefix: "381", Latitude: 35.15, Longitude: -90.05},
+	{City: "Houston", State: "Texas", StateAbr: "TX", ZipPrefix: "770", Latitude: 29.76, Longitude: -95.37},
+	{City: "Dallas", State: "Texas", StateAbr: "TX", ZipPrefix: "752", Latitude: 32.78, Longitude: -96.80},
+	{City: "Austin", State: "Texas", StateAbr: "TX", ZipPrefix: "787", Latitude: 30.27, Longitude: -97.74},
+	{City: "San Antonio", State: "Texas", StateAbr: "TX", ZipPrefix: "782", Latitude: 29.42, Longitude: -98.49},
+	{City: "Salt Lake City", State: "Utah", StateAbr: "UT", ZipPrefix: "841", Latitude: 40.76, Longitude: -111.89},
+	{City: "Provo", State: "Utah", StateAbr: "UT", ZipPrefix: "846", Latitude: 40.23, Longitude: -111.66},
+	{City: "Burlington", State: "Vermont", StateAbr: "VT", ZipPrefix: "054", Latitude: 44.48, Longitude: -73.21},
+	{City: "Montpelier", State: "Vermont", StateAbr: "VT", ZipPrefix: "056", Latitude: 44.26, Longitude: -72.58},
+	{City: "Richmond", State: "Virginia", StateAbr: "VA", ZipPrefix: "232", Latitude: 37.54, Longitude: -77.44},
+	{City: "Virginia Beach", State: "Virginia", StateAbr: "VA", ZipPrefix: "234", Latitude: 36.85, Longitude: -75.98},
+	{City: "Seattle", State: "Washington", StateAbr: "WA", ZipPrefix: "981", Latitude: 47.61, Longitude: -122.33},
+	{City: "Spokane", State: "Washington", StateAbr: "WA", ZipPrefix: "992", Latitude: 47.66, Longitude: -117.43},
+	{City: "Charleston", State: "West Virginia", StateAbr: "WV", ZipPrefix: "253", Latitude: 38.35, Longitude: -81.63},
+	{City: "Morgantown", State: "West Virginia", StateAbr: "WV", ZipPrefix: "265", Latitude: 39.63, Longitude: -79.96},
+	{City: "Milwaukee", State: "Wisconsin", StateAbr: "WI", ZipPrefix: "532", Latitude: 43.04, Longitude: -87.91},
+	{City: "Madison", State: "Wisconsin", StateAbr: "WI", ZipPrefix: "537", Latitude: 43.07, Longitude: -89.40},
+	{City: "Cheyenne", State: "Wyoming", StateAbr: "WY", ZipPrefix: "820", Latitude: 41.14, Longitude: -104.82},
+	{City: "Casper", State: "Wyoming", StateAbr: "WY", ZipPrefix: "826", Latitude: 42.87, Longitude: -106.31},
+}
@@ -0,0 +1,55 @@
+package data
+
+// CurrencyFormat contains how amounts of an ISO 4217 currency are commonly written
+type CurrencyFormat struct {
+	Symbol   string
+	Decimals int    // ISO 4217 minor unit digits
+	Group    string // separator between groups of digits
+	Decimal  string // separator before the decimals
+	Grouping []int  // digits per group from the right, the last size repeats. Defaults to 3
+	Pattern  string // where # is replaced by the amount, like $# or # €
+}
+
+// CurrencyFormats consists of currency formatting keyed by ISO 4217 code
+var CurrencyFormats = map[string]CurrencyFormat{
+	"AED": {Symbol: "AED", Decimals: 2, Group: ",", Decimal: ".", Pattern: "AED #"},
+	"ARS": {Symbol: "$", Decimals: 2, Group: ".", Decimal: ",", Pattern: "$ #"},
+	"AUD": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"BHD": {Symbol: "BD", Decimals: 3, Group: ",", Decimal: ".", Pattern: "BD #"},
+	"BRL": {Symbol: "R$", Decimals: 2, Group: ".", Decimal: ",", Pattern: "R$ #"},
+	"CAD": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"CHF": {Symbol: "CHF", Decimals: 2, Group: "'", Decimal: ".", Pattern: "CHF #"},
+	"CLP": {Symbol: "$", Decimals: 0, Group: ".", Decimal: ",", Pattern: "$#"},
+	"CNY": {Symbol: "¥", Decimals: 2, Group: ",", Decimal: ".", Pattern: "¥#"},
+	"COP": {Symbol: "$", Decimals: 2, Group: ".", Decimal: ",", Pattern: "$ #"},
+	"CZK": {Symbol: "Kč", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# Kč"},
+	"DKK": {Symbol: "kr.", Decimals: 2, Group: ".", Decimal: ",", Pattern: "# kr."},
+	"EGP": {Symbol: "E£", Decimals: 2, Group: ",", Decimal: ".", Pattern: "E£#"},
+	"EUR": {Symbol: "€", Decimals: 2, Group: ".", Decimal: ",", Pattern: "# €"},
+	"GBP": {Symbol: "£", Decimals: 2, Group: ",", Decimal: ".", Pattern: "£#"},
+	"HKD": {Symbol: "HK$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "HK$#"},
+	"HUF": {Symbol: "Ft", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# Ft"},
+	"IDR": {Symbol: "Rp", Decimals: 2, Group: ".", Decimal: ",", Pattern: "Rp#"},
+	"ILS": {Symbol: "₪", Decimals: 2, Group: ",", Decimal: ".", Pattern: "₪#"},
+	"INR": {Symbol: "₹", Decimals: 2, Group: ",", Decimal: ".", Grouping: []int{3, 2}, Pattern: "₹#"},
+	"ISK": {Symbol: "kr", Decimals: 0, Group: ".", Decimal: ",", Pattern: "# kr"},
+	"JOD": {Symbol: "JD", Decimals: 3, Group: ",", Decimal: ".", Pattern: "JD #"},
+	"JPY": {Symbol: "¥", Decimals: 0, Group: ",", Decimal: ".", Pattern: "¥#"},
+	"KRW": {Symbol: "₩", Decimals: 0, Group: ",", Decimal: ".", Pattern: "₩#"},
+	"KWD": {Symbol: "KD", Decimals: 3, Group: ",", Decimal: ".", Pattern: "KD #"},
+	"MXN": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"NGN": {Symbol: "₦", Decimals: 2, Group: ",", Decimal: ".", Pattern: "₦#"},
+	"NOK": {Symbol: "kr", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# kr"},
+	"NZD": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"PHP": {Symbol: "₱", Decimals: 2, Group: ",", Decimal: ".", Pattern: "₱#"},
+	"PLN": {Symbol: "zł", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# zł"},
+	"RUB": {Symbol: "₽", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# ₽"},
+	"SAR": {Symbol: "SAR", Decimals: 2, Group: ",", Decimal: ".", Pattern: "SAR #"},
+	"SEK": {Symbol: "kr", Decimals: 2, Group: " ", Decimal: ",", Pattern: "# kr"},
+	"SGD": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"THB": {Symbol: "฿", Decimals: 2, Group: ",", Decimal: ".", Pattern: "฿#"},
+	"TRY": {Symbol: "₺", Decimals: 2, Group: ".", Decimal: ",", Pattern: "₺#"},
+	"USD": {Symbol: "$", Decimals: 2, Group: ",", Decimal: ".", Pattern: "$#"},
+	"VND": {Symbol: "₫", Decimals: 0, Group: ".", Decimal: ",", Pattern: "# ₫"},
+	"ZAR": {Symbol: "R", Decimals: 2, Group: " ", Decimal: ",", Pattern: "R #"},
+}
@@ -23,6 +23,8 @@ var Data = map[string]map[string][]string{
 	"emoji":     Emoji,
 	"word":      Word,
 	"food":      Food,
+	"product":   Product,
+	"error":     Error,
 }
 
 // IntData consists of the main set of fake information (integer only)
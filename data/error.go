@@ -0,0 +1,69 @@
+package data
+
+// Error consists of realistic error messages
+var Error = map[string][]string{
+	"database": {
+		"sql: no rows in result set",
+		"sql: database is closed",
+		"sql: transaction has already been committed or rolled back",
+		"pq: duplicate key value violates unique constraint",
+		"pq: deadlock detected",
+		"pq: relation does not exist",
+		"Error 1062: Duplicate entry for key PRIMARY",
+		"Error 1213: Deadlock found when trying to get lock",
+		"connection pool exhausted",
+		"too many connections",
+	},
+	"network": {
+		"dial tcp: lookup failed: no such host",
+		"dial tcp: connect: connection refused",
+		"read: connection reset by peer",
+		"write: broken pipe",
+		"i/o timeout",
+		"context deadline exceeded",
+		"tls: handshake failure",
+		"x509: certificate has expired or is not yet valid",
+		"net/http: request canceled while waiting for connection",
+		"unexpected EOF",
+	},
+	"permission": {
+		"permission denied",
+		"access denied for user",
+		"invalid api key",
+		"token expired",
+		"insufficient scope",
+		"operation not permitted",
+	},
+	"runtime": {
+		"runtime error: index out of range",
+		"runtime error: invalid memory address or nil pointer dereference",
+		"runtime error: integer divide by zero",
+		"assignment to entry in nil map",
+		"all goroutines are asleep - deadlock",
+		"out of memory",
+		"context canceled",
+	},
+	"validation": {
+		"invalid email address",
+		"field is required",
+		"value is out of range",
+		"invalid date format",
+		"unexpected end of JSON input",
+		"invalid character looking for beginning of value",
+		"strconv.Atoi: parsing: invalid syntax",
+		"password must be at least 8 characters",
+	},
+	"http_400": {"the request body could not be parsed", "missing required parameter", "unexpected end of JSON input"},
+	"http_401": {"authentication credentials were not provided", "invalid api key", "token expired"},
+	"http_403": {"you do not have permission to access this resource", "insufficient scope", "account is suspended"},
+	"http_404": {"the requested resource could not be found", "user does not exist", "no route matches the path"},
+	"http_405": {"method is not allowed for this resource"},
+	"http_408": {"the client did not send a request in time"},
+	"http_409": {"the resource was modified by another request", "a resource with that name already exists"},
+	"http_422": {"invalid email address", "field is required", "value is out of range"},
+	"http_429": {"rate limit exceeded, retry later", "too many requests from this ip"},
+	"http_500": {"an unexpected error occurred", "database connection failed", "runtime error: invalid memory address or nil pointer dereference"},
+	"http_502": {"upstream service returned an invalid response", "bad response from the origin server"},
+	"http_503": {"service is temporarily unavailable", "the server is shutting down", "down for maintenance"},
+	"http_504": {"upstream service did not respond in time", "context deadline exceeded"},
+}
@@ -209,3 +209,17 @@ var CreditCards = map[string]CreditCardInfo{
 		},
 	},
 }
+
+// IBANFormats contains the bban structure for each supported iban country
+// n is a digit, a is an upper case letter and c is an upper case letter or digit
+var IBANFormats = map[string]string{
+	"AT": "nnnnnnnnnnnnnnnn",
+	"BE": "nnnnnnnnnnnn",
+	"CH": "nnnnncccccccccccc",
+	"DE": "nnnnnnnnnnnnnnnnnn",
+	"ES": "nnnnnnnnnnnnnnnnnnnn",
+	"FR": "nnnnnnnnnncccccccccccnn",
+	"GB": "aaaannnnnnnnnnnnnn",
+	"IT": "annnnnnnnnncccccccccccc",
+	"NL": "aaaannnnnnnnnn",
+}
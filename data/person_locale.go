@@ -0,0 +1,23 @@
+package data
+
+// PersonLocales consists of first and last names keyed by locale
+// en uses the default Person data set
+var PersonLocales = map[string]map[string][]string{
+	"en": Person,
+	"fr": {
+		"first": {"Adèle", "Agathe", "Alexandre", "Alice", "Amélie", "Antoine", "Arthur", "Baptiste", "Camille", "Céline", "Chloé", "Clément", "Élise", "Émile", "Emma", "Étienne", "Gabriel", "Hugo", "Inès", "Jules", "Juliette", "Léa", "Léon", "Louis", "Lucas", "Manon", "Mathilde", "Nathan", "Noémie", "Raphaël", "Sophie", "Théo", "Thomas", "Valentin", "Zoé"},
+		"last":  {"Bernard", "Bonnet", "Boyer", "Chevalier", "David", "Dubois", "Dupont", "Durand", "Fontaine", "Fournier", "Francois", "Garnier", "Girard", "Lambert", "Laurent", "Lefebvre", "Leroy", "Martin", "Mercier", "Michel", "Moreau", "Morel", "Petit", "Richard", "Robert", "Roux", "Rousseau", "Simon", "Thomas", "Vincent"},
+	},
+	"de": {
+		"first": {"Anna", "Ben", "Clara", "Elias", "Emil", "Emma", "Felix", "Finn", "Frieda", "Greta", "Hannah", "Henry", "Ida", "Jakob", "Jonas", "Julia", "Karl", "Lena", "Leon", "Lina", "Luca", "Lukas", "Marie", "Mia", "Noah", "Paul", "Sophie", "Theo", "Tim", "Ursula"},
+		"last":  {"Bauer", "Becker", "Braun", "Fischer", "Hartmann", "Hoffmann", "Koch", "Krüger", "Lange", "Meyer", "Müller", "Neumann", "Richter", "Schmid", "Schmidt", "Schmitz", "Schneider", "Schröder", "Schulz", "Schwarz", "Wagner", "Weber", "Werner", "Wolf", "Zimmermann"},
+	},
+	"es": {
+		"first": {"Alejandro", "Alba", "Álvaro", "Ana", "Carla", "Carmen", "Daniel", "David", "Diego", "Elena", "Hugo", "Irene", "Javier", "Jimena", "Laura", "Lucía", "Manuel", "María", "Martín", "Martina", "Mateo", "Pablo", "Paula", "Rocío", "Sara", "Sergio", "Sofía", "Valeria"},
+		"last":  {"Álvarez", "Castillo", "Díaz", "Fernández", "García", "Gómez", "González", "Hernández", "Jiménez", "López", "Martín", "Martínez", "Moreno", "Muñoz", "Navarro", "Pérez", "Romero", "Rodríguez", "Ruiz", "Sánchez", "Serrano", "Torres", "Vázquez"},
+	},
+	"ja": {
+		"first": {"愛", "葵", "朝陽", "彩", "大翔", "陽菜", "結衣", "花子", "颯太", "健太", "湊", "美咲", "翼", "蓮", "涼", "沙織", "さくら", "翔太", "拓海", "太郎", "悠真", "陽翔", "凛", "結菜", "優斗", "由美", "千尋", "直樹", "奈々", "樹"},
+		"last":  {"阿部", "遠藤", "藤田", "林", "橋本", "池田", "井上", "石川", "伊藤", "加藤", "木村", "小林", "前田", "松本", "森", "中村", "中島", "小川", "岡田", "斎藤", "佐々木", "佐藤", "清水", "鈴木", "高橋", "田中", "渡辺", "山田", "山口", "山本", "吉田"},
+	},
+}
@@ -0,0 +1,18 @@
+package data
+
+// PhoneFormat contains a countries dialing code and national phone number formats
+// # is replaced with a digit from 0 to 9 and N with a digit from 2 to 9
+// A leading 0 is the national trunk prefix and is dropped when dialing internationally
+type PhoneFormat struct {
+	DialCode string
+	Formats  []string
+}
+
+// PhoneFormats consists of phone number formats keyed by country code
+var PhoneFormats = map[string]PhoneFormat{
+	"US": {DialCode: "1", Formats: []string{"(N##) N##-####"}},
+	"GB": {DialCode: "44", Formats: []string{"07### ######", "020 #### ####", "01### ######"}},
+	"DE": {DialCode: "49", Formats: []string{"015# ########", "016# #######", "017# ########", "030 ########", "089 ########"}},
+	"IN": {DialCode: "91", Formats: []string{"6#### #####", "7#### #####", "8#### #####", "9#### #####"}},
+	"BR": {DialCode: "55", Formats: []string{"(1#) 9####-####", "(N#) 9####-####", "(N#) N###-####"}},
+}
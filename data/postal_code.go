@@ -0,0 +1,18 @@
+package data
+
+// PostalCodeFormat contains a countries postal code formats
+// # is replaced with a digit from 0 to 9, N with a digit from 1 to 9 and A with one of Letters
+type PostalCodeFormat struct {
+	Formats []string
+	Letters string
+}
+
+// PostalCodeFormats consists of postal code formats keyed by country code.
+// Letters are limited to the ones each country uses in every position
+var PostalCodeFormats = map[string]PostalCodeFormat{
+	"US": {Formats: []string{"#####", "#####-####"}},
+	"GB": {Formats: []string{"A# #AA", "A## #AA", "AA# #AA", "AA## #AA"}, Letters: "ABDEFGHLNPRSTUWY"},
+	"CA": {Formats: []string{"A#A #A#"}, Letters: "ABCEGHJKLMNPRSTVXY"},
+	"NL": {Formats: []string{"N### AA"}, Letters: "ABCDEGHJKLMNPRTVWXZ"},
+	"JP": {Formats: []string{"###-####"}},
+}
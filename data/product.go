@@ -0,0 +1,22 @@
+package data
+
+// Product consists of product data, the product names of a category are keyed by the category
+var Product = map[string][]string{
+	"category":    {"automotive", "beauty", "books", "clothing", "electronics", "garden", "grocery", "health", "home", "kitchen", "office", "pet", "sports", "toys"},
+	"adjective":   {"Classic", "Compact", "Deluxe", "Durable", "Eco", "Elegant", "Ergonomic", "Essential", "Handcrafted", "Lightweight", "Modern", "Portable", "Premium", "Rustic", "Sleek", "Smart", "Sturdy", "Ultra", "Vintage", "Wireless"},
+	"material":    {"Aluminum", "Bamboo", "Canvas", "Ceramic", "Copper", "Cotton", "Glass", "Leather", "Linen", "Marble", "Oak", "Plastic", "Rubber", "Silicone", "Steel", "Walnut", "Wool"},
+	"automotive":  {"Car Charger", "Dash Camera", "Floor Mats", "Jump Starter", "Seat Cover", "Steering Wheel Cover", "Tire Inflator", "Wiper Blades"},
+	"beauty":      {"Face Serum", "Hair Dryer", "Lip Balm", "Makeup Brush Set", "Moisturizer", "Nail Kit", "Perfume", "Shampoo"},
+	"books":       {"Bookmark Set", "Cookbook", "Journal", "Novel", "Photo Album", "Planner", "Sketchbook", "Travel Guide"},
+	"clothing":    {"Beanie", "Hoodie", "Jacket", "Jeans", "Scarf", "Socks", "Sweater", "T-Shirt"},
+	"electronics": {"Bluetooth Speaker", "Desk Lamp", "Headphones", "Keyboard", "Monitor", "Mouse", "Phone Case", "Power Bank", "Smartwatch", "Webcam"},
+	"garden":      {"Bird Feeder", "Garden Hose", "Hand Trowel", "Planter", "Pruning Shears", "Seed Kit", "Sprinkler", "Watering Can"},
+	"grocery":     {"Coffee Beans", "Granola", "Hot Sauce", "Olive Oil", "Pasta", "Spice Rub", "Tea Sampler", "Trail Mix"},
+	"health":      {"First Aid Kit", "Foam Roller", "Heating Pad", "Massage Gun", "Pill Organizer", "Thermometer", "Vitamin Pack", "Yoga Block"},
+	"home":        {"Bath Towel", "Candle", "Clock", "Doormat", "Picture Frame", "Pillow", "Throw Blanket", "Vase"},
+	"kitchen":     {"Blender", "Cutting Board", "Dutch Oven", "French Press", "Knife Set", "Mixing Bowl", "Skillet", "Water Bottle"},
+	"office":      {"Desk Organizer", "Filing Cabinet", "Monitor Stand", "Notebook", "Office Chair", "Pen Set", "Stapler", "Whiteboard"},
+	"pet":         {"Cat Tree", "Chew Toy", "Dog Bed", "Food Bowl", "Leash", "Litter Box", "Pet Carrier", "Scratching Post"},
+	"sports":      {"Basketball", "Dumbbell Set", "Jump Rope", "Resistance Bands", "Running Shoes", "Tennis Racket", "Water Bottle", "Yoga Mat"},
+	"toys":        {"Board Game", "Building Blocks", "Jigsaw Puzzle", "Kite", "Plush Bear", "Remote Control Car", "Toy Robot", "Yo-Yo"},
+}
@@ -0,0 +1,78 @@
+package gofakeit
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+// datasets holds the reference data added with AddData keyed by name
+var (
+	datasets     = make(map[string][]string)
+	lockDatasets sync.RWMutex
+)
+
+// AddData will register a named dataset the dataset function picks from, so reference data
+// like department names can be loaded once and used across fields and rows.
+// Adding a dataset with a name that already exists replaces it and nil or empty values remove it
+func AddData(name string, values []string) {
+	lockDatasets.Lock()
+	defer lockDatasets.Unlock()
+
+	if len(values) == 0 {
+		delete(datasets, name)
+		return
+	}
+
+	datasets[name] = append([]string(nil), values...)
+}
+
+// Dataset will return a random value from a dataset registered with AddData. Names that
+// havent been added fall back to the built in data sets, by category or group and key
+// the same as SetData, like jobdepartment or person.first
+func Dataset(name string) (string, error) { return dataset(globalFaker.ctx(), name) }
+
+// Dataset will return a random value from a dataset registered with AddData. Names that
+// havent been added fall back to the built in data sets, by category or group and key
+// the same as SetData, like jobdepartment or person.first
+func (f *Faker) Dataset(name string) (string, error) { return dataset(f.ctx(), name) }
+
+func dataset(r fakerCtx, name string) (string, error) {
+	lockDatasets.RLock()
+	values, ok := datasets[name]
+	lockDatasets.RUnlock()
+	if ok {
+		return values[r.Intn(len(values))], nil
+	}
+
+	dataVal, ok := dataCategories[name]
+	if !ok {
+		dataVal = strings.SplitN(name, ".", 2)
+	}
+	if !dataCheck(dataVal) {
+		return "", errors.New("Dataset " + name + " does not exist, add it with AddData")
+	}
+
+	return getRandValue(r, dataVal), nil
+}
+
+func addDatasetLookup() {
+	AddFuncLookup("dataset", Info{
+		Display:     "Dataset",
+		Category:    "misc",
+		Description: "Random value from a dataset registered with AddData",
+		Example:     "Engineering",
+		Output:      "string",
+		Params: []Param{
+			{Field: "name", Display: "Name", Type: "string", Default: "jobdepartment", Description: "Name of the dataset added with AddData or a built in data set"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			name, err := info.GetString(m, "name")
+			if err != nil {
+				return nil, err
+			}
+
+			return dataset(r, name)
+		},
+	})
+}
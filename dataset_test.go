@@ -0,0 +1,93 @@
+package gofakeit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func ExampleDataset() {
+	Seed(11)
+	AddData("department", []string{"Engineering", "Finance", "Legal"})
+	defer AddData("department", nil)
+
+	value, _ := Dataset("department")
+	fmt.Println(value)
+	// Output: Engineering
+}
+
+func TestDataset(t *testing.T) {
+	f := New(rand.NewSource(11))
+
+	if _, err := f.Dataset("department_test"); err == nil {
+		t.Fatal("expected an error for a dataset that hasnt been added")
+	}
+
+	values := []string{"a", "b"}
+	AddData("department_test", values)
+	values[0] = "changed"
+	for i := 0; i < 100; i++ {
+		value, err := f.Dataset("department_test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != "a" && value != "b" {
+			t.Fatalf("expected a value from the dataset got %s", value)
+		}
+	}
+
+	AddData("department_test", nil)
+	if _, err := f.Dataset("department_test"); err == nil {
+		t.Fatal("expected an error for a removed dataset")
+	}
+
+	// Built in data sets by category and by group and key
+	for _, name := range []string{"jobdepartment", "person.first"} {
+		value, err := f.Dataset(name)
+		if err != nil || value == "" {
+			t.Fatalf("expected a value from built in data set %s got %q %v", name, value, err)
+		}
+	}
+}
+
+func TestDatasetCSV(t *testing.T) {
+	departments := []string{"Engineering", "Finance", "Legal", "Human Resources"}
+	AddData("department", departments)
+	defer AddData("department", nil)
+
+	f := New(rand.NewSource(11))
+	value, err := f.CSV(&CSVOptions{
+		RowCount: 100,
+		Fields: []Field{
+			{Name: "name", Function: "name"},
+			{Name: "department", Function: "dataset", Params: map[string][]string{"name": {"department"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(string(value))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 101 {
+		t.Fatalf("expected 101 records got %d", len(records))
+	}
+	for _, record := range records[1:] {
+		if !stringInSlice(record[1], departments) {
+			t.Fatalf("expected a department from the dataset got %s", record[1])
+		}
+	}
+}
+
+func BenchmarkDataset(b *testing.B) {
+	AddData("department", []string{"Engineering", "Finance", "Legal"})
+	defer AddData("department", nil)
+
+	for i := 0; i < b.N; i++ {
+		Dataset("department")
+	}
+}
@@ -1,28 +1,101 @@
 package gofakeit
 
+import (
+	"errors"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+// Emoji will return a random fun emoji
+func Emoji() string { return emoji(globalFaker.ctx()) }
+
 // Emoji will return a random fun emoji
-func Emoji() string {
-	return getRandValue([]string{"emoji", "emoji"})
+func (f *Faker) Emoji() string { return emoji(f.ctx()) }
+
+func emoji(r fakerCtx) string {
+	return getRandValue(r, []string{"emoji", "emoji"})
 }
 
 // EmojiDescription will return a random fun emoji description
-func EmojiDescription() string {
-	return getRandValue([]string{"emoji", "description"})
+func EmojiDescription() string { return emojiDescription(globalFaker.ctx()) }
+
+// EmojiDescription will return a random fun emoji description
+func (f *Faker) EmojiDescription() string { return emojiDescription(f.ctx()) }
+
+func emojiDescription(r fakerCtx) string {
+	return getRandValue(r, []string{"emoji", "description"})
 }
 
 // EmojiCategory will return a random fun emoji category
-func EmojiCategory() string {
-	return getRandValue([]string{"emoji", "category"})
+func EmojiCategory() string { return emojiCategory(globalFaker.ctx()) }
+
+// EmojiCategory will return a random fun emoji category
+func (f *Faker) EmojiCategory() string { return emojiCategory(f.ctx()) }
+
+func emojiCategory(r fakerCtx) string {
+	return getRandValue(r, []string{"emoji", "category"})
+}
+
+// emojiCategoryNames maps the short category names to the categories of the emoji data set
+var emojiCategoryNames = map[string]string{
+	"smileys":    "Smileys & Emotion",
+	"people":     "People & Body",
+	"animals":    "Animals & Nature",
+	"food":       "Food & Drink",
+	"travel":     "Travel & Places",
+	"activities": "Activities",
+	"objects":    "Objects",
+	"symbols":    "Symbols",
+	"flags":      "Flags",
+}
+
+// EmojiByCategory will return a random fun emoji from the category passed.
+// Category can be smileys, people, animals, food, travel, activities, objects, symbols or flags
+// as well as the full category names like Smileys & Emotion
+func EmojiByCategory(category string) (string, error) {
+	return emojiByCategory(globalFaker.ctx(), category)
 }
 
+// EmojiByCategory will return a random fun emoji from the category passed.
+// Category can be smileys, people, animals, food, travel, activities, objects, symbols or flags
+// as well as the full category names like Smileys & Emotion
+func (f *Faker) EmojiByCategory(category string) (string, error) {
+	return emojiByCategory(f.ctx(), category)
+}
+
+func emojiByCategory(r fakerCtx, category string) (string, error) {
+	if name, ok := emojiCategoryNames[strings.ToLower(category)]; ok {
+		category = name
+	}
+
+	for name, emojis := range data.EmojiCategories {
+		if strings.EqualFold(name, category) {
+			return emojis[r.Intn(len(emojis))], nil
+		}
+	}
+
+	return "", errors.New("Invalid emoji category " + category)
+}
+
+// EmojiAlias will return a random fun emoji alias
+func EmojiAlias() string { return emojiAlias(globalFaker.ctx()) }
+
 // EmojiAlias will return a random fun emoji alias
-func EmojiAlias() string {
-	return getRandValue([]string{"emoji", "alias"})
+func (f *Faker) EmojiAlias() string { return emojiAlias(f.ctx()) }
+
+func emojiAlias(r fakerCtx) string {
+	return getRandValue(r, []string{"emoji", "alias"})
 }
 
 // EmojiTag will return a random fun emoji tag
-func EmojiTag() string {
-	return getRandValue([]string{"emoji", "tag"})
+func EmojiTag() string { return emojiTag(globalFaker.ctx()) }
+
+// EmojiTag will return a random fun emoji tag
+func (f *Faker) EmojiTag() string { return emojiTag(f.ctx()) }
+
+func emojiTag(r fakerCtx) string {
+	return getRandValue(r, []string{"emoji", "tag"})
 }
 
 func addEmojiLookup() {
@@ -32,8 +105,20 @@ func addEmojiLookup() {
 		Description: "Random emoji",
 		Example:     "🤣",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Emoji(), nil
+		Params: []Param{
+			{Field: "category", Display: "Category", Type: "string", Default: "all", Options: []string{"all", "smileys", "people", "animals", "food", "travel", "activities", "objects", "symbols", "flags"}, Description: "Category of emoji"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			category, err := info.GetString(m, "category")
+			if err != nil {
+				return nil, err
+			}
+
+			if category == "all" {
+				return emoji(r), nil
+			}
+
+			return emojiByCategory(r, category)
 		},
 	})
 
@@ -43,8 +128,8 @@ func addEmojiLookup() {
 		Description: "Random emoji description",
 		Example:     "face vomiting",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return EmojiDescription(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return emojiDescription(r), nil
 		},
 	})
 
@@ -54,8 +139,8 @@ func addEmojiLookup() {
 		Description: "Random emoji category",
 		Example:     "Smileys & Emotion",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return EmojiCategory(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return emojiCategory(r), nil
 		},
 	})
 
@@ -65,8 +150,8 @@ func addEmojiLookup() {
 		Description: "Random emoji alias",
 		Example:     "smile",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return EmojiAlias(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return emojiAlias(r), nil
 		},
 	})
 
@@ -76,8 +161,8 @@ func addEmojiLookup() {
 		Description: "Random emoji tag",
 		Example:     "happy",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return EmojiTag(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return emojiTag(r), nil
 		},
 	})
 }
@@ -3,6 +3,8 @@ package gofakeit
 import (
 	"fmt"
 	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 func ExampleEmoji() {
@@ -64,3 +66,67 @@ func BenchmarkEmojiTag(b *testing.B) {
 		EmojiTag()
 	}
 }
+
+func ExampleEmojiByCategory() {
+	Seed(11)
+	value, err := EmojiByCategory("animals")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 🐂
+}
+
+func TestEmojiByCategory(t *testing.T) {
+	for short, category := range emojiCategoryNames {
+		for _, name := range []string{short, category} {
+			for i := 0; i < 100; i++ {
+				value, err := EmojiByCategory(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !stringInSlice(value, data.EmojiCategories[category]) {
+					t.Fatalf("%s is not in category %s", value, category)
+				}
+			}
+		}
+	}
+
+	if _, err := EmojiByCategory("vehicles"); err == nil {
+		t.Error("expected error for unknown category")
+	}
+}
+
+func TestEmojiCategoriesData(t *testing.T) {
+	// Every emoji belongs to exactly one category
+	count := 0
+	for _, category := range data.Emoji["category"] {
+		emojis, ok := data.EmojiCategories[category]
+		if !ok {
+			t.Fatalf("category %s has no emojis", category)
+		}
+		count += len(emojis)
+	}
+	if count != len(data.Emoji["emoji"]) {
+		t.Errorf("expected %d categorized emojis, got %d", len(data.Emoji["emoji"]), count)
+	}
+}
+
+func TestEmojiLookupCategory(t *testing.T) {
+	info := GetFuncLookup("emoji")
+
+	m := map[string][]string{"category": {"food"}}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stringInSlice(value.(string), data.EmojiCategories["Food & Drink"]) {
+		t.Errorf("%s is not a food emoji", value)
+	}
+}
+
+func BenchmarkEmojiByCategory(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		EmojiByCategory("smileys")
+	}
+}
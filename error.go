@@ -0,0 +1,69 @@
+package gofakeit
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// errorCategories are the data sets Error picks a message from
+var errorCategories = []string{"database", "network", "permission", "runtime", "validation"}
+
+// httpErrorCodes are the status codes ErrorHTTP picks from
+var httpErrorCodes = []int{400, 401, 403, 404, 405, 408, 409, 422, 429, 500, 502, 503, 504}
+
+// HTTPError is an error with the http status code it maps to
+type HTTPError struct {
+	StatusCode int    `json:"status_code" xml:"status_code"`
+	Message    string `json:"message" xml:"message"`
+}
+
+// Error returns the status code, its text and the message, like 404 Not Found: the requested resource could not be found
+func (e *HTTPError) Error() string {
+	return strconv.Itoa(e.StatusCode) + " " + http.StatusText(e.StatusCode) + ": " + e.Message
+}
+
+// Error will generate a random error with a realistic database, network, permission, runtime or validation message
+func Error() error { return errorFunc(globalFaker.ctx()) }
+
+// Error will generate a random error with a realistic database, network, permission, runtime or validation message
+func (f *Faker) Error() error { return errorFunc(f.ctx()) }
+
+func errorFunc(r fakerCtx) error {
+	return errors.New(getRandValue(r, []string{"error", randomString(r, errorCategories)}))
+}
+
+// ErrorHTTP will generate a random *HTTPError with a 4xx or 5xx status code and a message that fits it
+func ErrorHTTP() error { return errorHTTP(globalFaker.ctx()) }
+
+// ErrorHTTP will generate a random *HTTPError with a 4xx or 5xx status code and a message that fits it
+func (f *Faker) ErrorHTTP() error { return errorHTTP(f.ctx()) }
+
+func errorHTTP(r fakerCtx) error {
+	code := httpErrorCodes[r.Intn(len(httpErrorCodes))]
+	return &HTTPError{StatusCode: code, Message: getRandValue(r, []string{"error", "http_" + strconv.Itoa(code)})}
+}
+
+func addErrorLookup() {
+	AddFuncLookup("error", Info{
+		Display:     "Error",
+		Category:    "error",
+		Description: "Random error message",
+		Example:     "dial tcp: connect: connection refused",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return errorFunc(r).Error(), nil
+		},
+	})
+
+	AddFuncLookup("errorhttp", Info{
+		Display:     "HTTP Error",
+		Category:    "error",
+		Description: "Random http error message with its status code",
+		Example:     "404 Not Found: the requested resource could not be found",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return errorHTTP(r).Error(), nil
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package gofakeit
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+func ExampleError() {
+	Seed(11)
+	fmt.Println(Error())
+	// Output: sql: database is closed
+}
+
+func ExampleErrorHTTP() {
+	Seed(11)
+	fmt.Println(ErrorHTTP())
+	// Output: 409 Conflict: a resource with that name already exists
+}
+
+func TestError(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for i := 0; i < 1000; i++ {
+		var err error = f.Error()
+		if err == nil || err.Error() == "" {
+			t.Fatal("expected a non nil error with a message")
+		}
+	}
+}
+
+func TestErrorHTTP(t *testing.T) {
+	for _, code := range httpErrorCodes {
+		if len(data.Error["http_"+strconv.Itoa(code)]) == 0 {
+			t.Errorf("expected messages for status code %d", code)
+		}
+	}
+
+	f := New(rand.NewSource(11))
+	for i := 0; i < 1000; i++ {
+		err := f.ErrorHTTP()
+		if err == nil {
+			t.Fatal("expected a non nil error")
+		}
+
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) {
+			t.Fatalf("expected an *HTTPError got %T", err)
+		}
+		if httpErr.StatusCode < 400 || httpErr.StatusCode > 599 || http.StatusText(httpErr.StatusCode) == "" {
+			t.Fatalf("expected a 4xx or 5xx status code got %d", httpErr.StatusCode)
+		}
+		prefix := fmt.Sprintf("%d %s: ", httpErr.StatusCode, http.StatusText(httpErr.StatusCode))
+		if !strings.HasPrefix(err.Error(), prefix) || httpErr.Message == "" {
+			t.Fatalf("expected %s to start with %s and have a message", err, prefix)
+		}
+	}
+}
+
+func BenchmarkError(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Error()
+	}
+}
+
+func BenchmarkErrorHTTP(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ErrorHTTP()
+	}
+}
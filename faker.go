@@ -1,15 +1,310 @@
 package gofakeit
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
 	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 )
 
+// Faker struct is the primary struct for using localized random sources.
+// A Faker is only safe for concurrent use if its source is, the package level
+// functions use a mutex guarded source and can be called from multiple goroutines
+type Faker struct {
+	Rand *rand.Rand
+
+	// src is the source New created Rand with and srcRand that Rand, so Clone can
+	// snapshot the source and tell when Rand has been replaced
+	src     rand.Source
+	srcRand *rand.Rand
+
+	// unique holds values already returned by Unique, guarded by uniqueLock
+	// so Unique can be called from multiple goroutines
+	unique     map[string]struct{}
+	uniqueLock sync.Mutex
+
+	// defaults fill in zero values of the options passed to the fakers csv generators
+	defaults Defaults
+
+	// data holds the overrides set with SetData keyed by group.key. It is copy on write
+	// so contexts already passed to generators keep a consistent view
+	data map[string][]string
+}
+
+// fakerCtx is what the generators get passed, the fakers random source along with
+// its data overrides
+type fakerCtx struct {
+	*rand.Rand
+	data map[string][]string
+}
+
+// ctx returns the context the fakers generators are called with
+func (f *Faker) ctx() fakerCtx { return fakerCtx{Rand: f.Rand, data: f.data} }
+
+// Defaults are used by csv generation in place of zero values in CSVOptions,
+// values set in the options always take precedence
+type Defaults struct {
+	RowCount  int    `json:"row_count" xml:"row_count"`
+	Delimiter string `json:"delimiter" xml:"delimiter"`
+}
+
+// SetDefaults will set the row count and delimiter used when CSVOptions leave them empty.
+// It should not be called while the faker is generating
+func SetDefaults(d Defaults) { globalFaker.SetDefaults(d) }
+
+// SetDefaults will set the row count and delimiter used when CSVOptions leave them empty.
+// It should not be called while the faker is generating
+func (f *Faker) SetDefaults(d Defaults) { f.defaults = d }
+
+// csvOptions returns a copy of co with the fakers defaults in place of zero values
+func (f *Faker) csvOptions(co *CSVOptions) *CSVOptions {
+	if co == nil || (f.defaults.RowCount == 0 && f.defaults.Delimiter == "") {
+		return co
+	}
+
+	c := *co
+	if c.RowCount == 0 {
+		c.RowCount = f.defaults.RowCount
+	}
+	if c.Delimiter == "" {
+		c.Delimiter = f.defaults.Delimiter
+	}
+	return &c
+}
+
+// globalFaker is the faker used by all package level functions
+var globalFaker = New(newLockedSource(time.Now().UTC().UnixNano()))
+
+// New will utilize src as the random source for all of the faker's generators.
+// Passing a seeded source, such as rand.NewSource(11), makes the output reproducible.
+// A nil src falls back to a mutex guarded source seeded with the current time
+func New(src rand.Source) *Faker {
+	if src == nil {
+		src = newLockedSource(time.Now().UTC().UnixNano())
+	}
+
+	r := rand.New(src)
+	return &Faker{Rand: r, src: src, srcRand: r}
+}
+
+// Clone will return a new faker whose source is a snapshot of this fakers source, so both
+// generate the same values from here on while advancing independently. The data overrides,
+// defaults and values already returned by Unique are copied as well.
+//
+// The source New was called with is the one cloned. Sources that are a pointer to a struct,
+// like rand.NewSource, are copied by value and a New(nil) source is copied under its lock.
+// Any other source is shared between the fakers, and a faker that wasn't created with New
+// or had Rand replaced gets a time seeded source. Bytes buffered by Rand.Read aren't copied
+func (f *Faker) Clone() *Faker {
+	var c *Faker
+	if f.src != nil && f.Rand == f.srcRand {
+		c = New(cloneSource(f.src))
+	} else {
+		c = New(nil)
+	}
+	c.defaults = f.defaults
+
+	f.uniqueLock.Lock()
+	if f.unique != nil {
+		c.unique = make(map[string]struct{}, len(f.unique))
+		for k := range f.unique {
+			c.unique[k] = struct{}{}
+		}
+	}
+	f.uniqueLock.Unlock()
+
+	// Overrides are copy on write so the map can be shared
+	c.data = f.data
+
+	return c
+}
+
+// cloneSource returns a copy of src at the same position, or src itself if it cant be copied
+func cloneSource(src rand.Source) rand.Source {
+	switch s := src.(type) {
+	case *lockedSource:
+		s.lk.Lock()
+		defer s.lk.Unlock()
+		return &lockedSource{src: cloneSource(s.src).(rand.Source64)}
+	case cryptoSource:
+		return s
+	}
+
+	v := reflect.ValueOf(src)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return src
+	}
+
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	return c.Interface().(rand.Source)
+}
+
+// NewUnixNano will create a faker with a source seeded with the current time.
+// Like rand.NewSource the source is not safe for concurrent use
+func NewUnixNano() *Faker {
+	return New(rand.NewSource(time.Now().UTC().UnixNano()))
+}
+
+// NewFromString will create a faker seeded from s, so a readable name like a test name
+// reproduces the same output every run. The seed is the 64 bit FNV-1a hash of s,
+// different strings can collide on the same seed but it is unlikely in practice
+func NewFromString(s string) *Faker {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return New(rand.NewSource(int64(h.Sum64())))
+}
+
+// NewCrypto will create a faker with a source that reads from crypto/rand. It is safe
+// for concurrent use but slower than the math/rand sources and Seed has no effect on it
+func NewCrypto() *Faker {
+	return New(cryptoSource{})
+}
+
+// dataCategories maps generator names to the data set they pick from
+var dataCategories = map[string][]string{
+	"firstname":           {"person", "first"},
+	"lastname":            {"person", "last"},
+	"nameprefix":          {"person", "prefix"},
+	"namesuffix":          {"person", "suffix"},
+	"city":                {"address", "city"},
+	"streetname":          {"address", "street_name"},
+	"state":               {"address", "state"},
+	"stateabr":            {"address", "state_abr"},
+	"country":             {"address", "country"},
+	"countryabr":          {"address", "country_abr"},
+	"company":             {"company", "name"},
+	"companysuffix":       {"company", "suffix"},
+	"jobtitle":            {"job", "title"},
+	"jobdepartment":       {"job", "department"},
+	"animal":              {"animal", "animal"},
+	"petname":             {"animal", "petname"},
+	"fruit":               {"food", "fruit"},
+	"vegetable":           {"food", "vegetable"},
+	"color":               {"color", "full"},
+	"safecolor":           {"color", "safe"},
+	"noun":                {"word", "noun"},
+	"verb":                {"word", "verb"},
+	"adjective":           {"word", "adjective"},
+	"adverb":              {"word", "adverb"},
+	"beername":            {"beer", "name"},
+	"carmaker":            {"car", "maker"},
+	"carmodel":            {"car", "model"},
+	"domainsuffix":        {"internet", "domain_suffix"},
+	"fileextension":       {"file", "extension"},
+	"programminglanguage": {"language", "programming"},
+	"timezone":            {"timezone", "text"},
+}
+
+// SetData will override the data set the generators of category pick from, for example
+// firstname or city. Any data set can also be set by its group and key, like person.first.
+// Passing no values removes the override and goes back to the built in data.
+// It should not be called while the faker is generating
+func SetData(category string, values []string) error { return globalFaker.SetData(category, values) }
+
+// SetData will override the data set the generators of category pick from, for example
+// firstname or city. Any data set can also be set by its group and key, like person.first.
+// Passing no values removes the override and goes back to the built in data.
+// It should not be called while the faker is generating
+func (f *Faker) SetData(category string, values []string) error {
+	dataVal, ok := dataCategories[category]
+	if !ok {
+		dataVal = strings.SplitN(category, ".", 2)
+		if !dataCheck(dataVal) {
+			return errors.New("Invalid data category " + category)
+		}
+	}
+	key := dataVal[0] + "." + dataVal[1]
+
+	// Copy on write so generators reading the overrides dont need a lock
+	overrides := make(map[string][]string, len(f.data))
+	for k, v := range f.data {
+		overrides[k] = v
+	}
+	if len(values) > 0 {
+		overrides[key] = append([]string(nil), values...)
+	} else {
+		delete(overrides, key)
+	}
+
+	if len(overrides) == 0 {
+		overrides = nil
+	}
+	f.data = overrides
+
+	return nil
+}
+
+// dataOverride returns the values set with SetData for the faker r belongs to
+func dataOverride(r fakerCtx, dataVal []string) ([]string, bool) {
+	if r.data == nil || len(dataVal) != 2 {
+		return nil, false
+	}
+
+	values, ok := r.data[dataVal[0]+"."+dataVal[1]]
+	return values, ok
+}
+
 // Seed random. Setting seed to 0 will use time.Now().UnixNano()
-func Seed(seed int64) {
+func Seed(seed int64) { globalFaker.Seed(seed) }
+
+// Seed will reinitialize the fakers source in place so the same seed replays the same sequence.
+// Setting seed to 0 will use time.Now().UnixNano()
+func (f *Faker) Seed(seed int64) {
 	if seed == 0 {
-		rand.Seed(time.Now().UTC().UnixNano())
+		f.Rand.Seed(time.Now().UTC().UnixNano())
 	} else {
-		rand.Seed(seed)
+		f.Rand.Seed(seed)
 	}
 }
+
+// lockedSource guards a rand.Source with a mutex so it can be shared across goroutines
+type lockedSource struct {
+	lk  sync.Mutex
+	src rand.Source64
+}
+
+func newLockedSource(seed int64) *lockedSource {
+	return &lockedSource{src: rand.NewSource(seed).(rand.Source64)}
+}
+
+func (ls *lockedSource) Int63() int64 {
+	ls.lk.Lock()
+	n := ls.src.Int63()
+	ls.lk.Unlock()
+	return n
+}
+
+func (ls *lockedSource) Uint64() uint64 {
+	ls.lk.Lock()
+	n := ls.src.Uint64()
+	ls.lk.Unlock()
+	return n
+}
+
+func (ls *lockedSource) Seed(seed int64) {
+	ls.lk.Lock()
+	ls.src.Seed(seed)
+	ls.lk.Unlock()
+}
+
+// cryptoSource is a rand.Source backed by crypto/rand, which can't be seeded
+type cryptoSource struct{}
+
+func (cryptoSource) Int63() int64 { return int64(cryptoSource{}.Uint64() &^ (1 << 63)) }
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic("gofakeit: unable to read from crypto/rand, " + err.Error())
+	}
+
+	return binary.BigEndian.Uint64(b[:])
+}
+
+func (cryptoSource) Seed(seed int64) {}
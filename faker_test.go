@@ -2,6 +2,9 @@ package gofakeit
 
 import (
 	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -40,3 +43,342 @@ func Example() {
 func TestSeed(t *testing.T) {
 	Seed(0)
 }
+
+func ExampleNew() {
+	f := New(rand.NewSource(11))
+	fmt.Println("Name:", f.Name())
+	fmt.Println("Email:", f.Email())
+	fmt.Println("Phone:", f.Phone())
+	// Output:
+	// Name: Markus Moen
+	// Email: alaynawuckert@kozey.biz
+	// Phone: 9948995369
+}
+
+func TestNewNil(t *testing.T) {
+	f := New(nil)
+	if f.Name() == "" || f.Email() == "" {
+		t.Fatal("expected a faker created with a nil source to generate values")
+	}
+
+	// The fallback source is guarded so it can be shared
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ii := 0; ii < 100; ii++ {
+				f.Number(1, 10)
+			}
+		}()
+	}
+	wg.Wait()
+
+	f.Seed(11)
+	if name := f.Name(); name != "Markus Moen" {
+		t.Errorf("expected a nil source faker to be seedable got %s", name)
+	}
+}
+
+func TestNewUnixNano(t *testing.T) {
+	f := NewUnixNano()
+	if f.Name() == "" {
+		t.Fatal("expected a faker to generate values")
+	}
+}
+
+func ExampleNewFromString() {
+	f := NewFromString("TestCheckout")
+	fmt.Println(f.Name())
+	// Output: Diamond Hamill
+}
+
+func TestNewFromString(t *testing.T) {
+	a, b := NewFromString("TestCheckout"), NewFromString("TestCheckout")
+	for i := 0; i < 100; i++ {
+		if x, y := a.Name()+a.Email(), b.Name()+b.Email(); x != y {
+			t.Fatalf("expected the same string to replay the same sequence got %s and %s", x, y)
+		}
+	}
+
+	seen := map[string]string{}
+	for _, s := range []string{"", "TestCheckout", "TestCheckout2", "testcheckout", "TestLogin", "a", "b"} {
+		value := NewFromString(s).Sentence(10)
+		if other, ok := seen[value]; ok {
+			t.Errorf("expected %q and %q to generate different values", s, other)
+		}
+		seen[value] = s
+	}
+}
+
+func TestNewCrypto(t *testing.T) {
+	f := NewCrypto()
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		seen[f.UUID()] = true
+		if n := f.Number(1, 10); n < 1 || n > 10 {
+			t.Fatalf("expected a number between 1 and 10 got %d", n)
+		}
+	}
+	if len(seen) != 100 {
+		t.Errorf("expected 100 unique uuids got %d", len(seen))
+	}
+
+	// Seeding has no effect on a crypto source
+	f.Seed(11)
+	a := f.Name() + f.Email()
+	f.Seed(11)
+	if b := f.Name() + f.Email(); a == b {
+		t.Error("expected seeding to not replay a crypto source")
+	}
+}
+
+func ExampleFaker_Clone() {
+	f := New(rand.NewSource(11))
+	f.Name()
+
+	c := f.Clone()
+	fmt.Println(f.Email())
+	fmt.Println(c.Email())
+	// Output:
+	// alaynawuckert@kozey.biz
+	// alaynawuckert@kozey.biz
+}
+
+func TestFakerClone(t *testing.T) {
+	for _, f := range []*Faker{New(rand.NewSource(11)), New(nil)} {
+		for i := 0; i < 10; i++ {
+			f.Sentence(5)
+		}
+
+		c := f.Clone()
+		for i := 0; i < 100; i++ {
+			if a, b := f.Name()+f.Paragraph(2, 3, 10, " "), c.Name()+c.Paragraph(2, 3, 10, " "); a != b {
+				t.Fatalf("expected clone to produce the same values got %s and %s", a, b)
+			}
+		}
+
+		// Advancing one doesnt move the other
+		f.Number(1, 10)
+		if f.Int64() == c.Int64() {
+			t.Error("expected the clone to advance independently")
+		}
+	}
+}
+
+func TestFakerCloneState(t *testing.T) {
+	f := New(rand.NewSource(11))
+	f.SetDefaults(Defaults{RowCount: 3})
+	if err := f.SetData("firstname", []string{"Ada"}); err != nil {
+		t.Fatal(err)
+	}
+	defer f.SetData("firstname", nil)
+
+	c := f.Clone()
+	defer c.SetData("firstname", nil)
+	if c.FirstName() != "Ada" {
+		t.Error("expected the clone to keep the data overrides")
+	}
+	if c.defaults.RowCount != 3 {
+		t.Error("expected the clone to keep the defaults")
+	}
+
+	// A replaced Rand isnt the source New was called with
+	f.Rand = rand.New(rand.NewSource(1))
+	if f.Clone().Rand.Int63() == rand.New(rand.NewSource(11)).Int63() {
+		t.Error("expected a replaced Rand to not clone the original source")
+	}
+}
+
+func ExampleFaker_Seed() {
+	f := New(rand.NewSource(1))
+	f.Seed(11)
+	fmt.Println(f.Name())
+	// Output: Markus Moen
+}
+
+func TestFakerSeed(t *testing.T) {
+	f := New(rand.NewSource(11))
+
+	sequence := func() []string {
+		return []string{f.Name(), f.Email(), f.UUID(), f.Generate("{number:1,1000}"), f.Sentence(5)}
+	}
+
+	f.Seed(42)
+	first := sequence()
+
+	f.Seed(42)
+	second := sequence()
+
+	if !equalSliceString(first, second) {
+		t.Errorf("expected reseeding to replay the sequence\n%v\n%v", first, second)
+	}
+
+	f.Seed(43)
+	if equalSliceString(first, sequence()) {
+		t.Error("expected a different seed to produce a different sequence")
+	}
+}
+
+func TestGlobalConcurrency(t *testing.T) {
+	// Run with go test -race to verify the global source isnt raced on
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ii := 0; ii < 100; ii++ {
+				Number(1, 100)
+				Name()
+				UUID()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func ExampleFaker_SetData() {
+	f := New(rand.NewSource(11))
+	f.SetData("firstname", []string{"Ada", "Grace"})
+	fmt.Println(f.Name())
+	// Output: Ada Moen
+}
+
+func TestFakerSetData(t *testing.T) {
+	f := New(rand.NewSource(11))
+	names := []string{"Ada", "Grace", "Linus"}
+	if err := f.SetData("firstname", names); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SetData("city", []string{"Springfield"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		if name := f.FirstName(); !stringInSlice(name, names) {
+			t.Fatalf("expected one of %v, got %s", names, name)
+		}
+		if city := f.City(); city != "Springfield" {
+			t.Fatalf("expected Springfield, got %s", city)
+		}
+	}
+
+	// Person picks from the override whether or not it is given a gender
+	for i := 0; i < 100; i++ {
+		if name := f.Person().FirstName; !stringInSlice(name, names) {
+			t.Fatalf("expected person first name to be one of %v, got %s", names, name)
+		}
+		person, err := f.PersonGender("female")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stringInSlice(person.FirstName, names) {
+			t.Fatalf("expected female first name to be one of %v, got %s", names, person.FirstName)
+		}
+	}
+
+	// Lookups called with the fakers rand see the override too
+	info := GetFuncLookup("firstname")
+	value, err := info.call(f.ctx(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stringInSlice(value.(string), names) {
+		t.Errorf("expected lookup to use the override, got %s", value)
+	}
+
+	// Overrides belong to the faker so they stay when Rand is replaced
+	f.Rand = rand.New(rand.NewSource(1))
+	if name := f.FirstName(); !stringInSlice(name, names) {
+		t.Errorf("expected the override after replacing Rand, got %s", name)
+	}
+
+	// Calling a built in CallR directly only has the rand to go on
+	value, err = info.CallR(rand.New(rand.NewSource(11)), nil, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.(string) != "Markus" {
+		t.Errorf("expected CallR to use the built in data, got %s", value)
+	}
+
+	// Other fakers keep the built in data
+	other := New(rand.NewSource(11))
+	if name := other.FirstName(); name != "Markus" {
+		t.Errorf("expected other faker to be untouched, got %s", name)
+	}
+
+	// Removing the override falls back to the built in data
+	f.SetData("firstname", nil)
+	f.SetData("city", nil)
+	f.Seed(11)
+	if name := f.FirstName(); name != "Markus" {
+		t.Errorf("expected built in data after removing the override, got %s", name)
+	}
+}
+
+func TestFakerSetDataGroupKey(t *testing.T) {
+	f := New(rand.NewSource(11))
+	defer f.SetData("person.last", nil)
+
+	if err := f.SetData("person.last", []string{"Lovelace"}); err != nil {
+		t.Fatal(err)
+	}
+	if last := f.LastName(); last != "Lovelace" {
+		t.Errorf("expected Lovelace, got %s", last)
+	}
+
+	for _, category := range []string{"notacategory", "person.notakey", "person"} {
+		if err := f.SetData(category, []string{"a"}); err == nil {
+			t.Errorf("expected error for category %s", category)
+		}
+	}
+}
+
+func TestFakerDefaults(t *testing.T) {
+	f := New(rand.NewSource(11))
+	f.SetDefaults(Defaults{RowCount: 5, Delimiter: ";"})
+
+	fields := []Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "first_name", Function: "firstname"},
+	}
+	co := &CSVOptions{RowCount: 0, Fields: fields}
+	value, err := f.CSV(co)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Errorf("expected the default of 5 rows plus a header got %d lines", len(lines))
+	}
+	if lines[0] != "id;first_name" {
+		t.Errorf("expected the default delimiter got %s", lines[0])
+	}
+	if co.RowCount != 0 || co.Delimiter != "" {
+		t.Errorf("expected the callers options to be left as is got %+v", co)
+	}
+
+	// Explicit values take precedence over the defaults
+	value, err = f.CSV(&CSVOptions{RowCount: 2, Delimiter: "|", Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+	if len(lines) != 3 || lines[0] != "id|first_name" {
+		t.Errorf("expected explicit row count and delimiter got %q", lines)
+	}
+
+	b := &strings.Builder{}
+	if err := f.CSVWriter(b, &CSVOptions{Fields: fields, NoHeader: true}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(b.String(), "\n") != 5 {
+		t.Errorf("expected csv writer to use the default row count got %s", b.String())
+	}
+
+	// Other fakers are not affected
+	if _, err := New(rand.NewSource(11)).CSV(&CSVOptions{Fields: fields}); err == nil {
+		t.Error("expected an error without a row count or defaults")
+	}
+}
@@ -1,24 +1,140 @@
 package gofakeit
 
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+// FileExtension will generate a random file extension
+func FileExtension() string { return fileExtension(globalFaker.ctx()) }
+
 // FileExtension will generate a random file extension
-func FileExtension() string {
-	return getRandValue([]string{"file", "extension"})
+func (f *Faker) FileExtension() string { return fileExtension(f.ctx()) }
+
+func fileExtension(r fakerCtx) string {
+	return getRandValue(r, []string{"file", "extension"})
 }
 
 // FileMimeType will generate a random mime file type
-func FileMimeType() string {
-	return getRandValue([]string{"file", "mime_type"})
+func FileMimeType() string { return fileMimeType(globalFaker.ctx()) }
+
+// FileMimeType will generate a random mime file type
+func (f *Faker) FileMimeType() string { return fileMimeType(f.ctx()) }
+
+func fileMimeType(r fakerCtx) string {
+	return getRandValue(r, []string{"file", "mime_type"})
+}
+
+// FileInfo is a struct of a file name with an extension and the mime type that goes with it
+type FileInfo struct {
+	Name      string `json:"name" xml:"name"`
+	Extension string `json:"extension" xml:"extension"`
+	MimeType  string `json:"mime_type" xml:"mime_type"`
+}
+
+// File will generate a struct with a random file name, extension and a mime type that matches the extension
+func File() *FileInfo { return file(globalFaker.ctx()) }
+
+// File will generate a struct with a random file name, extension and a mime type that matches the extension
+func (f *Faker) File() *FileInfo { return file(f.ctx()) }
+
+func file(r fakerCtx) *FileInfo {
+	ft := data.FileTypes[r.Intn(len(data.FileTypes))]
+
+	words := make([]string, number(r, 1, 2))
+	for i := range words {
+		words[i] = strings.ToLower(noun(r))
+	}
+
+	return &FileInfo{
+		Name:      strings.Join(words, "_") + "." + ft.Extension,
+		Extension: ft.Extension,
+		MimeType:  ft.MimeType,
+	}
+}
+
+// fileSizeUnits are the decimal units used by FileSize, each 1000 times the last
+var fileSizeUnits = []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"}
+
+// FileSize will generate a random human readable size like 4.2 MB between min and max bytes,
+// using the largest decimal unit the size reaches
+func FileSize(min, max int64) string { return fileSize(globalFaker.ctx(), min, max) }
+
+// FileSize will generate a random human readable size like 4.2 MB between min and max bytes,
+// using the largest decimal unit the size reaches
+func (f *Faker) FileSize(min, max int64) string { return fileSize(f.ctx(), min, max) }
+
+func fileSize(r fakerCtx, min, max int64) string {
+	return fileSizeFormat(fileSizeBytes(r, min, max))
+}
+
+// FileSizeBytes will generate a random byte count between min and max, negative values are treated as 0
+func FileSizeBytes(min, max int64) int64 { return fileSizeBytes(globalFaker.ctx(), min, max) }
+
+// FileSizeBytes will generate a random byte count between min and max, negative values are treated as 0
+func (f *Faker) FileSizeBytes(min, max int64) int64 { return fileSizeBytes(f.ctx(), min, max) }
+
+func fileSizeBytes(r fakerCtx, min, max int64) int64 {
+	if min < 0 {
+		min = 0
+	}
+	if max < 0 {
+		max = 0
+	}
+	if min > max {
+		min, max = max, min
+	}
+	if max-min == math.MaxInt64 {
+		return r.Int63()
+	}
+
+	return r.Int63n(max-min+1) + min
+}
+
+// fileSizeFormat formats bytes with one decimal, dropping it when it is zero
+func fileSizeFormat(bytes int64) string {
+	value := float64(bytes)
+	unit := 0
+	for value >= 1000 && unit < len(fileSizeUnits)-1 {
+		value /= 1000
+		unit++
+	}
+
+	// Rounding can carry over into the next unit, like 999.96 KB
+	if unit > 0 && math.Round(value*10)/10 >= 1000 && unit < len(fileSizeUnits)-1 {
+		value /= 1000
+		unit++
+	}
+
+	if unit == 0 {
+		return strconv.FormatInt(bytes, 10) + " B"
+	}
+	return strings.TrimSuffix(strconv.FormatFloat(value, 'f', 1, 64), ".0") + " " + fileSizeUnits[unit]
 }
 
 func addFileLookup() {
+	AddFuncLookup("file", Info{
+		Display:     "File",
+		Category:    "file",
+		Description: "Random file name with an extension and its matching mime type",
+		Example:     `{name: "cat_context.rar", extension: "rar", mime_type: "application/vnd.rar"}`,
+		Output:      "map[string]string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return file(r), nil
+		},
+	})
+
 	AddFuncLookup("fileextension", Info{
 		Display:     "File Extension",
 		Category:    "file",
 		Description: "Random file extension",
 		Example:     "nes",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return FileExtension(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return fileExtension(r), nil
 		},
 	})
 
@@ -28,8 +144,58 @@ func addFileLookup() {
 		Description: "Random file mime type",
 		Example:     "application/json",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return FileMimeType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return fileMimeType(r), nil
+		},
+	})
+
+	AddFuncLookup("filesize", Info{
+		Display:     "File Size",
+		Category:    "file",
+		Description: "Random human readable file size between min and max bytes",
+		Example:     "4.2 MB",
+		Output:      "string",
+		Params: []Param{
+			{Field: "min", Display: "Min", Type: "int", Default: "0", Description: "Minimum number of bytes"},
+			{Field: "max", Display: "Max", Type: "int", Default: "1000000000", Description: "Maximum number of bytes"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetInt(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetInt(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			return fileSize(r, int64(min), int64(max)), nil
+		},
+	})
+
+	AddFuncLookup("filesizebytes", Info{
+		Display:     "File Size Bytes",
+		Category:    "file",
+		Description: "Random number of bytes between min and max",
+		Example:     "4194304",
+		Output:      "int64",
+		Params: []Param{
+			{Field: "min", Display: "Min", Type: "int", Default: "0", Description: "Minimum number of bytes"},
+			{Field: "max", Display: "Max", Type: "int", Default: "1000000000", Description: "Maximum number of bytes"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetInt(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetInt(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			return fileSizeBytes(r, int64(min), int64(max)), nil
 		},
 	})
 }
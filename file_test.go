@@ -2,6 +2,9 @@ package gofakeit
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -28,3 +31,143 @@ func BenchmarkFileExtension(b *testing.B) {
 		FileExtension()
 	}
 }
+
+func ExampleFileSize() {
+	Seed(11)
+	fmt.Println(FileSize(1000, 10000000000))
+	// Output: 7 GB
+}
+
+func ExampleFileSizeBytes() {
+	Seed(11)
+	fmt.Println(FileSizeBytes(1000, 10000000000))
+	// Output: 6982003188
+}
+
+// fileSizeTestParse parses a FileSize string back to bytes along with how far off rounding can make it
+func fileSizeTestParse(size string) (float64, float64, error) {
+	parts := strings.Split(size, " ")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected value and unit, got %s", size)
+	}
+
+	value, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	for i, unit := range fileSizeUnits {
+		if unit == parts[1] {
+			scale := math.Pow(1000, float64(i))
+			return value * scale, 0.05 * scale, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("unknown unit in %s", size)
+}
+
+func TestFileSize(t *testing.T) {
+	ranges := [][2]int64{{0, 999}, {1000, 999999}, {512000, 512000}, {1, 5000000000}, {999950, 999999}, {0, math.MaxInt64}}
+	for _, rg := range ranges {
+		for i := 0; i < 1000; i++ {
+			size := FileSize(rg[0], rg[1])
+			bytes, tolerance, err := fileSizeTestParse(size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes < float64(rg[0])-tolerance || bytes > float64(rg[1])+tolerance {
+				t.Fatalf("expected %s to be between %d and %d bytes", size, rg[0], rg[1])
+			}
+		}
+	}
+}
+
+func TestFileSizeFormat(t *testing.T) {
+	tests := map[int64]string{
+		0:             "0 B",
+		999:           "999 B",
+		1000:          "1 KB",
+		512000:        "512 KB",
+		4200000:       "4.2 MB",
+		999960:        "1 MB",
+		1100000000:    "1.1 GB",
+		math.MaxInt64: "9.2 EB",
+	}
+	for bytes, expected := range tests {
+		if got := fileSizeFormat(bytes); got != expected {
+			t.Errorf("expected %d bytes to format as %s, got %s", bytes, expected, got)
+		}
+	}
+}
+
+func TestFileSizeBytes(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		if b := FileSizeBytes(100, 200); b < 100 || b > 200 {
+			t.Fatalf("expected bytes between 100 and 200, got %d", b)
+		}
+		if b := FileSizeBytes(200, 100); b < 100 || b > 200 {
+			t.Fatalf("expected swapped min and max to still be in range, got %d", b)
+		}
+		if b := FileSizeBytes(-50, -10); b != 0 {
+			t.Fatalf("expected negative sizes to be 0, got %d", b)
+		}
+	}
+}
+
+func BenchmarkFileSize(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FileSize(0, 1000000000)
+	}
+}
+
+func ExampleFile() {
+	Seed(11)
+	file := File()
+	fmt.Println(file.Name)
+	fmt.Println(file.Extension)
+	fmt.Println(file.MimeType)
+	// Output: cat_context.rar
+	// rar
+	// application/vnd.rar
+}
+
+func TestFile(t *testing.T) {
+	mimeTypes := map[string]string{
+		"csv":  "text/csv",
+		"gif":  "image/gif",
+		"html": "text/html",
+		"jpg":  "image/jpeg",
+		"jpeg": "image/jpeg",
+		"json": "application/json",
+		"mp3":  "audio/mpeg",
+		"mp4":  "video/mp4",
+		"pdf":  "application/pdf",
+		"png":  "image/png",
+		"txt":  "text/plain",
+		"zip":  "application/zip",
+	}
+
+	seen := 0
+	for i := 0; i < 1000; i++ {
+		file := File()
+		if !strings.HasSuffix(file.Name, "."+file.Extension) {
+			t.Fatalf("expected name %s to end with extension %s", file.Name, file.Extension)
+		}
+		if strings.Count(file.MimeType, "/") != 1 {
+			t.Fatalf("invalid mime type %s", file.MimeType)
+		}
+		if want, ok := mimeTypes[file.Extension]; ok {
+			seen++
+			if file.MimeType != want {
+				t.Fatalf("expected extension %s to have mime type %s got %s", file.Extension, want, file.MimeType)
+			}
+		}
+	}
+	if seen == 0 {
+		t.Error("expected some files with a known extension")
+	}
+}
+
+func BenchmarkFile(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		File()
+	}
+}
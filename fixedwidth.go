@@ -0,0 +1,186 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FixedWidthOptions defines values needed for fixed width generation
+type FixedWidthOptions struct {
+	RowCount int               `json:"row_count" xml:"row_count"`
+	Fields   []FixedWidthField `json:"fields" xml:"fields"`
+}
+
+// FixedWidthField is a field with the column layout used for fixed width outputs
+type FixedWidthField struct {
+	Field
+	Width int    `json:"width" xml:"width"` // column width in bytes, defaults to 10
+	Align string `json:"align" xml:"align"` // left or right, defaults to left
+	Pad   string `json:"pad" xml:"pad"`     // single character used to fill, defaults to space
+}
+
+// UnmarshalJSON decodes the embedded field and the column layout, without it
+// the promoted Field.UnmarshalJSON would skip width, align and pad
+func (f *FixedWidthField) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &f.Field); err != nil {
+		return err
+	}
+
+	layout := struct {
+		Width int    `json:"width"`
+		Align string `json:"align"`
+		Pad   string `json:"pad"`
+	}{f.Width, f.Align, f.Pad}
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return err
+	}
+	f.Width, f.Align, f.Pad = layout.Width, layout.Align, layout.Pad
+
+	return nil
+}
+
+// fixedWidthDefault is the column width used when a field does not set one
+const fixedWidthDefault = 10
+
+// FixedWidth generates rows of values laid out in fixed width columns.
+// Values longer than their column are truncated and shorter ones are padded, null values are left blank
+func FixedWidth(fo *FixedWidthOptions) ([]byte, error) { return fixedWidth(globalFaker.ctx(), fo) }
+
+// FixedWidth generates rows of values laid out in fixed width columns.
+// Values longer than their column are truncated and shorter ones are padded, null values are left blank
+func (f *Faker) FixedWidth(fo *FixedWidthOptions) ([]byte, error) { return fixedWidth(f.ctx(), fo) }
+
+func fixedWidth(r fakerCtx, fo *FixedWidthOptions) ([]byte, error) {
+	// Check fields
+	if fo.Fields == nil || len(fo.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build fixed width rows")
+	}
+
+	// Make sure you set a row count
+	if fo.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	// Validate column layouts, defaults go in a copy so the options can be reused as is
+	fields := make([]FixedWidthField, len(fo.Fields))
+	copy(fields, fo.Fields)
+	for i, field := range fields {
+		if field.Width == 0 {
+			fields[i].Width = fixedWidthDefault
+		} else if field.Width < 0 {
+			return nil, errors.New("Invalid width for field " + field.Name + ", must be greater than 0")
+		}
+		if field.Align == "" {
+			fields[i].Align = "left"
+		} else if field.Align != "left" && field.Align != "right" {
+			return nil, errors.New("Invalid align for field " + field.Name + ", must be left or right")
+		}
+		if field.Pad == "" {
+			fields[i].Pad = " "
+		} else if len(field.Pad) != 1 {
+			return nil, errors.New("Invalid pad for field " + field.Name + ", must be a single character")
+		}
+	}
+
+	b := &bytes.Buffer{}
+	for i := 1; i <= fo.RowCount; i++ {
+		for _, field := range fields {
+			var val string
+			if fieldNull(r, &field.Field) {
+				val = ""
+			} else if field.Function == "autoincrement" {
+				id, err := autoIncrement(&field.Field, i)
+				if err != nil {
+					return nil, err
+				}
+				val = fmt.Sprintf("%d", id)
+			} else if field.Function == "timeseries" {
+				ts, err := timeSeries(r, &field.Field, i)
+				if err != nil {
+					return nil, err
+				}
+				val = ts
+			} else {
+				// Get function info
+				funcInfo := GetFuncLookup(field.Function)
+				if funcInfo == nil {
+					return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+				}
+
+				value, err := fieldCall(r, &field.Field, funcInfo)
+				if err != nil {
+					return nil, err
+				}
+				val = fmt.Sprintf("%v", value)
+			}
+
+			b.WriteString(fixedWidthColumn(val, field.Width, field.Align, field.Pad))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.Bytes(), nil
+}
+
+// fixedWidthColumn truncates or pads val to exactly width bytes
+// without splitting a multibyte character
+func fixedWidthColumn(val string, width int, align string, pad string) string {
+	// Newlines would break the row layout
+	val = strings.NewReplacer("\r", " ", "\n", " ").Replace(val)
+
+	val = clampStringBytes(val, width)
+	fill := strings.Repeat(pad, width-len(val))
+	if align == "right" {
+		return fill + val
+	}
+	return val + fill
+}
+
+func addFileFixedWidthLookup() {
+	AddFuncLookup("fixedwidth", Info{
+		Display:     "Fixed Width",
+		Category:    "file",
+		Description: "Generates rows of values in fixed width columns",
+		Example: `
+			00001Markus    Moen
+			00002Alayna    Wuckert
+		`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name, function, width, align and pad to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			fo := FixedWidthOptions{}
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			fo.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				fo.Fields = make([]FixedWidthField, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &fo.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			return fixedWidth(r, &fo)
+		},
+	})
+}
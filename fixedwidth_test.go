@@ -0,0 +1,172 @@
+package gofakeit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func ExampleFixedWidth() {
+	Seed(11)
+
+	value, err := FixedWidth(&FixedWidthOptions{
+		RowCount: 2,
+		Fields: []FixedWidthField{
+			{Field: Field{Name: "id", Function: "autoincrement"}, Width: 5, Align: "right", Pad: "0"},
+			{Field: Field{Name: "first_name", Function: "firstname"}, Width: 10},
+			{Field: Field{Name: "last_name", Function: "lastname"}, Width: 10},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Spaces replaced with dots to show padding
+	fmt.Print(strings.Replace(string(value), " ", ".", -1))
+
+	// Output:
+	// 00001Markus....Moen......
+	// 00002Alayna....Wuckert...
+}
+
+func TestFixedWidthLineLength(t *testing.T) {
+	fields := []FixedWidthField{
+		{Field: Field{Name: "id", Function: "autoincrement"}, Width: 6, Align: "right", Pad: "0"},
+		{Field: Field{Name: "name", Function: "name"}, Width: 8},
+		{Field: Field{Name: "sentence", Function: "sentence"}, Width: 20},
+		{Field: Field{Name: "emoji", Function: "emoji"}, Width: 3, Align: "right"},
+	}
+	value, err := FixedWidth(&FixedWidthOptions{RowCount: 100, Fields: fields})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	total := 0
+	for _, f := range fields {
+		total += f.Width
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+	if len(lines) != 100 {
+		t.Fatalf("expected 100 lines got %d", len(lines))
+	}
+	for _, line := range lines {
+		if len(line) != total {
+			t.Fatalf("expected line length %d got %d: %q", total, len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Fatalf("line is not valid utf8: %q", line)
+		}
+	}
+}
+
+func TestFixedWidthColumn(t *testing.T) {
+	tests := []struct {
+		val   string
+		width int
+		align string
+		pad   string
+		out   string
+	}{
+		{"abc", 5, "left", " ", "abc  "},
+		{"abc", 5, "right", "0", "00abc"},
+		{"abcdef", 3, "left", " ", "abc"},
+		{"héllo", 2, "left", " ", "h "},
+	}
+
+	for _, test := range tests {
+		if got := fixedWidthColumn(test.val, test.width, test.align, test.pad); got != test.out {
+			t.Errorf("expected %q got %q", test.out, got)
+		}
+	}
+}
+
+func TestFixedWidthOptionsUnchanged(t *testing.T) {
+	fo := &FixedWidthOptions{
+		RowCount: 2,
+		Fields:   []FixedWidthField{{Field: Field{Name: "first_name", Function: "firstname"}}},
+	}
+	if _, err := FixedWidth(fo); err != nil {
+		t.Fatal(err)
+	}
+
+	field := fo.Fields[0]
+	if field.Width != 0 || field.Align != "" || field.Pad != "" {
+		t.Errorf("expected the defaults to not be written to the options got %+v", field)
+	}
+}
+
+func TestFixedWidthNull(t *testing.T) {
+	value, err := FixedWidth(&FixedWidthOptions{
+		RowCount: 100,
+		Fields: []FixedWidthField{
+			{Field: Field{Name: "id", Function: "autoincrement"}, Width: 3, Align: "right", Pad: "0"},
+			{Field: Field{Name: "first_name", Function: "firstname", NullProbability: 0.5}, Width: 12, Pad: "."},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nulls := 0
+	for _, line := range strings.Split(strings.TrimSuffix(string(value), "\n"), "\n") {
+		if len(line) != 15 {
+			t.Fatalf("expected every line to be 15 bytes got %q", line)
+		}
+		if line[3:] == "............" {
+			nulls++
+		}
+	}
+	if nulls == 0 || nulls == 100 {
+		t.Errorf("expected some blank first names got %d", nulls)
+	}
+}
+
+func TestFixedWidthInvalid(t *testing.T) {
+	_, err := FixedWidth(&FixedWidthOptions{
+		RowCount: 1,
+		Fields:   []FixedWidthField{{Field: Field{Name: "id", Function: "autoincrement"}, Width: -1}},
+	})
+	if err == nil {
+		t.Error("expected error for negative width")
+	}
+
+	_, err = FixedWidth(&FixedWidthOptions{
+		RowCount: 1,
+		Fields:   []FixedWidthField{{Field: Field{Name: "id", Function: "autoincrement"}, Width: 2, Align: "center"}},
+	})
+	if err == nil {
+		t.Error("expected error for invalid align")
+	}
+}
+
+func TestFixedWidthLookup(t *testing.T) {
+	info := GetFuncLookup("fixedwidth")
+
+	m := map[string][]string{
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement","width":4,"align":"right","pad":"0"}`,
+			`{"name":"first_name","function":"firstname","width":12}`,
+		},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !strings.HasPrefix(string(value.([]byte)), "0001") {
+		t.Errorf("expected first row to start with padded id got %s", value)
+	}
+
+	// Width defaults to 10 when not set
+	m["fields"] = []string{`{"name":"first_name","function":"firstname"}`}
+	value, err = info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if strings.Index(string(value.([]byte)), "\n") != 10 {
+		t.Errorf("expected default width of 10 got %q", value)
+	}
+}
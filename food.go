@@ -3,42 +3,77 @@ package gofakeit
 import "strings"
 
 // Fruit will return a random fruit name
-func Fruit() string {
-	return getRandValue([]string{"food", "fruit"})
+func Fruit() string { return fruit(globalFaker.ctx()) }
+
+// Fruit will return a random fruit name
+func (f *Faker) Fruit() string { return fruit(f.ctx()) }
+
+func fruit(r fakerCtx) string {
+	return getRandValue(r, []string{"food", "fruit"})
 }
 
 // Vegetable will return a random vegetable name
-func Vegetable() string {
-	return getRandValue([]string{"food", "vegetable"})
+func Vegetable() string { return vegetable(globalFaker.ctx()) }
+
+// Vegetable will return a random vegetable name
+func (f *Faker) Vegetable() string { return vegetable(f.ctx()) }
+
+func vegetable(r fakerCtx) string {
+	return getRandValue(r, []string{"food", "vegetable"})
 }
 
 // Breakfast will return a random breakfast name
-func Breakfast() string {
-	v := getRandValue([]string{"food", "breakfast"})
+func Breakfast() string { return breakfast(globalFaker.ctx()) }
+
+// Breakfast will return a random breakfast name
+func (f *Faker) Breakfast() string { return breakfast(f.ctx()) }
+
+func breakfast(r fakerCtx) string {
+	v := getRandValue(r, []string{"food", "breakfast"})
 	return strings.ToUpper(v[:1]) + v[1:]
 }
 
 // Lunch will return a random lunch name
-func Lunch() string {
-	v := getRandValue([]string{"food", "lunch"})
+func Lunch() string { return lunch(globalFaker.ctx()) }
+
+// Lunch will return a random lunch name
+func (f *Faker) Lunch() string { return lunch(f.ctx()) }
+
+func lunch(r fakerCtx) string {
+	v := getRandValue(r, []string{"food", "lunch"})
 	return strings.ToUpper(v[:1]) + v[1:]
 }
 
 // Dinner will return a random dinner name
-func Dinner() string {
-	v := getRandValue([]string{"food", "dinner"})
+func Dinner() string { return dinner(globalFaker.ctx()) }
+
+// Dinner will return a random dinner name
+func (f *Faker) Dinner() string { return dinner(f.ctx()) }
+
+func dinner(r fakerCtx) string {
+	v := getRandValue(r, []string{"food", "dinner"})
 	return strings.ToUpper(v[:1]) + v[1:]
 }
 
 // Snack will return a random snack name
-func Snack() string {
-	v := getRandValue([]string{"food", "snack"})
+func Snack() string { return snack(globalFaker.ctx()) }
+
+// Snack will return a random snack name
+func (f *Faker) Snack() string { return snack(f.ctx()) }
+
+func snack(r fakerCtx) string {
+	v := getRandValue(r, []string{"food", "snack"})
 	return strings.ToUpper(v[:1]) + v[1:]
 }
 
 // Dessert will return a random dessert name
-func Dessert() string {
-	v := getRandValue([]string{"food", "dessert"})
+func Dessert() string { return dessert(globalFaker.ctx()) }
+
+// Dessert will return a random dessert name
+func (f *Faker) Dessert() string { return dessert(f.ctx()) }
+
+func dessert(r fakerCtx) string {
+	v := getRandValue(r, []string{"food", "dessert"})
 	return strings.ToUpper(v[:1]) + v[1:]
 }
 
@@ -49,8 +84,8 @@ func addFoodLookup() {
 		Description: "Random fruit",
 		Example:     "Dates",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Fruit(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return fruit(r), nil
 		},
 	})
 
@@ -60,8 +95,8 @@ func addFoodLookup() {
 		Description: "Random vegetable",
 		Example:     "Amaranth Leaves",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Vegetable(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return vegetable(r), nil
 		},
 	})
 
@@ -71,8 +106,8 @@ func addFoodLookup() {
 		Description: "Random breakfast",
 		Example:     "Blueberry banana happy face pancakes",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Breakfast(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return breakfast(r), nil
 		},
 	})
 
@@ -82,8 +117,8 @@ func addFoodLookup() {
 		Description: "Random lunch",
 		Example:     "No bake hersheys bar pie",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Lunch(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return lunch(r), nil
 		},
 	})
 
@@ -93,8 +128,8 @@ func addFoodLookup() {
 		Description: "Random dinner",
 		Example:     "Wild addicting dip",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Dinner(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return dinner(r), nil
 		},
 	})
 
@@ -104,8 +139,8 @@ func addFoodLookup() {
 		Description: "Random snack",
 		Example:     "Hoisin marinated wing pieces",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Snack(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return snack(r), nil
 		},
 	})
 
@@ -115,8 +150,8 @@ func addFoodLookup() {
 		Description: "Random dessert",
 		Example:     "French napoleons",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Dessert(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return dessert(r), nil
 		},
 	})
 }
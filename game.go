@@ -3,8 +3,13 @@ package gofakeit
 import "fmt"
 
 // Gamertag will generate a random video game username
-func Gamertag() string {
-	return getRandValue([]string{"word", "noun"}) + getRandValue([]string{"word", "verb"}) + fmt.Sprintf("%d", Number(10, 999))
+func Gamertag() string { return gamertag(globalFaker.ctx()) }
+
+// Gamertag will generate a random video game username
+func (f *Faker) Gamertag() string { return gamertag(f.ctx()) }
+
+func gamertag(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "noun"}) + getRandValue(r, []string{"word", "verb"}) + fmt.Sprintf("%d", number(r, 10, 999))
 }
 
 func addGameLookup() {
@@ -14,8 +19,8 @@ func addGameLookup() {
 		Description: "Random gamertag",
 		Example:     "footinterpret63",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Gamertag(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return gamertag(r), nil
 		},
 	})
 }
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"math/rand"
 	"regexp/syntax"
 	"strings"
 )
@@ -23,10 +22,28 @@ import (
 // Ex: ??? - fda - random letters
 //
 // For a complete list of runnable functions use FuncsLookup
-func Generate(dataVal string) string {
+func Generate(dataVal string) string { return generate(globalFaker.ctx(), dataVal) }
+
+// Generate fake information from given string.
+// Replaceable values should be within {}
+//
+// Functions
+// Ex: {firstname} - billy
+// Ex: {sentence:3} - Record river mind.
+// Ex: {number:1,10} - 4
+// Ex: {uuid} - 590c1440-9888-45b0-bd51-a817ee07c3f2
+//
+// Letters/Numbers
+// Ex: ### - 481 - random numbers
+// Ex: ??? - fda - random letters
+//
+// For a complete list of runnable functions use FuncsLookup
+func (f *Faker) Generate(dataVal string) string { return generate(f.ctx(), dataVal) }
+
+func generate(r fakerCtx, dataVal string) string {
 	// Replace # with numbers and ? with letters
-	dataVal = replaceWithNumbers(dataVal)
-	dataVal = replaceWithLetters(dataVal)
+	dataVal = replaceWithNumbers(r, dataVal)
+	dataVal = replaceWithLetters(r, dataVal)
 
 	// Identify items between brackets: {person.first}
 	for strings.Count(dataVal, "{") > 0 && strings.Count(dataVal, "}") > 0 {
@@ -65,7 +82,7 @@ func Generate(dataVal string) string {
 			}
 
 			// Call function
-			fValue, err := info.Call(&mapParams, info)
+			fValue, err := info.call(r, &mapParams)
 			if err != nil {
 				// If we came across an error just dont replace value
 				dataVal = strings.Replace(dataVal, "{"+fParts+"}", err.Error(), 1)
@@ -84,16 +101,81 @@ func Generate(dataVal string) string {
 }
 
 // Regex will generate a string based upon a RE2 syntax
-func Regex(regexStr string) string {
+func Regex(regexStr string) string { return regex(globalFaker.ctx(), regexStr) }
+
+// Regex will generate a string based upon a RE2 syntax
+func (f *Faker) Regex(regexStr string) string { return regex(f.ctx(), regexStr) }
+
+func regex(r fakerCtx, regexStr string) string {
 	re, err := syntax.Parse(regexStr, syntax.Perl)
 	if err != nil {
 		return "Could not parse regex string"
 	}
 
-	return regexGenerate(re)
+	return regexGenerate(r, re)
+}
+
+// RegexE will generate a string based upon a RE2 syntax.
+// Supports literals, character classes such as \d \w \s, quantifiers and alternation.
+// An error is returned if the string cant be parsed or uses a construct that cant be generated, such as \b
+func RegexE(regexStr string) (string, error) { return regexE(globalFaker.ctx(), regexStr) }
+
+// RegexE will generate a string based upon a RE2 syntax.
+// Supports literals, character classes such as \d \w \s, quantifiers and alternation.
+// An error is returned if the string cant be parsed or uses a construct that cant be generated, such as \b
+func (f *Faker) RegexE(regexStr string) (string, error) { return regexE(f.ctx(), regexStr) }
+
+func regexE(r fakerCtx, regexStr string) (string, error) {
+	re, err := syntax.Parse(regexStr, syntax.Perl)
+	if err != nil {
+		return "", errors.New("Could not parse regex string, " + err.Error())
+	}
+
+	if err := regexCheck(re); err != nil {
+		return "", err
+	}
+
+	return regexGenerate(r, re), nil
 }
 
-func regexGenerate(re *syntax.Regexp) string {
+// regexCheck walks the parsed regex and returns an error for anything regexGenerate cant satisfy
+func regexCheck(re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return errors.New("Unsupported regex, pattern matches no strings")
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return errors.New("Unsupported regex, word boundaries are not supported")
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return errors.New("Unsupported regex, character class matches no characters")
+		}
+		for i := 0; i < len(re.Rune); i += 2 {
+			if re.Rune[i+1] != 0x10ffff {
+				continue
+			}
+
+			// Negated classes are picked from allStr so make sure at least one character fits
+			for j := 0; j < len(allStr); j++ {
+				for k := 0; k < len(re.Rune); k += 2 {
+					if rune(allStr[j]) >= re.Rune[k] && rune(allStr[j]) <= re.Rune[k+1] {
+						return nil
+					}
+				}
+			}
+			return errors.New("Unsupported regex, character class " + re.String() + " has no printable characters")
+		}
+	}
+
+	for _, sub := range re.Sub {
+		if err := regexCheck(sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func regexGenerate(r fakerCtx, re *syntax.Regexp) string {
 	op := re.Op
 	switch op {
 	case syntax.OpNoMatch: // matches no strings
@@ -102,8 +184,8 @@ func regexGenerate(re *syntax.Regexp) string {
 		return ""
 	case syntax.OpLiteral: // matches Runes sequence
 		var b strings.Builder
-		for _, r := range re.Rune {
-			b.WriteRune(r)
+		for _, ru := range re.Rune {
+			b.WriteRune(ru)
 		}
 		return b.String()
 	case syntax.OpCharClass: // matches Runes interpreted as range pair list
@@ -132,17 +214,17 @@ func regexGenerate(re *syntax.Regexp) string {
 				}
 			}
 			if len(chars) > 0 {
-				return string([]byte{chars[rand.Intn(len(chars))]})
+				return string([]byte{chars[r.Intn(len(chars))]})
 			}
 		}
 
-		r := rand.Intn(int(sum))
+		ri := r.Intn(int(sum))
 		var ru rune
 		sum = 0
 		for i := 0; i < len(re.Rune); i += 2 {
 			gap := int(re.Rune[i+1]-re.Rune[i]) + 1
-			if sum+gap > r {
-				ru = re.Rune[i] + rune(r-sum)
+			if sum+gap > ri {
+				ru = re.Rune[i] + rune(ri-sum)
 				break
 			}
 			sum += gap
@@ -150,7 +232,7 @@ func regexGenerate(re *syntax.Regexp) string {
 
 		return string(ru)
 	case syntax.OpAnyCharNotNL, syntax.OpAnyChar: // matches any character(and except newline)
-		return randCharacter(allStr)
+		return randCharacter(r, allStr)
 	case syntax.OpBeginLine: // matches empty string at beginning of line
 	case syntax.OpEndLine: // matches empty string at end of line
 	case syntax.OpBeginText: // matches empty string at beginning of text
@@ -158,28 +240,28 @@ func regexGenerate(re *syntax.Regexp) string {
 	case syntax.OpWordBoundary: // matches word boundary `\b`
 	case syntax.OpNoWordBoundary: // matches word non-boundary `\B`
 	case syntax.OpCapture: // capturing subexpression with index Cap, optional name Name
-		return regexGenerate(re.Sub0[0])
+		return regexGenerate(r, re.Sub0[0])
 	case syntax.OpStar: // matches Sub[0] zero or more times
 		var b strings.Builder
-		for i := 0; i < Number(0, 10); i++ {
-			for _, r := range re.Sub {
-				b.WriteString(regexGenerate(r))
+		for i := 0; i < number(r, 0, 10); i++ {
+			for _, rs := range re.Sub {
+				b.WriteString(regexGenerate(r, rs))
 			}
 		}
 		return b.String()
 	case syntax.OpPlus: // matches Sub[0] one or more times
 		var b strings.Builder
-		for i := 0; i < Number(1, 10); i++ {
-			for _, r := range re.Sub {
-				b.WriteString(regexGenerate(r))
+		for i := 0; i < number(r, 1, 10); i++ {
+			for _, rs := range re.Sub {
+				b.WriteString(regexGenerate(r, rs))
 			}
 		}
 		return b.String()
 	case syntax.OpQuest: // matches Sub[0] zero or one times
 		var b strings.Builder
-		for i := 0; i < Number(0, 1); i++ {
-			for _, r := range re.Sub {
-				b.WriteString(regexGenerate(r))
+		for i := 0; i < number(r, 0, 1); i++ {
+			for _, rs := range re.Sub {
+				b.WriteString(regexGenerate(r, rs))
 			}
 		}
 		return b.String()
@@ -188,85 +270,135 @@ func regexGenerate(re *syntax.Regexp) string {
 		count := 0
 		re.Max = int(math.Min(float64(re.Max), float64(10)))
 		if re.Max > re.Min {
-			count = rand.Intn(re.Max - re.Min + 1)
+			count = r.Intn(re.Max - re.Min + 1)
 		}
 		for i := 0; i < re.Min || i < (re.Min+count); i++ {
-			for _, r := range re.Sub {
-				b.WriteString(regexGenerate(r))
+			for _, rs := range re.Sub {
+				b.WriteString(regexGenerate(r, rs))
 			}
 		}
 		return b.String()
 	case syntax.OpConcat: // matches concatenation of Subs
 		var b strings.Builder
-		for _, r := range re.Sub {
-			b.WriteString(regexGenerate(r))
+		for _, rs := range re.Sub {
+			b.WriteString(regexGenerate(r, rs))
 		}
 		return b.String()
 	case syntax.OpAlternate: // matches alternation of Subs
-		return regexGenerate(re.Sub[Number(0, len(re.Sub)-1)])
+		return regexGenerate(r, re.Sub[number(r, 0, len(re.Sub)-1)])
 	}
 
 	return ""
 }
 
 // Map will generate a random set of map data
-func Map() map[string]interface{} {
+func Map() map[string]interface{} { return mapFunc(globalFaker.ctx()) }
+
+// Map will generate a random set of map data
+func (f *Faker) Map() map[string]interface{} { return mapFunc(f.ctx()) }
+
+func mapFunc(r fakerCtx) map[string]interface{} {
 	m := map[string]interface{}{}
 
 	randWordType := func() string {
-		s := RandomString([]string{"lorem", "bs", "job", "name", "address"})
+		s := randomString(r, []string{"lorem", "bs", "job", "name", "address"})
 		switch s {
 		case "bs":
-			return BS()
+			return bs(r)
 		case "job":
-			return JobTitle()
+			return jobTitle(r)
 		case "name":
-			return Name()
+			return name(r)
 		case "address":
-			return Street() + ", " + City() + ", " + State() + " " + Zip()
+			return street(r) + ", " + city(r) + ", " + state(r) + " " + zip(r)
 		}
-		return Word()
+		return word(r)
 	}
 
 	randSlice := func() []string {
 		var sl []string
-		for ii := 0; ii < Number(3, 10); ii++ {
-			sl = append(sl, Word())
+		for ii := 0; ii < number(r, 3, 10); ii++ {
+			sl = append(sl, word(r))
 		}
 		return sl
 	}
 
-	for i := 0; i < Number(3, 10); i++ {
-		t := RandomString([]string{"string", "int", "float", "slice", "map"})
+	for i := 0; i < number(r, 3, 10); i++ {
+		t := randomString(r, []string{"string", "int", "float", "slice", "map"})
 		switch t {
 		case "string":
-			m[Word()] = randWordType()
+			m[word(r)] = randWordType()
 		case "int":
-			m[Word()] = Number(1, 10000000)
+			m[word(r)] = number(r, 1, 10000000)
 		case "float":
-			m[Word()] = Float32Range(1, 1000000)
+			m[word(r)] = float32Range(r, 1, 1000000)
 		case "slice":
-			m[Word()] = randSlice()
+			m[word(r)] = randSlice()
 		case "map":
 			mm := map[string]interface{}{}
-			tt := RandomString([]string{"string", "int", "float", "slice"})
+			tt := randomString(r, []string{"string", "int", "float", "slice"})
 			switch tt {
 			case "string":
-				mm[Word()] = randWordType()
+				mm[word(r)] = randWordType()
 			case "int":
-				mm[Word()] = Number(1, 10000000)
+				mm[word(r)] = number(r, 1, 10000000)
 			case "float":
-				mm[Word()] = Float32Range(1, 1000000)
+				mm[word(r)] = float32Range(r, 1, 1000000)
 			case "slice":
-				mm[Word()] = randSlice()
+				mm[word(r)] = randSlice()
 			}
-			m[Word()] = mm
+			m[word(r)] = mm
 		}
 	}
 
 	return m
 }
 
+// MapSchema will generate a map from a list of fields where every value keeps
+// the native type returned by its function instead of being turned into a string
+func MapSchema(fields []Field) (map[string]interface{}, error) {
+	return mapSchema(globalFaker.ctx(), fields)
+}
+
+// MapSchema will generate a map from a list of fields where every value keeps
+// the native type returned by its function instead of being turned into a string
+func (f *Faker) MapSchema(fields []Field) (map[string]interface{}, error) {
+	return mapSchema(f.ctx(), fields)
+}
+
+func mapSchema(r fakerCtx, fields []Field) (map[string]interface{}, error) {
+	if len(fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build map")
+	}
+
+	// Rows are built the same way as json so object, array, ref and autoincrement fields work the same
+	row, err := jsonRow(r, fields, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSchemaValue(row).(map[string]interface{}), nil
+}
+
+// mapSchemaValue converts ordered json rows into plain maps and slices
+func mapSchemaValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case jsonOrderedKeyVal:
+		m := make(map[string]interface{}, len(val))
+		for _, kv := range val {
+			m[kv.Key] = mapSchemaValue(kv.Value)
+		}
+		return m
+	case []jsonOrderedKeyVal:
+		s := make([]map[string]interface{}, len(val))
+		for i, obj := range val {
+			s[i] = mapSchemaValue(obj).(map[string]interface{})
+		}
+		return s
+	}
+	return v
+}
+
 func addGenerateLookup() {
 	AddFuncLookup("generate", Info{
 		Display:     "Generate",
@@ -277,7 +409,7 @@ func addGenerateLookup() {
 		Params: []Param{
 			{Field: "str", Display: "String", Type: "string", Description: "String value to generate from"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			str, err := info.GetString(m, "str")
 			if err != nil {
 				return nil, err
@@ -288,7 +420,7 @@ func addGenerateLookup() {
 				return nil, errors.New("String length is too large. Limit to 1000 characters")
 			}
 
-			return Generate(str), nil
+			return generate(r, str), nil
 		},
 	})
 
@@ -301,7 +433,7 @@ func addGenerateLookup() {
 		Params: []Param{
 			{Field: "str", Display: "String", Type: "string", Description: "Regex RE2 syntax string"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			str, err := info.GetString(m, "str")
 			if err != nil {
 				return nil, err
@@ -312,7 +444,7 @@ func addGenerateLookup() {
 				return nil, errors.New("String length is too large. Limit to 500 characters")
 			}
 
-			return Regex(str), nil
+			return regexE(r, str)
 		},
 	})
 }
@@ -60,6 +60,9 @@ var regexes = []struct{ test string }{
 	{`[^0-5a-z\s]{5}`},
 	{`123[0-2]+.*\w{3}`},
 	{`(hello|world|whats|up)`},
+	{`[A-Z]{3}-\d{6}`},
+	{`\w{2,4}\s\d{3}`},
+	{`(foo|bar|baz){2}-[a-c]{1,3}`},
 	{`^\d{1,2}[/](1[0-2]|[1-9])[/]((19|20)\d{2})$`},
 	{`^((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])$`},
 	{"^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$"},
@@ -83,6 +86,61 @@ func TestRegex(t *testing.T) {
 	}
 }
 
+func ExampleRegexE() {
+	Seed(11)
+
+	value, err := RegexE(`[A-Z]{3}-\d{6}`)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+	// Output: GBR-645994
+}
+
+func TestRegexE(t *testing.T) {
+	for _, r := range regexes {
+		regCompile := regexp.MustCompile(r.test)
+		for i := 0; i < 100; i++ {
+			reg, err := RegexE(r.test)
+			if err != nil {
+				t.Fatal(r.test, err)
+			}
+			if !regCompile.MatchString(reg) {
+				t.Error("Generated data does not match regex. Regex: ", r.test, " output: ", reg)
+			}
+		}
+	}
+}
+
+func TestRegexEErrors(t *testing.T) {
+	for _, test := range []string{`[a-z`, `\bword\b`, `a\Bb`, `[^\x00-\x{10FFFF}]`} {
+		_, err := RegexE(test)
+		if err == nil {
+			t.Errorf("expected error for %s", test)
+		}
+	}
+}
+
+func TestRegexLookup(t *testing.T) {
+	info := GetFuncLookup("regex")
+
+	m := map[string][]string{"str": {`[A-Z]{3}-\d{6}`}}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(`^[A-Z]{3}-\d{6}$`).MatchString(value.(string)) {
+		t.Errorf("%s does not match", value)
+	}
+
+	m = map[string][]string{"str": {`\bword`}}
+	_, err = info.call(globalFaker.ctx(), &m)
+	if err == nil {
+		t.Error("expected error for unsupported regex")
+	}
+}
+
 func BenchmarkRegex(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Regex(`(hello|world|whats|up)`)
@@ -113,3 +171,77 @@ func BenchmarkMap(b *testing.B) {
 		Map()
 	}
 }
+
+func ExampleMapSchema() {
+	Seed(11)
+
+	value, err := MapSchema([]Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "name", Function: "firstname"},
+		{Name: "age", Function: "number", Params: map[string][]string{"min": {"18"}, "max": {"99"}}},
+		{Name: "active", Function: "bool"},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+	// Output: map[active:true age:95 id:1 name:Markus]
+}
+
+func TestMapSchema(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		value, err := MapSchema([]Field{
+			{Name: "age", Function: "number", Params: map[string][]string{"min": {"18"}, "max": {"99"}}},
+			{Name: "active", Function: "bool"},
+			{Name: "price", Function: "price", Params: map[string][]string{"min": {"1"}, "max": {"10"}}},
+			{Name: "name", Function: "firstname"},
+			{Name: "empty", Function: "lastname", NullProbability: 1},
+			{Name: "owner", Function: "object", Params: map[string][]string{"fields": {`{"name":"id","function":"autoincrement"}`}}},
+			{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hi {name}"}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := value["age"].(int); !ok {
+			t.Fatalf("expected age to be an int, got %T", value["age"])
+		}
+		if _, ok := value["active"].(bool); !ok {
+			t.Fatalf("expected active to be a bool, got %T", value["active"])
+		}
+		if _, ok := value["price"].(float64); !ok {
+			t.Fatalf("expected price to be a float64, got %T", value["price"])
+		}
+		if value["empty"] != nil {
+			t.Fatalf("expected empty to be nil, got %v", value["empty"])
+		}
+		owner, ok := value["owner"].(map[string]interface{})
+		if !ok || owner["id"] != 1 {
+			t.Fatalf("expected owner to be a map with id 1, got %v", value["owner"])
+		}
+		if value["greeting"] != "hi "+value["name"].(string) {
+			t.Fatalf("expected greeting to reference name, got %v", value["greeting"])
+		}
+	}
+}
+
+func TestMapSchemaErrors(t *testing.T) {
+	if _, err := MapSchema(nil); err == nil {
+		t.Error("expected error for missing fields")
+	}
+	if _, err := MapSchema([]Field{{Name: "a", Function: "notafunction"}}); err == nil {
+		t.Error("expected error for invalid function")
+	}
+}
+
+func BenchmarkMapSchema(b *testing.B) {
+	fields := []Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "name", Function: "firstname"},
+		{Name: "active", Function: "bool"},
+	}
+	for i := 0; i < b.N; i++ {
+		MapSchema(fields)
+	}
+}
@@ -3,35 +3,65 @@ package gofakeit
 import "strings"
 
 // HackerPhrase will return a random hacker sentence
-func HackerPhrase() string {
-	words := strings.Split(Generate(getRandValue([]string{"hacker", "phrase"})), " ")
+func HackerPhrase() string { return hackerPhrase(globalFaker.ctx()) }
+
+// HackerPhrase will return a random hacker sentence
+func (f *Faker) HackerPhrase() string { return hackerPhrase(f.ctx()) }
+
+func hackerPhrase(r fakerCtx) string {
+	words := strings.Split(generate(r, getRandValue(r, []string{"hacker", "phrase"})), " ")
 	words[0] = strings.Title(words[0])
 	return strings.Join(words, " ")
 }
 
 // HackerAbbreviation will return a random hacker abbreviation
-func HackerAbbreviation() string {
-	return getRandValue([]string{"hacker", "abbreviation"})
+func HackerAbbreviation() string { return hackerAbbreviation(globalFaker.ctx()) }
+
+// HackerAbbreviation will return a random hacker abbreviation
+func (f *Faker) HackerAbbreviation() string { return hackerAbbreviation(f.ctx()) }
+
+func hackerAbbreviation(r fakerCtx) string {
+	return getRandValue(r, []string{"hacker", "abbreviation"})
 }
 
 // HackerAdjective will return a random hacker adjective
-func HackerAdjective() string {
-	return getRandValue([]string{"hacker", "adjective"})
+func HackerAdjective() string { return hackerAdjective(globalFaker.ctx()) }
+
+// HackerAdjective will return a random hacker adjective
+func (f *Faker) HackerAdjective() string { return hackerAdjective(f.ctx()) }
+
+func hackerAdjective(r fakerCtx) string {
+	return getRandValue(r, []string{"hacker", "adjective"})
 }
 
 // HackerNoun will return a random hacker noun
-func HackerNoun() string {
-	return getRandValue([]string{"hacker", "noun"})
+func HackerNoun() string { return hackerNoun(globalFaker.ctx()) }
+
+// HackerNoun will return a random hacker noun
+func (f *Faker) HackerNoun() string { return hackerNoun(f.ctx()) }
+
+func hackerNoun(r fakerCtx) string {
+	return getRandValue(r, []string{"hacker", "noun"})
 }
 
 // HackerVerb will return a random hacker verb
-func HackerVerb() string {
-	return getRandValue([]string{"hacker", "verb"})
+func HackerVerb() string { return hackerVerb(globalFaker.ctx()) }
+
+// HackerVerb will return a random hacker verb
+func (f *Faker) HackerVerb() string { return hackerVerb(f.ctx()) }
+
+func hackerVerb(r fakerCtx) string {
+	return getRandValue(r, []string{"hacker", "verb"})
 }
 
 // HackeringVerb will return a random hacker ingverb
-func HackeringVerb() string {
-	return getRandValue([]string{"hacker", "ingverb"})
+func HackeringVerb() string { return hackeringVerb(globalFaker.ctx()) }
+
+// HackeringVerb will return a random hacker ingverb
+func (f *Faker) HackeringVerb() string { return hackeringVerb(f.ctx()) }
+
+func hackeringVerb(r fakerCtx) string {
+	return getRandValue(r, []string{"hacker", "ingverb"})
 }
 
 func addHackerLookup() {
@@ -41,8 +71,8 @@ func addHackerLookup() {
 		Description: "Random hacker phrase",
 		Example:     "If we calculate the program, we can get to the AI pixel through the redundant XSS matrix!",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackerPhrase(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackerPhrase(r), nil
 		},
 	})
 
@@ -52,8 +82,8 @@ func addHackerLookup() {
 		Description: "Random hacker abbreviation",
 		Example:     "ADP",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackerAbbreviation(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackerAbbreviation(r), nil
 		},
 	})
 
@@ -63,8 +93,8 @@ func addHackerLookup() {
 		Description: "Random hacker adjective",
 		Example:     "wireless",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackerAdjective(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackerAdjective(r), nil
 		},
 	})
 
@@ -74,8 +104,8 @@ func addHackerLookup() {
 		Description: "Random hacker noun",
 		Example:     "driver",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackerNoun(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackerNoun(r), nil
 		},
 	})
 
@@ -85,8 +115,8 @@ func addHackerLookup() {
 		Description: "Random hacker verb",
 		Example:     "synthesize",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackerVerb(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackerVerb(r), nil
 		},
 	})
 
@@ -96,8 +126,8 @@ func addHackerLookup() {
 		Description: "Random hackering verb",
 		Example:     "connecting",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HackeringVerb(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hackeringVerb(r), nil
 		},
 	})
 }
@@ -2,7 +2,6 @@ package gofakeit
 
 import (
 	"math"
-	"math/rand"
 	"strings"
 
 	"github.com/brianvoe/gofakeit/v5/data"
@@ -46,48 +45,51 @@ func intDataCheck(dataVal []string) bool {
 }
 
 // Get Random Value
-func getRandValue(dataVal []string) string {
+func getRandValue(r fakerCtx, dataVal []string) string {
+	if values, ok := dataOverride(r, dataVal); ok {
+		return values[r.Intn(len(values))]
+	}
 	if !dataCheck(dataVal) {
 		return ""
 	}
-	return data.Data[dataVal[0]][dataVal[1]][rand.Intn(len(data.Data[dataVal[0]][dataVal[1]]))]
+	return data.Data[dataVal[0]][dataVal[1]][r.Intn(len(data.Data[dataVal[0]][dataVal[1]]))]
 }
 
 // Get Random Integer Value
-func getRandIntValue(dataVal []string) int {
+func getRandIntValue(r fakerCtx, dataVal []string) int {
 	if !intDataCheck(dataVal) {
 		return 0
 	}
-	return data.IntData[dataVal[0]][dataVal[1]][rand.Intn(len(data.IntData[dataVal[0]][dataVal[1]]))]
+	return data.IntData[dataVal[0]][dataVal[1]][r.Intn(len(data.IntData[dataVal[0]][dataVal[1]]))]
 }
 
 // Replace # with numbers
-func replaceWithNumbers(str string) string {
+func replaceWithNumbers(r fakerCtx, str string) string {
 	if str == "" {
 		return str
 	}
 	bytestr := []byte(str)
 	for i := 0; i < len(bytestr); i++ {
 		if bytestr[i] == hashtag {
-			bytestr[i] = byte(randDigit())
+			bytestr[i] = byte(randDigit(r))
 		}
 	}
 	if bytestr[0] == '0' {
-		bytestr[0] = byte(rand.Intn(8)+1) + '0'
+		bytestr[0] = byte(r.Intn(8)+1) + '0'
 	}
 
 	return string(bytestr)
 }
 
 // Replace ? with ASCII lowercase letters
-func replaceWithLetters(str string) string {
+func replaceWithLetters(r fakerCtx, str string) string {
 	if str == "" {
 		return str
 	}
 	bytestr := []byte(str)
 	for i := 0; i < len(bytestr); i++ {
 		if bytestr[i] == questionmark {
-			bytestr[i] = byte(randLetter())
+			bytestr[i] = byte(randLetter(r))
 		}
 	}
 
@@ -95,14 +97,14 @@ func replaceWithLetters(str string) string {
 }
 
 // Replace ? with ASCII lowercase letters between a and f
-func replaceWithHexLetters(str string) string {
+func replaceWithHexLetters(r fakerCtx, str string) string {
 	if str == "" {
 		return str
 	}
 	bytestr := []byte(str)
 	for i := 0; i < len(bytestr); i++ {
 		if bytestr[i] == questionmark {
-			bytestr[i] = byte(randHexLetter())
+			bytestr[i] = byte(randHexLetter(r))
 		}
 	}
 
@@ -110,45 +112,45 @@ func replaceWithHexLetters(str string) string {
 }
 
 // Generate random lowercase ASCII letter
-func randLetter() rune {
+func randLetter(r fakerCtx) rune {
 	allLetters := upperStr + lowerStr
-	return rune(allLetters[rand.Intn(len(allLetters))])
+	return rune(allLetters[r.Intn(len(allLetters))])
 }
 
-func randCharacter(s string) string {
-	return string(s[rand.Int63()%int64(len(s))])
+func randCharacter(r fakerCtx, s string) string {
+	return string(s[r.Int63()%int64(len(s))])
 }
 
 // Generate random lowercase ASCII letter between a and f
-func randHexLetter() rune {
-	return rune(byte(rand.Intn(6)) + 'a')
+func randHexLetter(r fakerCtx) rune {
+	return rune(byte(r.Intn(6)) + 'a')
 }
 
 // Generate random ASCII digit
-func randDigit() rune {
-	return rune(byte(rand.Intn(10)) + '0')
+func randDigit(r fakerCtx) rune {
+	return rune(byte(r.Intn(10)) + '0')
 }
 
 // Generate random integer between min and max
-func randIntRange(min, max int) int {
+func randIntRange(r fakerCtx, min, max int) int {
 	if min == max {
 		return min
 	}
-	return rand.Intn((max+1)-min) + min
+	return r.Intn((max+1)-min) + min
 }
 
-func randFloat32Range(min, max float32) float32 {
+func randFloat32Range(r fakerCtx, min, max float32) float32 {
 	if min == max {
 		return min
 	}
-	return rand.Float32()*(max-min) + min
+	return r.Float32()*(max-min) + min
 }
 
-func randFloat64Range(min, max float64) float64 {
+func randFloat64Range(r fakerCtx, min, max float64) float64 {
 	if min == max {
 		return min
 	}
-	return rand.Float64()*(max-min) + min
+	return r.Float64()*(max-min) + min
 }
 
 func toFixed(num float64, precision int) float64 {
@@ -172,6 +174,15 @@ func equalSliceString(a, b []string) bool {
 	return true
 }
 
+func stringInSlice(a string, list []string) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
 func funcLookupSplit(str string) []string {
 	out := []string{}
 	for str != "" {
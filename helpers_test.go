@@ -5,14 +5,14 @@ import (
 )
 
 func TestRandIntRange(t *testing.T) {
-	if randIntRange(5, 5) != 5 {
+	if randIntRange(globalFaker.ctx(), 5, 5) != 5 {
 		t.Error("You should have gotten 5 back")
 	}
 }
 
 func TestGetRandValueFail(t *testing.T) {
 	for _, test := range [][]string{nil, {}, {"not", "found"}, {"person", "notfound"}} {
-		if getRandValue(test) != "" {
+		if getRandValue(globalFaker.ctx(), test) != "" {
 			t.Error("You should have gotten no value back")
 		}
 	}
@@ -20,26 +20,26 @@ func TestGetRandValueFail(t *testing.T) {
 
 func TestGetRandIntValueFail(t *testing.T) {
 	for _, test := range [][]string{nil, {}, {"not", "found"}, {"status_code", "notfound"}} {
-		if getRandIntValue(test) != 0 {
+		if getRandIntValue(globalFaker.ctx(), test) != 0 {
 			t.Error("You should have gotten no value back")
 		}
 	}
 }
 
 func TestRandFloat32RangeSame(t *testing.T) {
-	if randFloat32Range(5.0, 5.0) != 5.0 {
+	if randFloat32Range(globalFaker.ctx(), 5.0, 5.0) != 5.0 {
 		t.Error("You should have gotten 5.0 back")
 	}
 }
 
 func TestRandFloat64RangeSame(t *testing.T) {
-	if randFloat64Range(5.0, 5.0) != 5.0 {
+	if randFloat64Range(globalFaker.ctx(), 5.0, 5.0) != 5.0 {
 		t.Error("You should have gotten 5.0 back")
 	}
 }
 
 func TestReplaceWithNumbers(t *testing.T) {
-	if replaceWithNumbers("") != "" {
+	if replaceWithNumbers(globalFaker.ctx(), "") != "" {
 		t.Error("You should have gotten an empty string")
 	}
 }
@@ -50,7 +50,7 @@ func BenchmarkReplaceWithNumbers(b *testing.B) {
 		Seed(42)
 
 		b.StartTimer()
-		replaceWithNumbers("###☺#☻##☹##")
+		replaceWithNumbers(globalFaker.ctx(), "###☺#☻##☹##")
 		b.StopTimer()
 	}
 }
@@ -62,7 +62,7 @@ func TestReplaceWithNumbersUnicode(t *testing.T) {
 		{"\x80#¼#語", "\x805¼7語"},
 	} {
 		Seed(42)
-		got := replaceWithNumbers(test.in)
+		got := replaceWithNumbers(globalFaker.ctx(), test.in)
 		if got == test.should {
 			continue
 		}
@@ -72,13 +72,13 @@ func TestReplaceWithNumbersUnicode(t *testing.T) {
 }
 
 func TestReplaceWithLetters(t *testing.T) {
-	if replaceWithLetters("") != "" {
+	if replaceWithLetters(globalFaker.ctx(), "") != "" {
 		t.Error("You should have gotten an empty string")
 	}
 }
 
 func TestReplaceWithHexLetters(t *testing.T) {
-	if "" != replaceWithHexLetters("") {
+	if "" != replaceWithHexLetters(globalFaker.ctx(), "") {
 		t.Error("You should have gotten an empty string")
 	}
 }
@@ -3,13 +3,23 @@ package gofakeit
 import "errors"
 
 // HipsterWord will return a single hipster word
-func HipsterWord() string {
-	return getRandValue([]string{"hipster", "word"})
+func HipsterWord() string { return hipsterWord(globalFaker.ctx()) }
+
+// HipsterWord will return a single hipster word
+func (f *Faker) HipsterWord() string { return hipsterWord(f.ctx()) }
+
+func hipsterWord(r fakerCtx) string {
+	return getRandValue(r, []string{"hipster", "word"})
 }
 
 // HipsterSentence will generate a random sentence
-func HipsterSentence(wordCount int) string {
-	return sentence(wordCount, HipsterWord)
+func HipsterSentence(wordCount int) string { return hipsterSentence(globalFaker.ctx(), wordCount) }
+
+// HipsterSentence will generate a random sentence
+func (f *Faker) HipsterSentence(wordCount int) string { return hipsterSentence(f.ctx(), wordCount) }
+
+func hipsterSentence(r fakerCtx, wordCount int) string {
+	return sentenceGen(r, wordCount, hipsterWord)
 }
 
 // HipsterParagraph will generate a random paragraphGenerator
@@ -18,7 +28,20 @@ func HipsterSentence(wordCount int) string {
 // Set Word Count
 // Set Paragraph Separator
 func HipsterParagraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
-	return paragraphGenerator(paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, HipsterSentence)
+	return hipsterParagraph(globalFaker.ctx(), paragraphCount, sentenceCount, wordCount, separator)
+}
+
+// HipsterParagraph will generate a random paragraphGenerator
+// Set Paragraph Count
+// Set Sentence Count
+// Set Word Count
+// Set Paragraph Separator
+func (f *Faker) HipsterParagraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return hipsterParagraph(f.ctx(), paragraphCount, sentenceCount, wordCount, separator)
+}
+
+func hipsterParagraph(r fakerCtx, paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return paragraphGenerator(r, paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, hipsterSentence)
 }
 
 func addHipsterLookup() {
@@ -28,8 +51,8 @@ func addHipsterLookup() {
 		Description: "Random hipster word",
 		Example:     "microdosing",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return HipsterWord(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return hipsterWord(r), nil
 		},
 	})
 
@@ -42,7 +65,7 @@ func addHipsterLookup() {
 		Params: []Param{
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			wordCount, err := info.GetInt(m, "wordcount")
 			if err != nil {
 				return nil, err
@@ -51,7 +74,7 @@ func addHipsterLookup() {
 				return nil, errors.New("Invalid word count, must be greater than 0, less than 50")
 			}
 
-			return HipsterSentence(wordCount), nil
+			return hipsterSentence(r, wordCount), nil
 		},
 	})
 
@@ -67,7 +90,7 @@ func addHipsterLookup() {
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 			{Field: "paragraphseparator", Display: "Paragraph Separator", Type: "string", Default: "<br />", Description: "String value to add between paragraphs"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			paragraphCount, err := info.GetInt(m, "paragraphcount")
 			if err != nil {
 				return nil, err
@@ -97,7 +120,7 @@ func addHipsterLookup() {
 				return nil, err
 			}
 
-			return HipsterParagraph(paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
+			return hipsterParagraph(r, paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
 		},
 	})
 }
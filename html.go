@@ -0,0 +1,152 @@
+package gofakeit
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"strings"
+)
+
+// HTMLOptions defines values needed for html document generation
+type HTMLOptions struct {
+	Paragraphs  int `json:"paragraphs" xml:"paragraphs"`     // defaults to 3
+	ListItems   int `json:"list_items" xml:"list_items"`     // defaults to 5
+	ImageWidth  int `json:"image_width" xml:"image_width"`   // defaults to 640
+	ImageHeight int `json:"image_height" xml:"image_height"` // defaults to 480
+}
+
+// htmlTemplate is written so the output is also well formed xml
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8" />
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<img src="{{.Image}}" alt="{{.Alt}}" width="{{.Width}}" height="{{.Height}}" />
+{{range .Paragraphs}}<p>{{.}}</p>
+{{end}}<ul>
+{{range .ListItems}}<li>{{.}}</li>
+{{end}}</ul>
+</body>
+</html>
+`))
+
+// HTMLDocument generates a small html page with a title, paragraphs of lorem ipsum,
+// a list and an image pointing to ImageURL
+func HTMLDocument(ho *HTMLOptions) ([]byte, error) { return htmlDocument(globalFaker.ctx(), ho) }
+
+// HTMLDocument generates a small html page with a title, paragraphs of lorem ipsum,
+// a list and an image pointing to ImageURL
+func (f *Faker) HTMLDocument(ho *HTMLOptions) ([]byte, error) { return htmlDocument(f.ctx(), ho) }
+
+func htmlDocument(r fakerCtx, ho *HTMLOptions) ([]byte, error) {
+	if ho == nil {
+		ho = &HTMLOptions{}
+	}
+
+	if ho.Paragraphs < 0 || ho.ListItems < 0 || ho.ImageWidth < 0 || ho.ImageHeight < 0 {
+		return nil, errors.New("Paragraphs, list items and image sizes must be 0 or greater")
+	}
+
+	paragraphs := ho.Paragraphs
+	if paragraphs == 0 {
+		paragraphs = 3
+	}
+	listItems := ho.ListItems
+	if listItems == 0 {
+		listItems = 5
+	}
+	width := ho.ImageWidth
+	if width == 0 {
+		width = 640
+	}
+	height := ho.ImageHeight
+	if height == 0 {
+		height = 480
+	}
+
+	doc := struct {
+		Title      string
+		Image      string
+		Alt        string
+		Width      int
+		Height     int
+		Paragraphs []string
+		ListItems  []string
+	}{
+		Title:  strings.TrimSuffix(sentence(r, randIntRange(r, 3, 6)), "."),
+		Image:  ImageURL(width, height),
+		Alt:    noun(r),
+		Width:  width,
+		Height: height,
+	}
+
+	for i := 0; i < paragraphs; i++ {
+		doc.Paragraphs = append(doc.Paragraphs, loremIpsumParagraph(r, 1, randIntRange(r, 3, 6), randIntRange(r, 8, 14), ""))
+	}
+	for i := 0; i < listItems; i++ {
+		doc.ListItems = append(doc.ListItems, strings.TrimSuffix(loremIpsumSentence(r, randIntRange(r, 2, 5)), "."))
+	}
+
+	var b bytes.Buffer
+	if err := htmlTemplate.Execute(&b, doc); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func addFileHTMLLookup() {
+	AddFuncLookup("htmldocument", Info{
+		Display:     "HTML Document",
+		Category:    "file",
+		Description: "Generates a small html page with a title, paragraphs, a list and an image",
+		Example: `
+			<!DOCTYPE html>
+			<html lang="en">
+			<head>
+			<meta charset="utf-8" />
+			<title>Cat extend wall</title>
+			</head>
+			...
+		`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "paragraphs", Display: "Paragraphs", Type: "int", Default: "3", Description: "Number of paragraphs"},
+			{Field: "listitems", Display: "List Items", Type: "int", Default: "5", Description: "Number of list items"},
+			{Field: "imagewidth", Display: "Image Width", Type: "int", Default: "640", Description: "Image width in px"},
+			{Field: "imageheight", Display: "Image Height", Type: "int", Default: "480", Description: "Image height in px"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			ho := HTMLOptions{}
+
+			paragraphs, err := info.GetInt(m, "paragraphs")
+			if err != nil {
+				return nil, err
+			}
+			ho.Paragraphs = paragraphs
+
+			listItems, err := info.GetInt(m, "listitems")
+			if err != nil {
+				return nil, err
+			}
+			ho.ListItems = listItems
+
+			width, err := info.GetInt(m, "imagewidth")
+			if err != nil {
+				return nil, err
+			}
+			ho.ImageWidth = width
+
+			height, err := info.GetInt(m, "imageheight")
+			if err != nil {
+				return nil, err
+			}
+			ho.ImageHeight = height
+
+			return htmlDocument(r, &ho)
+		},
+	})
+}
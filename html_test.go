@@ -0,0 +1,131 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func ExampleHTMLDocument() {
+	Seed(11)
+
+	value, err := HTMLDocument(&HTMLOptions{Paragraphs: 2, ListItems: 3, ImageWidth: 300, ImageHeight: 200})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output:
+	// <!DOCTYPE html>
+	// <html lang="en">
+	// <head>
+	// <meta charset="utf-8" />
+	// <title>Cat extend wall</title>
+	// </head>
+	// <body>
+	// <h1>Cat extend wall</h1>
+	// <img src="https://picsum.photos/300/200" alt="river" width="300" height="200" />
+	// <p>Quisquam amet quas et ut non dolorem ipsam aut enim assumenda mollitia. Harum ut dicta similique veniam nulla voluptas at excepturi non ad maxime. At non eaque hic repellat praesentium voluptatem qui consequuntur dolor iusto autem.</p>
+	// <p>Fugit tempore exercitationem harum consequatur voluptatum modi minima aut eaque et et. Aut ea voluptatem dignissimos expedita odit tempore quod aut beatae ipsam iste. Minus voluptatibus dolorem maiores eius sed nihil vel enim odio voluptatem accusamus. Natus quibusdam temporibus tenetur cumque sint necessitatibus dolorem ex ducimus iusto ex.</p>
+	// <ul>
+	// <li>Neque dicta</li>
+	// <li>Officiis et ducimus</li>
+	// <li>Ut ut</li>
+	// </ul>
+	// </body>
+	// </html>
+}
+
+func TestHTMLDocument(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		value, err := HTMLDocument(&HTMLOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.HasPrefix(value, []byte("<!DOCTYPE html>")) {
+			t.Fatal("expected document to start with a doctype")
+		}
+
+		// The document is also well formed xml so a strict parse checks every tag is closed
+		counts, err := htmlTestElements(value)
+		if err != nil {
+			t.Fatalf("document is not well formed: %s\n%s", err, value)
+		}
+
+		expected := map[string]int{"html": 1, "head": 1, "title": 1, "body": 1, "h1": 1, "img": 1, "p": 3, "ul": 1, "li": 5}
+		for name, count := range expected {
+			if counts[name] != count {
+				t.Fatalf("expected %d %s elements, got %d", count, name, counts[name])
+			}
+		}
+	}
+}
+
+func TestHTMLDocumentImage(t *testing.T) {
+	value, err := HTMLDocument(&HTMLOptions{ImageWidth: 300, ImageHeight: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(value), `<img src="`+ImageURL(300, 200)+`"`) {
+		t.Errorf("expected image pointing to %s, got %s", ImageURL(300, 200), value)
+	}
+}
+
+func TestHTMLDocumentErrors(t *testing.T) {
+	if _, err := HTMLDocument(&HTMLOptions{Paragraphs: -1}); err == nil {
+		t.Error("expected error for negative paragraphs")
+	}
+
+	if _, err := HTMLDocument(nil); err != nil {
+		t.Errorf("expected nil options to use defaults, got %s", err)
+	}
+}
+
+func TestHTMLDocumentLookup(t *testing.T) {
+	info := GetFuncLookup("htmldocument")
+
+	m := map[string][]string{"paragraphs": {"4"}, "listitems": {"2"}}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err := htmlTestElements(value.([]byte))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["p"] != 4 || counts["li"] != 2 {
+		t.Errorf("expected 4 paragraphs and 2 list items, got %d and %d", counts["p"], counts["li"])
+	}
+}
+
+func BenchmarkHTMLDocument(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		HTMLDocument(&HTMLOptions{})
+	}
+}
+
+// htmlTestElements counts the elements of a document after checking it is well formed
+func htmlTestElements(b []byte) (map[string]int, error) {
+	counts := map[string]int{}
+	d := xml.NewDecoder(bytes.NewReader(b))
+	d.Strict = true
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return counts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
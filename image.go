@@ -16,7 +16,12 @@ func ImageURL(width int, height int) string {
 }
 
 // Image generates a random rgba image
-func Image(width int, height int) *image.RGBA {
+func Image(width int, height int) *image.RGBA { return imageFunc(globalFaker.ctx(), width, height) }
+
+// Image generates a random rgba image
+func (f *Faker) Image(width int, height int) *image.RGBA { return imageFunc(f.ctx(), width, height) }
+
+func imageFunc(r fakerCtx, width int, height int) *image.RGBA {
 	upLeft := image.Point{0, 0}
 	lowRight := image.Point{width, height}
 
@@ -25,7 +30,7 @@ func Image(width int, height int) *image.RGBA {
 	// Set color for each pixel
 	for x := 0; x < width; x++ {
 		for y := 0; y < height; y++ {
-			img.Set(x, y, color.RGBA{uint8(Number(0, 255)), uint8(Number(0, 255)), uint8(Number(0, 255)), 0xff})
+			img.Set(x, y, color.RGBA{uint8(number(r, 0, 255)), uint8(number(r, 0, 255)), uint8(number(r, 0, 255)), 0xff})
 		}
 	}
 
@@ -33,16 +38,26 @@ func Image(width int, height int) *image.RGBA {
 }
 
 // ImageJpeg generates a random rgba jpeg image
-func ImageJpeg(width int, height int) []byte {
+func ImageJpeg(width int, height int) []byte { return imageJpeg(globalFaker.ctx(), width, height) }
+
+// ImageJpeg generates a random rgba jpeg image
+func (f *Faker) ImageJpeg(width int, height int) []byte { return imageJpeg(f.ctx(), width, height) }
+
+func imageJpeg(r fakerCtx, width int, height int) []byte {
 	buf := new(bytes.Buffer)
-	jpeg.Encode(buf, Image(width, height), nil)
+	jpeg.Encode(buf, imageFunc(r, width, height), nil)
 	return buf.Bytes()
 }
 
 // ImagePng generates a random rgba png image
-func ImagePng(width int, height int) []byte {
+func ImagePng(width int, height int) []byte { return imagePng(globalFaker.ctx(), width, height) }
+
+// ImagePng generates a random rgba png image
+func (f *Faker) ImagePng(width int, height int) []byte { return imagePng(f.ctx(), width, height) }
+
+func imagePng(r fakerCtx, width int, height int) []byte {
 	buf := new(bytes.Buffer)
-	png.Encode(buf, Image(width, height))
+	png.Encode(buf, imageFunc(r, width, height))
 	return buf.Bytes()
 }
 
@@ -57,7 +72,7 @@ func addImageLookup() {
 			{Field: "width", Display: "Width", Type: "int", Default: "500", Description: "Image width in px"},
 			{Field: "height", Display: "Height", Type: "int", Default: "500", Description: "Image height in px"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			width, err := info.GetInt(m, "width")
 			if err != nil {
 				return nil, err
@@ -88,7 +103,7 @@ func addImageLookup() {
 			{Field: "width", Display: "Width", Type: "int", Default: "500", Description: "Image width in px"},
 			{Field: "height", Display: "Height", Type: "int", Default: "500", Description: "Image height in px"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			width, err := info.GetInt(m, "width")
 			if err != nil {
 				return nil, err
@@ -105,7 +120,7 @@ func addImageLookup() {
 				return nil, errors.New("Invalid image height, must be greater than 10, less than 1000")
 			}
 
-			return ImageJpeg(width, height), nil
+			return imageJpeg(r, width, height), nil
 		},
 	})
 
@@ -119,7 +134,7 @@ func addImageLookup() {
 			{Field: "width", Display: "Width", Type: "int", Default: "500", Description: "Image width in px"},
 			{Field: "height", Display: "Height", Type: "int", Default: "500", Description: "Image height in px"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			width, err := info.GetInt(m, "width")
 			if err != nil {
 				return nil, err
@@ -136,7 +151,7 @@ func addImageLookup() {
 				return nil, errors.New("Invalid image height, must be greater than 10, less than 1000")
 			}
 
-			return ImagePng(width, height), nil
+			return imagePng(r, width, height), nil
 		},
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"strconv"
 )
 
 // JSONOptions defines values needed for json generation
@@ -12,6 +13,7 @@ type JSONOptions struct {
 	RowCount int     `json:"row_count" xml:"row_count"`
 	Fields   []Field `json:"fields" xml:"fields"`
 	Indent   bool    `json:"indent" xml:"indent"`
+	Prefix   string  `json:"prefix" xml:"prefix"` // starts every indented line after the first
 }
 
 type jsonKeyVal struct {
@@ -54,7 +56,12 @@ func (okv jsonOrderedKeyVal) MarshalJSON() ([]byte, error) {
 }
 
 // JSON generates an object or an array of objects in json format
-func JSON(jo *JSONOptions) ([]byte, error) {
+func JSON(jo *JSONOptions) ([]byte, error) { return jsonFunc(globalFaker.ctx(), jo) }
+
+// JSON generates an object or an array of objects in json format
+func (f *Faker) JSON(jo *JSONOptions) ([]byte, error) { return jsonFunc(f.ctx(), jo) }
+
+func jsonFunc(r fakerCtx, jo *JSONOptions) ([]byte, error) {
 	// Check to make sure they passed in a type
 	if jo.Type != "array" && jo.Type != "object" {
 		return nil, errors.New("Invalid type, must be array or object")
@@ -64,90 +71,268 @@ func JSON(jo *JSONOptions) ([]byte, error) {
 		return nil, errors.New("Must pass fields in order to build json object(s)")
 	}
 
+	// Report every invalid field before generating any rows
+	if err := ValidateFields(jo.Fields); err != nil {
+		return nil, err
+	}
+
 	if jo.Type == "object" {
-		v := make(jsonOrderedKeyVal, len(jo.Fields))
+		// Object only has one row
+		v, err := jsonRow(r, jo.Fields, 1)
+		if err != nil {
+			return nil, err
+		}
 
-		// Loop through fields and add to them to map[string]interface{}
-		for i, field := range jo.Fields {
-			if field.Function == "autoincrement" {
-				// Object only has one
-				v[i] = &jsonKeyVal{Key: field.Name, Value: 1}
-				continue
-			}
+		return jsonMarshal(v, jo)
+	}
 
-			// Get function info
-			funcInfo := GetFuncLookup(field.Function)
-			if funcInfo == nil {
-				return nil, errors.New("Invalid function, " + field.Function + " does not exist")
-			}
+	if jo.Type == "array" {
+		// Make sure you set a row count
+		if jo.RowCount <= 0 {
+			return nil, errors.New("Must have row count")
+		}
 
-			// Call function value
-			value, err := funcInfo.Call(&field.Params, funcInfo)
+		v := make([]jsonOrderedKeyVal, jo.RowCount)
+
+		for i := 0; i < int(jo.RowCount); i++ {
+			vr, err := jsonRow(r, jo.Fields, i+1) // +1 because index starts with 0
 			if err != nil {
 				return nil, err
 			}
 
-			v[i] = &jsonKeyVal{Key: field.Name, Value: value}
+			v[i] = vr
+		}
 
+		return jsonMarshal(v, jo)
+	}
+
+	return nil, errors.New("Invalid type, must be array or object")
+}
+
+// jsonMarshal marshals v compact or with the indent and prefix of json.MarshalIndent
+func jsonMarshal(v interface{}, jo *JSONOptions) ([]byte, error) {
+	if jo.Indent {
+		return json.MarshalIndent(v, jo.Prefix, "    ")
+	}
+
+	return json.Marshal(v)
+}
+
+// JSONL generates rows of objects in json lines format, one compact object per line
+func JSONL(jo *JSONOptions) ([]byte, error) { return jsonl(globalFaker.ctx(), jo) }
+
+// JSONL generates rows of objects in json lines format, one compact object per line
+func (f *Faker) JSONL(jo *JSONOptions) ([]byte, error) { return jsonl(f.ctx(), jo) }
+
+func jsonl(r fakerCtx, jo *JSONOptions) ([]byte, error) {
+	if jo.Fields == nil || len(jo.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build json object(s)")
+	}
+
+	// Report every invalid field before generating any rows
+	if err := ValidateFields(jo.Fields); err != nil {
+		return nil, err
+	}
+
+	// Make sure you set a row count
+	if jo.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	var buf bytes.Buffer
+	for i := 0; i < int(jo.RowCount); i++ {
+		vr, err := jsonRow(r, jo.Fields, i+1) // +1 because index starts with 0
+		if err != nil {
+			return nil, err
 		}
 
-		// Marshal into bytes
-		j := []byte{}
-		if jo.Indent {
-			j, _ = json.MarshalIndent(v, "", "    ")
-		} else {
-			j, _ = json.Marshal(v)
+		j, err := json.Marshal(vr)
+		if err != nil {
+			return nil, err
 		}
-		return j, nil
+		buf.Write(j)
+		buf.WriteString("\n")
 	}
 
-	if jo.Type == "array" {
-		// Make sure you set a row count
-		if jo.RowCount <= 0 {
-			return nil, errors.New("Must have row count")
+	return buf.Bytes(), nil
+}
+
+// OpenAPIExample generates a single object for the example of an OpenAPI schema, with every value
+// in its native json type. Null probabilities are ignored so each field shows an example value
+func OpenAPIExample(fields []Field) ([]byte, error) { return openAPIExample(globalFaker.ctx(), fields) }
+
+// OpenAPIExample generates a single object for the example of an OpenAPI schema, with every value
+// in its native json type. Null probabilities are ignored so each field shows an example value
+func (f *Faker) OpenAPIExample(fields []Field) ([]byte, error) {
+	return openAPIExample(f.ctx(), fields)
+}
+
+func openAPIExample(r fakerCtx, fields []Field) ([]byte, error) {
+	if len(fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build an example object")
+	}
+
+	example := make([]Field, len(fields))
+	for i, field := range fields {
+		field.NullProbability = 0
+		example[i] = field
+	}
+
+	return jsonFunc(r, &JSONOptions{Type: "object", Fields: example})
+}
+
+// jsonRow generates a single object from fields, rowNum is used for autoincrement fields
+func jsonRow(r fakerCtx, fields []Field, rowNum int) (jsonOrderedKeyVal, error) {
+	v := make(jsonOrderedKeyVal, len(fields))
+
+	// Loop through fields and add to them to map[string]interface{}
+	refOrder, err := refFieldOrder(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, field := range fields {
+		// Ref fields are filled in after the rest of the row
+		if field.Function == "ref" {
+			v[i] = &jsonKeyVal{Key: field.Name}
+			continue
 		}
 
-		v := make([]jsonOrderedKeyVal, jo.RowCount)
+		if fieldNull(r, &field) {
+			v[i] = &jsonKeyVal{Key: field.Name, Value: nil}
+			continue
+		}
 
-		for i := 0; i < int(jo.RowCount); i++ {
-			vr := make(jsonOrderedKeyVal, len(jo.Fields))
+		if field.Function == "autoincrement" {
+			id, err := autoIncrement(&field, rowNum)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = &jsonKeyVal{Key: field.Name, Value: id}
+			continue
+		}
 
-			// Loop through fields and add to them to map[string]interface{}
-			for ii, field := range jo.Fields {
-				if field.Function == "autoincrement" {
-					vr[ii] = &jsonKeyVal{Key: field.Name, Value: i + 1} // +1 because index starts with 0
-					continue
-				}
+		if field.Function == "timeseries" {
+			ts, err := timeSeries(r, &field, rowNum)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = &jsonKeyVal{Key: field.Name, Value: ts}
+			continue
+		}
+
+		// Nested object made up of its own fields
+		if field.Function == "object" {
+			obj, err := jsonNested(r, &field, rowNum)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = &jsonKeyVal{Key: field.Name, Value: obj}
+			continue
+		}
 
-				// Get function info
-				funcInfo := GetFuncLookup(field.Function)
-				if funcInfo == nil {
-					return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+		// Array of nested objects
+		if field.Function == "array" {
+			count := 1
+			if c, ok := field.Params["count"]; ok && len(c) > 0 {
+				var err error
+				count, err = strconv.Atoi(c[0])
+				if err != nil || count < 0 {
+					return nil, errors.New("Invalid count for array field " + field.Name + ", must be a positive int")
 				}
+			}
 
-				// Call function value
-				value, err := funcInfo.Call(&field.Params, funcInfo)
+			arr := make([]jsonOrderedKeyVal, count)
+			for ii := 0; ii < count; ii++ {
+				obj, err := jsonNested(r, &field, ii+1)
 				if err != nil {
 					return nil, err
 				}
-
-				vr[ii] = &jsonKeyVal{Key: field.Name, Value: value}
+				arr[ii] = obj
 			}
+			v[i] = &jsonKeyVal{Key: field.Name, Value: arr}
+			continue
+		}
 
-			v[i] = vr
+		// Get function info
+		funcInfo := GetFuncLookup(field.Function)
+		if funcInfo == nil {
+			return nil, errors.New("Invalid function, " + field.Function + " does not exist")
 		}
 
-		// Marshal into bytes
-		j := []byte{}
-		if jo.Indent {
-			j, _ = json.MarshalIndent(v, "", "    ")
-		} else {
-			j, _ = json.Marshal(v)
+		// Call function value
+		value, err := fieldCall(r, &field, funcInfo)
+		if err != nil {
+			return nil, err
 		}
-		return j, nil
+
+		v[i] = &jsonKeyVal{Key: field.Name, Value: jsonValue(funcInfo, value)}
 	}
 
-	return nil, errors.New("Invalid type, must be array or object")
+	if len(refOrder) > 0 {
+		row := make(map[string]interface{}, len(fields))
+		for _, kv := range v {
+			row[kv.Key] = kv.Value
+		}
+		err := refEvaluate(r, fields, refOrder, row, func(i int, value interface{}) {
+			v[i].Value = value
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// jsonValue keeps values in their native json type. Functions, like custom lookups,
+// that hand back a string while their Output says number or bool are converted
+// so they are not written as quoted strings
+func jsonValue(info *Info, value interface{}) interface{} {
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	switch info.Output {
+	case "int", "int8", "int16", "int32", "int64":
+		if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return n
+		}
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		if n, err := strconv.ParseUint(str, 10, 64); err == nil {
+			return n
+		}
+	case "float", "float32", "float64":
+		if n, err := strconv.ParseFloat(str, 64); err == nil {
+			return n
+		}
+	case "bool":
+		if b, err := strconv.ParseBool(str); err == nil {
+			return b
+		}
+	}
+
+	return value
+}
+
+// jsonNested generates an object from the json encoded fields param of an object or array field
+func jsonNested(r fakerCtx, field *Field, rowNum int) (jsonOrderedKeyVal, error) {
+	fieldsStr, ok := field.Params["fields"]
+	if !ok || len(fieldsStr) == 0 {
+		return nil, errors.New("Must pass fields param for " + field.Function + " field " + field.Name)
+	}
+
+	fields := make([]Field, len(fieldsStr))
+	for i, f := range fieldsStr {
+		// Unmarshal fields string into fields array
+		err := json.Unmarshal([]byte(f), &fields[i])
+		if err != nil {
+			return nil, errors.New("Unable to decode json string")
+		}
+	}
+
+	return jsonRow(r, fields, rowNum)
 }
 
 func addFileJSONLookup() {
@@ -166,8 +351,9 @@ func addFileJSONLookup() {
 			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows in JSON array"},
 			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
 			{Field: "indent", Display: "Indent", Type: "bool", Default: "false", Description: "Whether or not to add indents and newlines"},
+			{Field: "prefix", Display: "Prefix", Type: "string", Optional: true, Description: "Optional prefix of every indented line after the first"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			jo := JSONOptions{}
 
 			typ, err := info.GetString(m, "type")
@@ -206,7 +392,90 @@ func addFileJSONLookup() {
 			}
 			jo.Indent = indent
 
-			return JSON(&jo)
+			if m != nil && len((*m)["prefix"]) > 0 {
+				prefix, err := info.GetString(m, "prefix")
+				if err != nil {
+					return nil, err
+				}
+				jo.Prefix = prefix
+			}
+
+			return jsonFunc(r, &jo)
+		},
+	})
+}
+
+func addFileJSONLLookup() {
+	AddFuncLookup("jsonl", Info{
+		Display:     "JSON Lines",
+		Category:    "file",
+		Description: "Generates rows of objects in newline delimited json format",
+		Example: `{"id":1,"first_name":"Markus","last_name":"Moen"}
+			{"id":2,"first_name":"Alayna","last_name":"Wuckert"}
+			{"id":3,"first_name":"Lura","last_name":"Lockman"}`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of lines of JSON objects"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			jo := JSONOptions{}
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			jo.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				jo.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &jo.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			return jsonl(r, &jo)
+		},
+	})
+}
+
+func addFileOpenAPIExampleLookup() {
+	AddFuncLookup("openapiexample", Info{
+		Display:     "OpenAPI Example",
+		Category:    "file",
+		Description: "Generates a single json object with native types for an OpenAPI example",
+		Example:     `{"id":1,"first_name":"Markus","active":true,"score":14}`,
+		Output:      "[]byte",
+		Params: []Param{
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			fields := make([]Field, len(fieldsStr))
+			for i, f := range fieldsStr {
+				// Unmarshal fields string into fields array
+				err = json.Unmarshal([]byte(f), &fields[i])
+				if err != nil {
+					return nil, errors.New("Unable to decode json string")
+				}
+			}
+
+			return openAPIExample(r, fields)
 		},
 	})
 }
@@ -1,7 +1,10 @@
 package gofakeit
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -95,7 +98,7 @@ func TestJSONLookup(t *testing.T) {
 			`{"name":"password","function":"password","params":{"special":["false"],"length":["20"]}}`,
 		},
 	}
-	_, err := info.Call(&m, info)
+	_, err := info.call(globalFaker.ctx(), &m)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -118,7 +121,7 @@ func BenchmarkJSONLookup100(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -140,7 +143,7 @@ func BenchmarkJSONLookup1000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -162,7 +165,7 @@ func BenchmarkJSONLookup10000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -184,9 +187,424 @@ func BenchmarkJSONLookup100000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
 	}
 }
+
+func ExampleJSONL() {
+	Seed(11)
+
+	value, err := JSONL(&JSONOptions{
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+		RowCount: 3,
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(string(value))
+
+	// Output:
+	// {"id":1,"first_name":"Markus","last_name":"Moen","password":"Dc0VYXjkWABx"}
+	// {"id":2,"first_name":"Osborne","last_name":"Hilll","password":"XPJ9OVNbs5lm"}
+	// {"id":3,"first_name":"Mertie","last_name":"Halvorson","password":"eyl3bhwfV8wA"}
+}
+
+func TestJSONIndentPrefix(t *testing.T) {
+	for _, typ := range []string{"object", "array"} {
+		jo := JSONOptions{
+			Type:     typ,
+			RowCount: 3,
+			Fields: []Field{
+				{Name: "first_name", Function: "firstname"},
+				{Name: "address", Function: "address"},
+			},
+		}
+
+		compact, err := JSON(&jo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(compact), "\n") {
+			t.Errorf("%s expected compact json to not contain newlines got %s", typ, compact)
+		}
+
+		jo.Indent = true
+		jo.Prefix = "// "
+		indented, err := JSON(&jo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(string(indented), "\n")
+		if len(lines) < 3 {
+			t.Fatalf("%s expected indented json to contain newlines got %s", typ, indented)
+		}
+		for _, line := range lines[1:] {
+			if !strings.HasPrefix(line, "// ") {
+				t.Fatalf("%s expected every line after the first to start with the prefix got %q", typ, line)
+			}
+		}
+		if !strings.HasPrefix(lines[1], "//     ") {
+			t.Errorf("%s expected the prefix to come before the indent got %q", typ, lines[1])
+		}
+	}
+
+	info := GetFuncLookup("json")
+	m := map[string][]string{
+		"type":   {"object"},
+		"fields": {`{"name":"first_name","function":"firstname"}`},
+		"indent": {"true"},
+		"prefix": {"\t"},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(value.([]byte)), "\n\t    \"first_name\"") {
+		t.Errorf("expected the lookup to pass the prefix got %s", value)
+	}
+
+	// The prefix is optional
+	delete(m, "prefix")
+	value, err = info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(value.([]byte)), "\n    \"first_name\"") {
+		t.Errorf("expected no prefix without the param got %s", value)
+	}
+}
+
+func TestJSONL(t *testing.T) {
+	value, err := JSONL(&JSONOptions{
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "address", Function: "address"},
+			{Name: "paragraph", Function: "paragraph"},
+		},
+		RowCount: 25,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+	if len(lines) != 25 {
+		t.Fatalf("expected 25 lines got %d", len(lines))
+	}
+
+	for i, line := range lines {
+		var row map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &row); err != nil {
+			t.Fatalf("line %d could not be parsed: %s", i, err)
+		}
+		if row["id"] != float64(i+1) {
+			t.Errorf("expected id %d got %v", i+1, row["id"])
+		}
+	}
+}
+
+func TestJSONLValidateFields(t *testing.T) {
+	value, err := JSONL(&JSONOptions{
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "missing", Function: "doesnotexist"},
+			{Name: "nested", Function: "object"},
+		},
+		RowCount: 5,
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid fields")
+	}
+	if value != nil {
+		t.Errorf("expected no output for invalid fields got %s", value)
+	}
+
+	fe, ok := err.(FieldsError)
+	if !ok {
+		t.Fatalf("expected FieldsError got %T", err)
+	}
+	if len(fe) != 2 || fe[0].Name != "missing" || fe[1].Name != "nested" {
+		t.Errorf("expected missing and nested to be invalid got %s", err)
+	}
+}
+
+func TestJSONLLookup(t *testing.T) {
+	info := GetFuncLookup("jsonl")
+
+	m := map[string][]string{
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+		},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if count := strings.Count(string(value.([]byte)), "\n"); count != 10 {
+		t.Errorf("expected 10 lines got %d", count)
+	}
+}
+
+func TestJSONNested(t *testing.T) {
+	value, err := JSON(&JSONOptions{
+		Type: "object",
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "address", Function: "object", Params: map[string][]string{
+				"fields": {
+					`{"name":"street","function":"street"}`,
+					`{"name":"city","function":"city"}`,
+					`{"name":"zip","function":"zip"}`,
+					`{"name":"geo","function":"object","params":{"fields":["{\"name\":\"lat\",\"function\":\"latitude\"}"]}}`,
+				},
+			}},
+			{Name: "orders", Function: "array", Params: map[string][]string{
+				"count": {"3"},
+				"fields": {
+					`{"name":"order_id","function":"autoincrement"}`,
+					`{"name":"price","function":"price"}`,
+				},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var result struct {
+		ID      int `json:"id"`
+		Address struct {
+			Street string `json:"street"`
+			City   string `json:"city"`
+			Zip    string `json:"zip"`
+			Geo    struct {
+				Lat float64 `json:"lat"`
+			} `json:"geo"`
+		} `json:"address"`
+		Orders []struct {
+			OrderID int     `json:"order_id"`
+			Price   float64 `json:"price"`
+		} `json:"orders"`
+	}
+	if err := json.Unmarshal(value, &result); err != nil {
+		t.Fatalf("unable to unmarshal nested json: %s\n%s", err, value)
+	}
+
+	if result.Address.Street == "" || result.Address.City == "" || result.Address.Zip == "" {
+		t.Errorf("expected nested address values got %s", value)
+	}
+	if result.Address.Geo.Lat == 0 {
+		t.Errorf("expected deeply nested latitude got %s", value)
+	}
+	if len(result.Orders) != 3 {
+		t.Fatalf("expected 3 orders got %d", len(result.Orders))
+	}
+	for i, order := range result.Orders {
+		if order.OrderID != i+1 {
+			t.Errorf("expected order id %d got %d", i+1, order.OrderID)
+		}
+	}
+
+	// Nested fields are required
+	_, err = JSON(&JSONOptions{
+		Type:   "object",
+		Fields: []Field{{Name: "address", Function: "object"}},
+	})
+	if err == nil {
+		t.Error("expected error for object without fields")
+	}
+}
+
+func TestJSONNullProbability(t *testing.T) {
+	for _, prob := range []float32{1, 0} {
+		value, err := JSON(&JSONOptions{
+			Type:     "array",
+			RowCount: 100,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname", NullProbability: prob},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var rows []map[string]interface{}
+		err = json.Unmarshal(value, &rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, row := range rows {
+			name, ok := row["first_name"]
+			if !ok {
+				t.Fatal("expected first_name key to always be present")
+			}
+			if prob == 1 && name != nil {
+				t.Fatalf("expected null with probability 1, got %v", name)
+			}
+			if prob == 0 && name == nil {
+				t.Fatal("expected value with probability 0")
+			}
+		}
+	}
+}
+
+func TestJSONNullProbabilityLookup(t *testing.T) {
+	info := GetFuncLookup("json")
+	m := map[string][]string{
+		"type":     {"array"},
+		"rowcount": {"10"},
+		"fields":   {`{"name":"first_name","function":"firstname","null_probability":1}`},
+	}
+
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Count(string(value.([]byte)), `"first_name":null`) != 10 {
+		t.Errorf("expected every first_name to be null, got %s", value)
+	}
+}
+
+func TestJSONNativeTypes(t *testing.T) {
+	// Custom function handing back its number as a string
+	AddFuncLookup("stringcount", Info{
+		Category: "custom",
+		Output:   "int",
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			return "42", nil
+		},
+	})
+	defer RemoveFuncLookup("stringcount")
+
+	value, err := JSON(&JSONOptions{
+		Type: "object",
+		Fields: []Field{
+			{Name: "age", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"100"}}},
+			{Name: "price", Function: "price"},
+			{Name: "active", Function: "bool"},
+			{Name: "count", Function: "stringcount"},
+			{Name: "card", Function: "creditcardnumber"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(value, &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"age", "price", "count"} {
+		if _, ok := obj[key].(float64); !ok {
+			t.Errorf("expected %s to be a json number, got %T in %s", key, obj[key], value)
+		}
+	}
+	if _, ok := obj["active"].(bool); !ok {
+		t.Errorf("expected active to be a json boolean, got %T in %s", obj["active"], value)
+	}
+	if _, ok := obj["card"].(string); !ok {
+		t.Errorf("expected card to stay a string, got %T in %s", obj["card"], value)
+	}
+}
+
+func TestJSONTimeSeries(t *testing.T) {
+	value, err := JSON(&JSONOptions{
+		Type:     "array",
+		RowCount: 5,
+		Fields: []Field{
+			{Name: "at", Function: "timeseries", Params: map[string][]string{"start": {"2021-03-04"}, "interval": {"1h"}, "format": {"2006-01-02 15:04"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[{"at":"2021-03-04 00:00"},{"at":"2021-03-04 01:00"},{"at":"2021-03-04 02:00"},{"at":"2021-03-04 03:00"},{"at":"2021-03-04 04:00"}]`
+	if string(value) != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+}
+
+func ExampleOpenAPIExample() {
+	Seed(11)
+
+	value, err := OpenAPIExample([]Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "first_name", Function: "firstname"},
+		{Name: "active", Function: "bool"},
+		{Name: "score", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"100"}}},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+	// Output: {"id":1,"first_name":"Markus","active":true,"score":14}
+}
+
+func TestOpenAPIExample(t *testing.T) {
+	value, err := OpenAPIExample([]Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "name", Function: "firstname", NullProbability: 1},
+		{Name: "active", Function: "bool"},
+		{Name: "price", Function: "price", Params: map[string][]string{"min": {"1"}, "max": {"100"}}},
+		{Name: "tags", Function: "array", Params: map[string][]string{
+			"count":  {"2"},
+			"fields": {`{"name":"tag","function":"word"}`},
+		}},
+		{Name: "address", Function: "object", Params: map[string][]string{
+			"fields": {`{"name":"city","function":"city"}`},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var example interface{}
+	if err := json.Unmarshal(value, &example); err != nil {
+		t.Fatal(err)
+	}
+	object, ok := example.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a single json object got %s", value)
+	}
+
+	if id, ok := object["id"].(float64); !ok || id != 1 {
+		t.Errorf("expected id to be the number 1 got %#v", object["id"])
+	}
+	if _, ok := object["name"].(string); !ok {
+		t.Errorf("expected name to be a string and not null got %#v", object["name"])
+	}
+	if _, ok := object["active"].(bool); !ok {
+		t.Errorf("expected active to be a bool got %#v", object["active"])
+	}
+	if _, ok := object["price"].(float64); !ok {
+		t.Errorf("expected price to be a number got %#v", object["price"])
+	}
+	if _, ok := object["tags"].([]interface{}); !ok {
+		t.Errorf("expected tags to be an array got %#v", object["tags"])
+	}
+	if _, ok := object["address"].(map[string]interface{}); !ok {
+		t.Errorf("expected address to be an object got %#v", object["address"])
+	}
+
+	if _, err := OpenAPIExample(nil); err == nil {
+		t.Error("expected an error without fields")
+	}
+}
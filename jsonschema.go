@@ -0,0 +1,235 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+)
+
+// jsonSchema is the subset of json schema used to generate values
+type jsonSchema struct {
+	Type       string               `json:"type"`
+	Properties jsonSchemaProperties `json:"properties"`
+	Items      *jsonSchema          `json:"items"`
+	Enum       []interface{}        `json:"enum"`
+	Minimum    *float64             `json:"minimum"`
+	Maximum    *float64             `json:"maximum"`
+	MinItems   *int                 `json:"minItems"`
+	MaxItems   *int                 `json:"maxItems"`
+	Format     string               `json:"format"`
+}
+
+type jsonSchemaProperty struct {
+	Name   string
+	Schema *jsonSchema
+}
+
+// jsonSchemaProperties keeps properties in the order they are defined in the schema
+type jsonSchemaProperties []jsonSchemaProperty
+
+func (p *jsonSchemaProperties) UnmarshalJSON(data []byte) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	if t, err := d.Token(); err != nil || t != json.Delim('{') {
+		return errors.New("Properties must be an object")
+	}
+
+	*p = jsonSchemaProperties{}
+
+	for d.More() {
+		key, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		schema := &jsonSchema{}
+		if err := d.Decode(schema); err != nil {
+			return err
+		}
+		*p = append(*p, jsonSchemaProperty{Name: key.(string), Schema: schema})
+	}
+
+	return nil
+}
+
+// FromJSONSchema generates a json value conforming to a json schema.
+// Supported keywords are type, properties, items, enum, minimum, maximum, minItems, maxItems and format
+func FromJSONSchema(schema []byte) ([]byte, error) { return fromJSONSchema(globalFaker.ctx(), schema) }
+
+// FromJSONSchema generates a json value conforming to a json schema.
+// Supported keywords are type, properties, items, enum, minimum, maximum, minItems, maxItems and format
+func (f *Faker) FromJSONSchema(schema []byte) ([]byte, error) { return fromJSONSchema(f.ctx(), schema) }
+
+func fromJSONSchema(r fakerCtx, schema []byte) ([]byte, error) {
+	s := &jsonSchema{}
+	if err := json.Unmarshal(schema, s); err != nil {
+		return nil, errors.New("Unable to decode json schema: " + err.Error())
+	}
+
+	v, err := jsonSchemaValue(r, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+func jsonSchemaValue(r fakerCtx, s *jsonSchema) (interface{}, error) {
+	if len(s.Enum) > 0 {
+		return s.Enum[r.Intn(len(s.Enum))], nil
+	}
+
+	switch s.Type {
+	case "object":
+		obj := jsonOrderedKeyVal{}
+		for _, p := range s.Properties {
+			v, err := jsonSchemaValue(r, p.Schema)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, &jsonKeyVal{Key: p.Name, Value: v})
+		}
+		return obj, nil
+
+	case "array":
+		if s.Items == nil {
+			return nil, errors.New("Array schema must have items")
+		}
+
+		min, max := 1, 5
+		if s.MinItems != nil {
+			min = *s.MinItems
+			if s.MaxItems == nil && max < min {
+				max = min + 4
+			}
+		}
+		if s.MaxItems != nil {
+			max = *s.MaxItems
+			if s.MinItems == nil && min > max {
+				min = max
+			}
+		}
+		if min < 0 || min > max {
+			return nil, errors.New("Invalid minItems and maxItems")
+		}
+
+		arr := make([]interface{}, randIntRange(r, min, max))
+		for i := range arr {
+			v, err := jsonSchemaValue(r, s.Items)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = v
+		}
+		return arr, nil
+
+	case "integer":
+		min, max, err := jsonSchemaBounds(s)
+		if err != nil {
+			return nil, err
+		}
+		min, max = math.Ceil(min), math.Floor(max)
+		if min > max {
+			return nil, errors.New("No integer between minimum and maximum")
+		}
+		return randIntRange(r, int(min), int(max)), nil
+
+	case "number":
+		min, max, err := jsonSchemaBounds(s)
+		if err != nil {
+			return nil, err
+		}
+		return randFloat64Range(r, min, max), nil
+
+	case "string":
+		return jsonSchemaString(r, s.Format), nil
+
+	case "boolean":
+		return boolFunc(r), nil
+
+	case "null":
+		return nil, nil
+
+	case "":
+		// Infer the type from the keywords that are set
+		if s.Properties != nil {
+			return jsonSchemaValue(r, &jsonSchema{Type: "object", Properties: s.Properties})
+		}
+		if s.Items != nil {
+			return jsonSchemaValue(r, &jsonSchema{Type: "array", Items: s.Items, MinItems: s.MinItems, MaxItems: s.MaxItems})
+		}
+		return nil, errors.New("Schema must have a type")
+	}
+
+	return nil, errors.New("Unsupported type " + s.Type)
+}
+
+// jsonSchemaBounds returns the minimum and maximum of a numeric schema,
+// a missing side is set 1000 away from the other one
+func jsonSchemaBounds(s *jsonSchema) (float64, float64, error) {
+	min, max := 0.0, 1000.0
+	if s.Minimum != nil {
+		min = *s.Minimum
+		if s.Maximum == nil {
+			max = min + 1000
+		}
+	}
+	if s.Maximum != nil {
+		max = *s.Maximum
+		if s.Minimum == nil {
+			min = max - 1000
+		}
+	}
+	if min > max {
+		return 0, 0, errors.New("Minimum must be less than or equal to maximum")
+	}
+	return min, max, nil
+}
+
+// jsonSchemaString generates a string for a format, unknown formats
+// are only annotations in json schema so they get a plain word
+func jsonSchemaString(r fakerCtx, format string) string {
+	switch format {
+	case "email":
+		return email(r)
+	case "date-time":
+		return date(r).Format(time.RFC3339)
+	case "date":
+		return date(r).Format("2006-01-02")
+	case "time":
+		return date(r).Format("15:04:05Z07:00")
+	case "uuid":
+		return uuid(r)
+	case "uri":
+		return url(r)
+	case "hostname":
+		return domainName(r)
+	case "ipv4":
+		return ipv4Address(r)
+	case "ipv6":
+		return ipv6Address(r)
+	}
+	return word(r)
+}
+
+func addFileJSONSchemaLookup() {
+	AddFuncLookup("jsonschema", Info{
+		Display:     "JSON Schema",
+		Category:    "file",
+		Description: "Generates a json value conforming to a json schema",
+		Example:     `{"id":"8b8e4c1e-fd0e-4b26-9bd9-d2b1f3d1e8e7","email":"markusmoen@pagac.net","age":37}`,
+		Output:      "[]byte",
+		Params: []Param{
+			{Field: "schema", Display: "Schema", Type: "string", Default: `{"type":"object","properties":{"id":{"type":"string","format":"uuid"},"email":{"type":"string","format":"email"},"age":{"type":"integer","minimum":18,"maximum":99}}}`, Description: "JSON schema to generate a value for"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			schema, err := info.GetString(m, "schema")
+			if err != nil {
+				return nil, err
+			}
+
+			return fromJSONSchema(r, []byte(schema))
+		},
+	})
+}
@@ -0,0 +1,222 @@
+package gofakeit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleFromJSONSchema() {
+	Seed(11)
+
+	value, err := FromJSONSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"email": {"type": "string", "format": "email"},
+			"age": {"type": "integer", "minimum": 18, "maximum": 99},
+			"status": {"enum": ["active", "disabled"]}
+		}
+	}`))
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output: {"id":"590c1440-9888-45b0-bd51-a817ee07c3f2","email":"anibalkozey@lockman.name","age":63,"status":"active"}
+}
+
+var jsonSchemaTestSchema = `{
+	"type": "object",
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"created": {"type": "string", "format": "date-time"},
+		"score": {"type": "number", "minimum": -1.5, "maximum": 1.5},
+		"level": {"enum": [1, 2, 3]},
+		"active": {"type": "boolean"},
+		"owner": {
+			"type": "object",
+			"properties": {
+				"email": {"type": "string", "format": "email"},
+				"age": {"type": "integer", "minimum": 18, "maximum": 21},
+				"role": {"type": "string", "enum": ["admin", "editor", "viewer"]}
+			}
+		},
+		"tags": {"type": "array", "minItems": 2, "maxItems": 4, "items": {"type": "string"}},
+		"points": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"x": {"type": "integer", "maximum": 0},
+					"y": {"type": "integer", "minimum": 10}
+				}
+			}
+		},
+		"deleted": {"type": "null"}
+	}
+}`
+
+func TestFromJSONSchema(t *testing.T) {
+	schema := &jsonSchema{}
+	if err := json.Unmarshal([]byte(jsonSchemaTestSchema), schema); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		value, err := FromJSONSchema([]byte(jsonSchemaTestSchema))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var v interface{}
+		if err := json.Unmarshal(value, &v); err != nil {
+			t.Fatal(err)
+		}
+		if err := jsonSchemaTestValidate(schema, v); err != nil {
+			t.Fatalf("%s: %s", err, value)
+		}
+	}
+}
+
+func TestFromJSONSchemaPropertyOrder(t *testing.T) {
+	value, err := FromJSONSchema([]byte(`{"properties": {"z": {"type": "null"}, "a": {"type": "null"}, "m": {"type": "null"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != `{"z":null,"a":null,"m":null}` {
+		t.Errorf("expected properties in schema order, got %s", value)
+	}
+}
+
+func TestFromJSONSchemaErrors(t *testing.T) {
+	for _, schema := range []string{
+		`not json`,
+		`{}`,
+		`{"type": "tuple"}`,
+		`{"type": "array"}`,
+		`{"type": "array", "items": {"type": "string"}, "minItems": 5, "maxItems": 2}`,
+		`{"type": "integer", "minimum": 10, "maximum": 1}`,
+		`{"type": "integer", "minimum": 1.2, "maximum": 1.8}`,
+		`{"type": "object", "properties": {"a": {"type": "unknown"}}}`,
+	} {
+		if _, err := FromJSONSchema([]byte(schema)); err == nil {
+			t.Errorf("expected error for schema %s", schema)
+		}
+	}
+}
+
+func TestFromJSONSchemaLookup(t *testing.T) {
+	info := GetFuncLookup("jsonschema")
+
+	m := map[string][]string{"schema": {`{"type": "array", "minItems": 3, "maxItems": 3, "items": {"type": "string", "format": "email"}}`}}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var emails []string
+	if err := json.Unmarshal(value.([]byte), &emails); err != nil {
+		t.Fatal(err)
+	}
+	if len(emails) != 3 {
+		t.Errorf("expected 3 emails, got %d", len(emails))
+	}
+}
+
+func BenchmarkFromJSONSchema(b *testing.B) {
+	schema := []byte(jsonSchemaTestSchema)
+	for i := 0; i < b.N; i++ {
+		FromJSONSchema(schema)
+	}
+}
+
+// jsonSchemaTestValidate checks a decoded json value against the supported schema keywords
+func jsonSchemaTestValidate(s *jsonSchema, v interface{}) error {
+	if len(s.Enum) > 0 {
+		for _, e := range s.Enum {
+			if reflect.DeepEqual(e, v) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%v is not in enum %v", v, s.Enum)
+	}
+
+	switch s.Type {
+	case "object", "":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v is not an object", v)
+		}
+		if len(obj) != len(s.Properties) {
+			return fmt.Errorf("expected %d properties, got %d", len(s.Properties), len(obj))
+		}
+		for _, p := range s.Properties {
+			pv, ok := obj[p.Name]
+			if !ok {
+				return errors.New("missing property " + p.Name)
+			}
+			if err := jsonSchemaTestValidate(p.Schema, pv); err != nil {
+				return fmt.Errorf("%s: %s", p.Name, err)
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%v is not an array", v)
+		}
+		if s.MinItems != nil && len(arr) < *s.MinItems || s.MaxItems != nil && len(arr) > *s.MaxItems {
+			return fmt.Errorf("array length %d is out of bounds", len(arr))
+		}
+		for _, item := range arr {
+			if err := jsonSchemaTestValidate(s.Items, item); err != nil {
+				return err
+			}
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("%v is not a number", v)
+		}
+		if s.Type == "integer" && n != math.Trunc(n) {
+			return fmt.Errorf("%v is not an integer", v)
+		}
+		if s.Minimum != nil && n < *s.Minimum || s.Maximum != nil && n > *s.Maximum {
+			return fmt.Errorf("%v is out of bounds", v)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%v is not a string", v)
+		}
+		switch s.Format {
+		case "email":
+			if !strings.Contains(str, "@") {
+				return errors.New(str + " is not an email")
+			}
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, str); err != nil {
+				return err
+			}
+		case "uuid":
+			if _, err := uuidParse(str); err != nil {
+				return err
+			}
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%v is not a boolean", v)
+		}
+	case "null":
+		if v != nil {
+			return fmt.Errorf("%v is not null", v)
+		}
+	}
+	return nil
+}
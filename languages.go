@@ -1,18 +1,33 @@
 package gofakeit
 
 // Language will return a random language
-func Language() string {
-	return getRandValue([]string{"language", "long"})
+func Language() string { return language(globalFaker.ctx()) }
+
+// Language will return a random language
+func (f *Faker) Language() string { return language(f.ctx()) }
+
+func language(r fakerCtx) string {
+	return getRandValue(r, []string{"language", "long"})
 }
 
 // LanguageAbbreviation will return a random language abbreviation
-func LanguageAbbreviation() string {
-	return getRandValue([]string{"language", "short"})
+func LanguageAbbreviation() string { return languageAbbreviation(globalFaker.ctx()) }
+
+// LanguageAbbreviation will return a random language abbreviation
+func (f *Faker) LanguageAbbreviation() string { return languageAbbreviation(f.ctx()) }
+
+func languageAbbreviation(r fakerCtx) string {
+	return getRandValue(r, []string{"language", "short"})
 }
 
 // ProgrammingLanguage will return a random programming language
-func ProgrammingLanguage() string {
-	return getRandValue([]string{"language", "programming"})
+func ProgrammingLanguage() string { return programmingLanguage(globalFaker.ctx()) }
+
+// ProgrammingLanguage will return a random programming language
+func (f *Faker) ProgrammingLanguage() string { return programmingLanguage(f.ctx()) }
+
+func programmingLanguage(r fakerCtx) string {
+	return getRandValue(r, []string{"language", "programming"})
 }
 
 // ProgrammingLanguageBest will return a random programming language
@@ -27,8 +42,8 @@ func addLanguagesLookup() {
 		Description: "Random language",
 		Example:     "Kazakh",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Language(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return language(r), nil
 		},
 	})
 
@@ -38,8 +53,8 @@ func addLanguagesLookup() {
 		Description: "Random abbreviated language",
 		Example:     "kk",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return LanguageAbbreviation(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return languageAbbreviation(r), nil
 		},
 	})
 
@@ -49,8 +64,8 @@ func addLanguagesLookup() {
 		Description: "Random programming language",
 		Example:     "Go",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return ProgrammingLanguage(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return programmingLanguage(r), nil
 		},
 	})
 }
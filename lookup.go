@@ -1,9 +1,16 @@
 package gofakeit
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
 // FuncLookups is the primary map array with mapping to all available data
@@ -12,14 +19,18 @@ var lockFuncLookups sync.Mutex
 
 // Info structures fields to better break down what each one generates
 type Info struct {
-	Display     string                                                        `json:"display"`
-	Category    string                                                        `json:"category"`
-	Description string                                                        `json:"description"`
-	Example     string                                                        `json:"example"`
-	Output      string                                                        `json:"output"`
-	Data        map[string]string                                             `json:"-"`
-	Params      []Param                                                       `json:"params"`
-	Call        func(m *map[string][]string, info *Info) (interface{}, error) `json:"-"`
+	Display     string                                                                      `json:"display"`
+	Category    string                                                                      `json:"category"`
+	Description string                                                                      `json:"description"`
+	Example     string                                                                      `json:"example"`
+	Output      string                                                                      `json:"output"`
+	Data        map[string]string                                                           `json:"-"`
+	Params      []Param                                                                     `json:"params"`
+	Call        func(m *map[string][]string, info *Info) (interface{}, error)               `json:"-"` // uses the package level random source
+	CallR       func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) `json:"-"` // used over Call when set, r is the fakers random source
+
+	// generate is set by the built in lookups in place of CallR so they also get the fakers data overrides
+	generate func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error)
 }
 
 // Param is a breakdown of param requirements and type definition
@@ -30,13 +41,279 @@ type Param struct {
 	Default     string   `json:"default"`
 	Options     []string `json:"options"`
 	Description string   `json:"description"`
+	Optional    bool     `json:"optional"` // can be left out even though it has no default
 }
 
-// Field is used for defining what name and function you to generate for file outuputs
+// Field is used for defining what name and function you to generate for file outuputs.
+// Besides lookup functions, Function can be autoincrement, timeseries for timestamps that
+// increase each row, or ref, which fills its template param with other fields from the same row,
+// see ref.go for evaluation order.
+// Params are passed as is to the functions CallR or Call, including functions added with AddFuncLookup
 type Field struct {
 	Name     string              `json:"name"`
 	Function string              `json:"function"`
 	Params   map[string][]string `json:"params"`
+
+	// NullProbability is the chance, between 0 and 1, of a value being left empty in csv,
+	// null in json, NULL in sql or blank in fixed width
+	NullProbability float32 `json:"null_probability"`
+
+	// ForceQuote always wraps the value in double quotes in csv, other values are only
+	// quoted when they need to be
+	ForceQuote bool `json:"force_quote"`
+
+	// MaxLen truncates string values to at most this many characters, or bytes with MaxLenBytes,
+	// without splitting a multibyte character. 0 leaves them as is
+	MaxLen      int  `json:"max_len"`
+	MaxLenBytes bool `json:"max_len_bytes"`
+
+	// Transform changes a generated value before MaxLen is applied, it is one of upper, lower,
+	// title or trim for string values or sha256 for the hex sha256 hash of any value
+	Transform string `json:"transform"`
+}
+
+// fieldTransforms are the transforms a field can apply to its values
+var fieldTransforms = map[string]func(value interface{}) interface{}{
+	"upper":  fieldStringTransform(strings.ToUpper),
+	"lower":  fieldStringTransform(strings.ToLower),
+	"title":  fieldStringTransform(strings.Title),
+	"trim":   fieldStringTransform(strings.TrimSpace),
+	"sha256": fieldSHA256,
+}
+
+// fieldSHA256 hashes the value as it would be written out
+func fieldSHA256(value interface{}) interface{} {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%v", value))))
+}
+
+// fieldStringTransform applies fn to string values and leaves other types as is
+func fieldStringTransform(fn func(string) string) func(value interface{}) interface{} {
+	return func(value interface{}) interface{} {
+		if s, ok := value.(string); ok {
+			return fn(s)
+		}
+		return value
+	}
+}
+
+// UnmarshalJSON decodes a field, params can be given as json strings, numbers, booleans,
+// objects or arrays of them, {"min": 1, "max": 10} as well as {"min": ["1"], "max": ["10"]}
+func (f *Field) UnmarshalJSON(data []byte) error {
+	type fieldAlias Field
+	aux := struct {
+		*fieldAlias
+		Params map[string]json.RawMessage `json:"params"`
+	}{fieldAlias: (*fieldAlias)(f)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	f.Params = nil
+	if aux.Params != nil {
+		f.Params = make(map[string][]string, len(aux.Params))
+		for key, raw := range aux.Params {
+			values, err := fieldParamValues(raw)
+			if err != nil {
+				return fmt.Errorf("%s field %s param could not be decoded", f.Name, key)
+			}
+			f.Params[key] = values
+		}
+	}
+
+	return nil
+}
+
+// fieldParamValues converts a json param value into the string values a lookup receives.
+// Strings are used as is, anything else keeps its json form
+func fieldParamValues(raw json.RawMessage) ([]string, error) {
+	raw = bytes.TrimSpace(raw)
+	if bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+
+	if len(raw) == 0 || raw[0] != '[' {
+		value, err := fieldParamValue(raw)
+		if err != nil {
+			return nil, err
+		}
+		return []string{value}, nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(items))
+	for i, item := range items {
+		value, err := fieldParamValue(item)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func fieldParamValue(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '"' {
+		var value string
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, raw); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// CallLookup runs the lookup with params m. Lookups that set CallR use the random source of
+// the faker, lookups that only set Call use the package level random source
+func CallLookup(info *Info, m *map[string][]string) (interface{}, error) {
+	return info.call(globalFaker.ctx(), m)
+}
+
+// CallLookup runs the lookup with params m. Lookups that set CallR use the random source of
+// the faker, lookups that only set Call use the package level random source
+func (f *Faker) CallLookup(info *Info, m *map[string][]string) (interface{}, error) {
+	return info.call(f.ctx(), m)
+}
+
+// call runs generate or CallR with r when one is set, falling back to Call
+func (i *Info) call(r fakerCtx, m *map[string][]string) (interface{}, error) {
+	if i.generate != nil {
+		return i.generate(r, m, i)
+	}
+	if i.CallR != nil {
+		return i.CallR(r.Rand, m, i)
+	}
+	if i.Call != nil {
+		return i.Call(m, i)
+	}
+
+	return nil, errors.New("Lookup " + i.Display + " has no Call or CallR function")
+}
+
+// fieldNull decides, using the fakers random source, if a fields value should be null for a row
+func fieldNull(r fakerCtx, field *Field) bool {
+	// Skip the random draw when nulls are off so seeded output stays the same
+	if field.NullProbability <= 0 {
+		return false
+	}
+
+	return r.Float32() < field.NullProbability
+}
+
+// fieldCall runs the function of the field with its params, transforming the value
+// and clamping string values to MaxLen
+func fieldCall(r fakerCtx, field *Field, info *Info) (interface{}, error) {
+	value, err := info.call(r, &field.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldFormat(field, value)
+}
+
+// fieldFormat applies the fields transform to value and clamps string values to MaxLen
+func fieldFormat(field *Field, value interface{}) (interface{}, error) {
+	if field.Transform != "" {
+		transform, ok := fieldTransforms[field.Transform]
+		if !ok {
+			return nil, errors.New("Invalid transform " + field.Transform + ", must be upper, lower, title, trim or sha256")
+		}
+		value = transform(value)
+	}
+
+	if s, ok := value.(string); ok && field.MaxLen > 0 {
+		if field.MaxLenBytes {
+			value = clampStringBytes(s, field.MaxLen)
+		} else {
+			value = clampString(s, field.MaxLen)
+		}
+	}
+
+	return value, nil
+}
+
+// autoIncrement returns the value of an autoincrement field for the given row number.
+// Values begin at the fields start param and increase by its step param, both default to 1
+func autoIncrement(field *Field, rowNum int) (int, error) {
+	start, step := 1, 1
+
+	if v, ok := field.Params["start"]; ok && len(v) > 0 {
+		i, err := strconv.Atoi(v[0])
+		if err != nil {
+			return 0, fmt.Errorf("%s field start param could not parse to int value", field.Name)
+		}
+		start = i
+	}
+
+	if v, ok := field.Params["step"]; ok && len(v) > 0 {
+		i, err := strconv.Atoi(v[0])
+		if err != nil {
+			return 0, fmt.Errorf("%s field step param could not parse to int value", field.Name)
+		}
+		step = i
+	}
+
+	return start + (rowNum-1)*step, nil
+}
+
+// timeSeries returns the timestamp of a timeseries field for the given row number.
+// Rows begin at the start param and are spaced by the interval param plus a random jitter
+// below the jitter param, so timestamps always increase. Defaults are 2000-01-01T00:00:00Z,
+// 1s and no jitter, formatted with the format param or RFC3339Nano
+func timeSeries(r fakerCtx, field *Field, rowNum int) (string, error) {
+	ts, err := timeSeriesParams(field)
+	if err != nil {
+		return "", fmt.Errorf("%s field %s", field.Name, err)
+	}
+
+	t := ts.start.Add(time.Duration(rowNum-1) * ts.interval)
+	if ts.jitter > 0 {
+		t = t.Add(time.Duration(r.Int63n(int64(ts.jitter))))
+	}
+
+	return t.Format(ts.format), nil
+}
+
+type timeSeriesOptions struct {
+	start    time.Time
+	interval time.Duration
+	jitter   time.Duration
+	format   string
+}
+
+func timeSeriesParams(field *Field) (*timeSeriesOptions, error) {
+	param := func(name, def string) string {
+		if v, ok := field.Params[name]; ok && len(v) > 0 {
+			return v[0]
+		}
+		return def
+	}
+
+	start, err := dateParse(param("start", "2000-01-01T00:00:00Z"))
+	if err != nil {
+		return nil, fmt.Errorf("start param %s", err)
+	}
+
+	interval, err := time.ParseDuration(param("interval", "1s"))
+	if err != nil || interval <= 0 {
+		return nil, errors.New("interval param must be a duration greater than 0, like 1s")
+	}
+
+	jitter, err := time.ParseDuration(param("jitter", "0s"))
+	if err != nil || jitter < 0 || jitter >= interval {
+		return nil, errors.New("jitter param must be a duration less than the interval")
+	}
+
+	return &timeSeriesOptions{start: start, interval: interval, jitter: jitter, format: param("format", time.RFC3339Nano)}, nil
 }
 
 // init will add all the functions to MapLookups
@@ -47,6 +324,7 @@ func init() {
 	addCarLookup()
 	addPersonLookup()
 	addWordLookup()
+	addLoremLookup()
 	addGenerateLookup()
 	addMiscLookup()
 	addColorLookup()
@@ -54,13 +332,24 @@ func init() {
 	addDateTimeLookup()
 	addPaymentLookup()
 	addCompanyLookup()
+	addProductLookup()
 	addHackerLookup()
 	addHipsterLookup()
 	addLanguagesLookup()
 	addFileLookup()
 	addFileJSONLookup()
+	addFileJSONLLookup()
+	addFileOpenAPIExampleLookup()
 	addFileXMLLookup()
 	addFileCSVLookup()
+	addFileSQLLookup()
+	addFileFixedWidthLookup()
+	addFileParquetLookup()
+	addFileYAMLLookup()
+	addFileMarkdownLookup()
+	addFileHTMLLookup()
+	addFileJSONSchemaLookup()
+	addFileAvroLookup()
 	addEmojiLookup()
 	addImageLookup()
 	addNumberLookup()
@@ -69,6 +358,12 @@ func init() {
 	addGameLookup()
 	addFoodLookup()
 	addAppLookup()
+	addBarcodeLookup()
+	addWeightedLookup()
+	addCronLookup()
+	addDatasetLookup()
+	addErrorLookup()
+	addTelemetryLookup()
 }
 
 // AddFuncLookup takes a field and adds it to map
@@ -77,6 +372,14 @@ func AddFuncLookup(functionName string, info Info) {
 		FuncLookups = make(map[string]Info)
 	}
 
+	// Built in lookups can still be run with CallR directly, just without data overrides
+	if info.generate != nil && info.CallR == nil {
+		generate := info.generate
+		info.CallR = func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			return generate(fakerCtx{Rand: r}, m, info)
+		}
+	}
+
 	lockFuncLookups.Lock()
 	FuncLookups[functionName] = info
 	lockFuncLookups.Unlock()
@@ -92,6 +395,123 @@ func GetFuncLookup(functionName string) *Info {
 	return &info
 }
 
+// GetFuncLookups returns a copy of all the functions in the lookup keyed by name
+func GetFuncLookups() map[string]Info { return funcLookups() }
+
+// FuncLookups returns a copy of all the functions in the lookup keyed by name
+func (f *Faker) FuncLookups() map[string]Info { return funcLookups() }
+
+func funcLookups() map[string]Info {
+	lockFuncLookups.Lock()
+	defer lockFuncLookups.Unlock()
+
+	lookups := make(map[string]Info, len(FuncLookups))
+	for name, info := range FuncLookups {
+		lookups[name] = info
+	}
+
+	return lookups
+}
+
+// FieldError is a field that can not be generated
+type FieldError struct {
+	Name string
+	Err  error
+}
+
+func (fe FieldError) Error() string { return fe.Name + ": " + fe.Err.Error() }
+
+// FieldsError lists every field ValidateFields found that can not be generated
+type FieldsError []FieldError
+
+func (fe FieldsError) Error() string {
+	msgs := make([]string, len(fe))
+	for i, e := range fe {
+		msgs[i] = e.Error()
+	}
+	return "Invalid fields, " + strings.Join(msgs, "; ")
+}
+
+// ValidateFields checks that every fields function exists and has its required params,
+// including the nested fields of object and array fields. All the invalid fields
+// are returned together in a FieldsError
+func ValidateFields(fields []Field) error {
+	var fe FieldsError
+	validateFields(fields, "", &fe)
+	if len(fe) > 0 {
+		return fe
+	}
+
+	return nil
+}
+
+func validateFields(fields []Field, prefix string, fe *FieldsError) {
+	for _, field := range fields {
+		name := prefix + field.Name
+		invalid := func(err error) { *fe = append(*fe, FieldError{Name: name, Err: err}) }
+
+		if field.NullProbability < 0 || field.NullProbability > 1 {
+			invalid(errors.New("null probability must be between 0 and 1"))
+		}
+		if field.MaxLen < 0 {
+			invalid(errors.New("max len can not be negative"))
+		}
+		if _, ok := fieldTransforms[field.Transform]; field.Transform != "" && !ok {
+			invalid(errors.New("unknown transform " + field.Transform + ", must be upper, lower, title, trim or sha256"))
+		}
+
+		switch field.Function {
+		case "autoincrement":
+			if _, err := autoIncrement(&field, 1); err != nil {
+				invalid(errors.New("start and step params must be ints"))
+			}
+			continue
+		case "timeseries":
+			if _, err := timeSeriesParams(&field); err != nil {
+				invalid(err)
+			}
+			continue
+		case "ref":
+			if _, err := refTemplate(&field); err != nil {
+				invalid(errors.New("missing param template"))
+			}
+			continue
+		case "object", "array":
+			fieldsStr, ok := field.Params["fields"]
+			if !ok || len(fieldsStr) == 0 {
+				invalid(errors.New("missing param fields"))
+				continue
+			}
+
+			nested := make([]Field, len(fieldsStr))
+			for i, f := range fieldsStr {
+				if err := json.Unmarshal([]byte(f), &nested[i]); err != nil {
+					invalid(errors.New("unable to decode fields json"))
+					nested = nil
+					break
+				}
+			}
+			validateFields(nested, name+".", fe)
+			continue
+		}
+
+		info := GetFuncLookup(field.Function)
+		if info == nil {
+			invalid(errors.New("function " + field.Function + " does not exist"))
+			continue
+		}
+
+		for _, p := range info.Params {
+			if p.Default != "" || p.Optional {
+				continue
+			}
+			if values, ok := field.Params[p.Field]; !ok || len(values) == 0 {
+				invalid(errors.New("missing param " + p.Field))
+			}
+		}
+	}
+}
+
 // RemoveFuncLookup will remove a function from lookup
 func RemoveFuncLookup(functionName string) {
 	_, ok := FuncLookups[functionName]
@@ -121,7 +541,7 @@ func (i *Info) GetField(m *map[string][]string, field string) (*Param, []string,
 	// Get value from map
 	if m != nil {
 		value, ok := (*m)[field]
-		if !ok {
+		if !ok || len(value) == 0 {
 			if p.Default != "" {
 				// If default isnt empty use default
 				return p, []string{p.Default}, nil
@@ -257,3 +677,22 @@ func (i *Info) GetIntArray(m *map[string][]string, field string) ([]int, error)
 
 	return ints, nil
 }
+
+// GetFloat32Array will retrieve []float field from data
+func (i *Info) GetFloat32Array(m *map[string][]string, field string) ([]float32, error) {
+	_, value, err := i.GetField(m, field)
+	if err != nil {
+		return nil, err
+	}
+
+	var floats []float32
+	for i := 0; i < len(value); i++ {
+		valueFloat, err := strconv.ParseFloat(value[i], 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s value could not parse to float", value[i])
+		}
+		floats = append(floats, float32(valueFloat))
+	}
+
+	return floats, nil
+}
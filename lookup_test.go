@@ -1,7 +1,10 @@
 package gofakeit
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"strings"
 	"testing"
 	"time"
@@ -65,6 +68,166 @@ func Example_custom_with_params() {
 	// Output: lhwlrodole
 }
 
+func Example_custom_csv() {
+	Seed(11)
+
+	// Custom functions can be used as a field function, the fields params are passed to CallR
+	AddFuncLookup("temperature", Info{
+		Display:     "Temperature",
+		Category:    "custom",
+		Description: "Random temperature within a range",
+		Example:     "21C",
+		Output:      "string",
+		Params: []Param{
+			{Field: "min", Display: "Min", Type: "int", Default: "-10", Description: "Minimum temperature"},
+			{Field: "max", Display: "Max", Type: "int", Default: "40", Description: "Maximum temperature"},
+			{Field: "unit", Display: "Unit", Type: "string", Default: "C", Options: []string{"C", "F"}, Description: "Temperature unit"},
+		},
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetInt(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetInt(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			unit, err := info.GetString(m, "unit")
+			if err != nil {
+				return nil, err
+			}
+
+			f := Faker{Rand: r} // Use the callers random source
+			return fmt.Sprintf("%d%s", f.Number(min, max), unit), nil
+		},
+	})
+	defer RemoveFuncLookup("temperature")
+
+	value, err := CSV(&CSVOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "city", Function: "city"},
+			{Name: "celsius", Function: "temperature"},
+			{Name: "fahrenheit", Function: "temperature", Params: map[string][]string{"min": {"32"}, "max": {"100"}, "unit": {"F"}}},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output:
+	// city,celsius,fahrenheit
+	// North Barton,39C,99F
+	// Ratkebury,0C,91F
+	// Roobton,19C,47F
+}
+
+func TestLookupCustomFieldParams(t *testing.T) {
+	var received []map[string][]string
+	AddFuncLookup("customparams", Info{
+		Display:     "Custom Params",
+		Category:    "custom",
+		Description: "Returns the number of values it received for its list param",
+		Example:     "2",
+		Output:      "int",
+		Params: []Param{
+			{Field: "size", Display: "Size", Type: "int", Default: "1", Description: "Size to multiply by"},
+			{Field: "list", Display: "List", Type: "[]string", Default: "a", Description: "List of values"},
+		},
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			received = append(received, *m)
+
+			size, err := info.GetInt(m, "size")
+			if err != nil {
+				return nil, err
+			}
+
+			list, err := info.GetStringArray(m, "list")
+			if err != nil {
+				return nil, err
+			}
+
+			return size * len(list), nil
+		},
+	})
+	defer RemoveFuncLookup("customparams")
+
+	// Fields decoded from json, as the csv lookup and server receive them
+	var fields []Field
+	err := json.Unmarshal([]byte(`[
+		{"name":"typed","function":"customparams","params":{"size":3,"list":["x","y"],"extra":true}},
+		{"name":"strings","function":"customparams","params":{"size":["2"],"list":["x","y","z"]}},
+		{"name":"defaults","function":"customparams"},
+		{"name":"empty","function":"customparams","params":{"size":[],"list":null}}
+	]`), &fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := CSV(&CSVOptions{RowCount: 2, Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "typed,strings,defaults,empty\n6,6,1,1\n6,6,1,1\n"
+	if string(value) != expected {
+		t.Errorf("expected %q, got %q", expected, string(value))
+	}
+
+	// Every param is forwarded, including ones the function does not declare
+	if extra := received[0]["extra"]; len(extra) != 1 || extra[0] != "true" {
+		t.Errorf("expected extra param to be forwarded as true, got %v", extra)
+	}
+}
+
+func TestFieldUnmarshalJSON(t *testing.T) {
+	var field Field
+	err := json.Unmarshal([]byte(`{
+		"name": "items",
+		"function": "array",
+		"null_probability": 0.5,
+		"params": {
+			"rowcount": 2,
+			"precision": 1.5,
+			"fields": [{"name": "sku", "function": "uuid"}, "{\"name\":\"id\",\"function\":\"autoincrement\"}"]
+		}
+	}`), &field)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if field.Name != "items" || field.Function != "array" || field.NullProbability != 0.5 {
+		t.Errorf("unexpected field %+v", field)
+	}
+	if v := field.Params["rowcount"]; len(v) != 1 || v[0] != "2" {
+		t.Errorf("expected rowcount param 2, got %v", v)
+	}
+	if v := field.Params["precision"]; len(v) != 1 || v[0] != "1.5" {
+		t.Errorf("expected precision param 1.5, got %v", v)
+	}
+	expected := []string{`{"name":"sku","function":"uuid"}`, `{"name":"id","function":"autoincrement"}`}
+	if v := field.Params["fields"]; !equalSliceString(v, expected) {
+		t.Errorf("expected fields param %v, got %v", expected, v)
+	}
+
+	// No params stays nil
+	field = Field{}
+	if err := json.Unmarshal([]byte(`{"name":"a","function":"name"}`), &field); err != nil {
+		t.Fatal(err)
+	}
+	if field.Params != nil {
+		t.Errorf("expected nil params, got %v", field.Params)
+	}
+
+	if err := json.Unmarshal([]byte(`{"name":"a","params":{"b":[1,}}`), &field); err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
+
 func TestLookupChecking(t *testing.T) {
 	Seed(time.Now().UnixNano())
 
@@ -103,6 +266,9 @@ func TestLookupChecking(t *testing.T) {
 				case "[]int":
 					mapData[p.Field] = []string{fmt.Sprintf("%d", Int8()), fmt.Sprintf("%d", Int8()), fmt.Sprintf("%d", Int8()), fmt.Sprintf("%d", Int8())}
 					break
+				case "[]float":
+					mapData[p.Field] = []string{fmt.Sprintf("%v", Float32()), fmt.Sprintf("%v", Float32()), fmt.Sprintf("%v", Float32()), fmt.Sprintf("%v", Float32())}
+					break
 				case "[]Field":
 					mapData[p.Field] = []string{`{"name":"first_name","function":"firstname"}`}
 					break
@@ -112,7 +278,7 @@ func TestLookupChecking(t *testing.T) {
 			}
 		}
 
-		_, err := info.Call(&mapData, &info)
+		_, err := info.call(globalFaker.ctx(), &mapData)
 		if err != nil {
 			t.Fatalf("%s failed - Err: %s - Data: %v", field, err, mapData)
 		}
@@ -152,6 +318,61 @@ func TestLookupCheckFields(t *testing.T) {
 	}
 }
 
+func TestLookupCallR(t *testing.T) {
+	AddFuncLookup("callr", Info{
+		Category:    "custom",
+		Description: "Random number from the callers random source",
+		Example:     "5",
+		Output:      "int",
+		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+			return nil, errors.New("Call should not be used when CallR is set")
+		},
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			return r.Int63(), nil
+		},
+	})
+	defer RemoveFuncLookup("callr")
+
+	info := GetFuncLookup("callr")
+	first, err := New(rand.NewSource(11)).CallLookup(info, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := New(rand.NewSource(11)).CallLookup(info, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Errorf("expected the same value from the same seed, got %v and %v", first, second)
+	}
+
+	if _, err := CallLookup(&Info{Display: "Empty"}, nil); err == nil {
+		t.Error("expected error for a lookup without Call or CallR")
+	}
+}
+
+func TestLookupCallCSV(t *testing.T) {
+	// Lookups added with only Call still work as field functions
+	AddFuncLookup("legacycall", Info{
+		Category:    "custom",
+		Description: "Fixed value",
+		Example:     "legacy",
+		Output:      "string",
+		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+			return "legacy", nil
+		},
+	})
+	defer RemoveFuncLookup("legacycall")
+
+	value, err := CSV(&CSVOptions{RowCount: 2, Fields: []Field{{Name: "value", Function: "legacycall"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != "value\nlegacy\nlegacy\n" {
+		t.Errorf("expected legacy values, got %q", value)
+	}
+}
+
 func TestLookupRemove(t *testing.T) {
 	funcName := "friendname"
 
@@ -177,3 +398,137 @@ func TestLookupRemove(t *testing.T) {
 		t.Fatal("Got info when I shouldn't have")
 	}
 }
+
+func TestGetFuncLookups(t *testing.T) {
+	lookups := GetFuncLookups()
+	if len(lookups) != len(FuncLookups) {
+		t.Fatalf("expected %d lookups, got %d", len(FuncLookups), len(lookups))
+	}
+	if _, ok := lookups["firstname"]; !ok {
+		t.Error("expected firstname in lookups")
+	}
+
+	// Changing the copy leaves the lookup alone
+	delete(lookups, "firstname")
+	if GetFuncLookup("firstname") == nil {
+		t.Error("expected firstname to still be in the lookup")
+	}
+
+	if len(New(rand.NewSource(11)).FuncLookups()) != len(FuncLookups) {
+		t.Error("expected faker lookups to match the package lookups")
+	}
+}
+
+func ExampleValidateFields() {
+	err := ValidateFields([]Field{
+		{Name: "id", Function: "autoincrement"},
+		{Name: "first_name", Function: "firstnam"},
+		{Name: "team", Function: "teams", Params: map[string][]string{"people": {"bob"}}},
+	})
+
+	fmt.Println(err)
+
+	// Output: Invalid fields, first_name: function firstnam does not exist; team: missing param teams
+}
+
+func TestValidateFields(t *testing.T) {
+	valid := []Field{
+		{Name: "id", Function: "autoincrement", Params: map[string][]string{"start": {"10"}}},
+		{Name: "first_name", Function: "firstname"},
+		{Name: "status", Function: "oneof", Params: map[string][]string{"values": {"a", "b"}}},
+		{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hi {first_name}"}}},
+		{Name: "address", Function: "object", Params: map[string][]string{"fields": {`{"name":"city","function":"city"}`}}},
+	}
+	if err := ValidateFields(valid); err != nil {
+		t.Fatalf("expected valid fields, got %s", err)
+	}
+
+	invalid := []Field{
+		{Name: "id", Function: "autoincrement", Params: map[string][]string{"step": {"x"}}},
+		{Name: "first_name", Function: "firstnam"},
+		{Name: "status", Function: "oneof"},
+		{Name: "greeting", Function: "ref"},
+		{Name: "address", Function: "object", Params: map[string][]string{"fields": {`{"name":"city","function":"notacity"}`}}},
+		{Name: "items", Function: "array"},
+		{Name: "email", Function: "email", NullProbability: 2},
+		{Name: "last_name", Function: "lastname"},
+		{Name: "city", Function: "city", MaxLen: -1},
+	}
+	err := ValidateFields(invalid)
+	fe, ok := err.(FieldsError)
+	if !ok {
+		t.Fatalf("expected FieldsError, got %v", err)
+	}
+
+	names := []string{}
+	for _, e := range fe {
+		names = append(names, e.Name)
+	}
+	expected := []string{"id", "first_name", "status", "greeting", "address.city", "items", "email", "city"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Errorf("expected invalid fields %v, got %v", expected, names)
+	}
+}
+
+func TestValidateFieldsCSVJSON(t *testing.T) {
+	fields := []Field{
+		{Name: "first_name", Function: "firstnam"},
+		{Name: "last_name", Function: "lastnam"},
+	}
+
+	_, err := CSV(&CSVOptions{RowCount: 1, Fields: fields})
+	if fe, ok := err.(FieldsError); !ok || len(fe) != 2 {
+		t.Errorf("expected csv to report both invalid fields, got %v", err)
+	}
+
+	_, err = JSON(&JSONOptions{Type: "array", RowCount: 1, Fields: fields})
+	if fe, ok := err.(FieldsError); !ok || len(fe) != 2 {
+		t.Errorf("expected json to report both invalid fields, got %v", err)
+	}
+}
+
+func TestFieldTransform(t *testing.T) {
+	generate := func(field Field) string {
+		value, err := New(rand.NewSource(11)).CSV(&CSVOptions{RowCount: 1, NoHeader: true, Fields: []Field{field}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.TrimSuffix(string(value), "\n")
+	}
+
+	tests := []struct {
+		field    Field
+		expected string
+	}{
+		{Field{Name: "name", Function: "name"}, "Santos Rohan"},
+		{Field{Name: "name", Function: "name", Transform: "upper"}, "SANTOS ROHAN"},
+		{Field{Name: "name", Function: "name", Transform: "lower"}, "santos rohan"},
+		{Field{Name: "words", Function: "loremwords", Params: map[string][]string{"count": {"3"}}, Transform: "title"}, "Officia Et Modi"},
+		{Field{Name: "padded", Function: "randomstring", Params: map[string][]string{"strs": {"  padded  "}}, Transform: "trim"}, "padded"},
+		{Field{Name: "name", Function: "name", Transform: "sha256"}, "ad6662f4a8c006a16f803ec36c072f2cbc930bdced717f1d1a1ec6113b9f4d75"},
+		{Field{Name: "number", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"1"}}, Transform: "sha256"}, "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b"},
+		{Field{Name: "name", Function: "name", Transform: "upper", MaxLen: 3}, "SAN"},
+	}
+	for _, test := range tests {
+		if value := generate(test.field); value != test.expected {
+			t.Errorf("expected %s transform to give %q got %q", test.field.Transform, test.expected, value)
+		}
+	}
+
+	// Transforms apply to json the same way, leaving other types as is
+	value, err := New(rand.NewSource(11)).JSON(&JSONOptions{Type: "object", Fields: []Field{
+		{Name: "name", Function: "name", Transform: "upper"},
+		{Name: "number", Function: "number", Params: map[string][]string{"min": {"5"}, "max": {"5"}}, Transform: "upper"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != `{"name":"MARKUS MOEN","number":5}` {
+		t.Errorf("expected json transform got %s", value)
+	}
+
+	_, err = CSV(&CSVOptions{RowCount: 1, Fields: []Field{{Name: "name", Function: "name", Transform: "reverse"}}})
+	if fe, ok := err.(FieldsError); !ok || len(fe) != 1 || !strings.Contains(fe.Error(), "unknown transform reverse") {
+		t.Errorf("expected unknown transform error got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+package gofakeit
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+// LoremWords will generate exactly n lower case lorem ipsum words separated by spaces
+func LoremWords(n int) string { return loremWords(globalFaker.ctx(), n) }
+
+// LoremWords will generate exactly n lower case lorem ipsum words separated by spaces
+func (f *Faker) LoremWords(n int) string { return loremWords(f.ctx(), n) }
+
+func loremWords(r fakerCtx, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	words := make([]string, n)
+	for i := range words {
+		words[i] = loremIpsumWord(r)
+	}
+	return strings.Join(words, " ")
+}
+
+// LoremSentences will generate exactly n lorem ipsum sentences of 4 to 12 words separated by spaces
+func LoremSentences(n int) string { return loremSentences(globalFaker.ctx(), n) }
+
+// LoremSentences will generate exactly n lorem ipsum sentences of 4 to 12 words separated by spaces
+func (f *Faker) LoremSentences(n int) string { return loremSentences(f.ctx(), n) }
+
+func loremSentences(r fakerCtx, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	sentences := make([]string, n)
+	for i := range sentences {
+		sentences[i] = loremIpsumSentence(r, number(r, 4, 12))
+	}
+	return strings.Join(sentences, " ")
+}
+
+// LoremParagraphs will generate exactly n lorem ipsum paragraphs of 3 to 6 sentences separated by new lines
+func LoremParagraphs(n int) string { return loremParagraphs(globalFaker.ctx(), n) }
+
+// LoremParagraphs will generate exactly n lorem ipsum paragraphs of 3 to 6 sentences separated by new lines
+func (f *Faker) LoremParagraphs(n int) string { return loremParagraphs(f.ctx(), n) }
+
+func loremParagraphs(r fakerCtx, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	paragraphs := make([]string, n)
+	for i := range paragraphs {
+		paragraphs[i] = loremSentences(r, number(r, 3, 6))
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// LoremCharacters will generate lorem ipsum words that are exactly n characters long in total.
+// The words are picked so the text ends on a whole word, only cutting the last word short
+// when the words available can not add up to n
+func LoremCharacters(n int) string { return loremCharacters(globalFaker.ctx(), n) }
+
+// LoremCharacters will generate lorem ipsum words that are exactly n characters long in total.
+// The words are picked so the text ends on a whole word, only cutting the last word short
+// when the words available can not add up to n
+func (f *Faker) LoremCharacters(n int) string { return loremCharacters(f.ctx(), n) }
+
+func loremCharacters(r fakerCtx, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	words, ok := dataOverride(r, []string{"lorem", "word"})
+	if !ok {
+		words = data.Lorem["word"]
+	}
+
+	// Group the words by length to finish on a word that fills the rest exactly
+	byLen := map[int][]string{}
+	for _, w := range words {
+		byLen[len(w)] = append(byLen[len(w)], w)
+	}
+
+	var sb strings.Builder
+	for sb.Len() < n {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		rest := n - sb.Len()
+		if exact := byLen[rest]; len(exact) > 0 {
+			sb.WriteString(exact[r.Intn(len(exact))])
+			break
+		}
+
+		// Leave room for a space and at least one more character, trying a few
+		// random words before cutting one short
+		next := ""
+		for i := 0; i < 10; i++ {
+			if w := words[r.Intn(len(words))]; len(w) > 0 && len(w) <= rest-2 {
+				next = w
+				break
+			}
+		}
+		if next == "" {
+			next = words[r.Intn(len(words))]
+			for len(next) < rest {
+				next += " " + words[r.Intn(len(words))]
+			}
+			sb.WriteString(next[:rest])
+			break
+		}
+		sb.WriteString(next)
+	}
+
+	text := []rune(sb.String())
+	text[0] = unicode.ToTitle(text[0])
+	return string(text)
+}
+
+// SearchDoc is a struct containing a document for full text search indexing
+type SearchDoc struct {
+	Title       string    `json:"title" xml:"title"`
+	Body        string    `json:"body" xml:"body"`
+	Tags        []string  `json:"tags" xml:"tags"`
+	Author      string    `json:"author" xml:"author"`
+	PublishedAt time.Time `json:"published_at" xml:"published_at"`
+}
+
+// SearchDocument will generate a document with a lorem ipsum title, a body of 2 to 5
+// lorem ipsum paragraphs separated by new lines and 1 to 5 unique noun tags
+func SearchDocument() *SearchDoc { return searchDocument(globalFaker.ctx()) }
+
+// SearchDocument will generate a document with a lorem ipsum title, a body of 2 to 5
+// lorem ipsum paragraphs separated by new lines and 1 to 5 unique noun tags
+func (f *Faker) SearchDocument() *SearchDoc { return searchDocument(f.ctx()) }
+
+func searchDocument(r fakerCtx) *SearchDoc {
+	title := []rune(loremWords(r, number(r, 3, 8)))
+	title[0] = unicode.ToTitle(title[0])
+
+	// A noun data set with few values can't always fill the count with unique tags
+	count := number(r, 1, 5)
+	tags := make([]string, 0, count)
+	for i := 0; i < count*10 && len(tags) < count; i++ {
+		tag := strings.ToLower(noun(r))
+		if !stringInSlice(tag, tags) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &SearchDoc{
+		Title:       string(title),
+		Body:        loremParagraphs(r, number(r, 2, 5)),
+		Tags:        tags,
+		Author:      name(r),
+		PublishedAt: date(r),
+	}
+}
+
+func addLoremLookup() {
+	AddFuncLookup("loremwords", Info{
+		Display:     "Lorem Words",
+		Category:    "word",
+		Description: "Exact number of lorem ipsum words",
+		Example:     "quia quae repellat consequatur quidem",
+		Output:      "string",
+		Params: []Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "5", Description: "Number of words"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			count, err := loremCount(info, m)
+			if err != nil {
+				return nil, err
+			}
+
+			return loremWords(r, count), nil
+		},
+	})
+
+	AddFuncLookup("loremsentences", Info{
+		Display:     "Lorem Sentences",
+		Category:    "word",
+		Description: "Exact number of lorem ipsum sentences",
+		Example:     "Quia quae repellat consequatur quidem nisi. Quas et ut non dolorem ipsam aut enim.",
+		Output:      "string",
+		Params: []Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "3", Description: "Number of sentences"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			count, err := loremCount(info, m)
+			if err != nil {
+				return nil, err
+			}
+
+			return loremSentences(r, count), nil
+		},
+	})
+
+	AddFuncLookup("loremparagraphs", Info{
+		Display:     "Lorem Paragraphs",
+		Category:    "word",
+		Description: "Exact number of lorem ipsum paragraphs separated by new lines",
+		Example:     "Quia quae repellat consequatur quidem nisi. Quas et ut non dolorem ipsam aut enim...",
+		Output:      "string",
+		Params: []Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "2", Description: "Number of paragraphs"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			count, err := loremCount(info, m)
+			if err != nil {
+				return nil, err
+			}
+
+			return loremParagraphs(r, count), nil
+		},
+	})
+
+	AddFuncLookup("loremcharacters", Info{
+		Display:     "Lorem Characters",
+		Category:    "word",
+		Description: "Lorem ipsum words exactly a number of characters long",
+		Example:     "Quia quae repellat",
+		Output:      "string",
+		Params: []Param{
+			{Field: "count", Display: "Count", Type: "int", Default: "100", Description: "Number of characters"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			count, err := loremCount(info, m)
+			if err != nil {
+				return nil, err
+			}
+
+			return loremCharacters(r, count), nil
+		},
+	})
+
+	AddFuncLookup("searchdocument", Info{
+		Display:     "Search Document",
+		Category:    "word",
+		Description: "Random document with a title, multi paragraph body, tags, author and publish date",
+		Example:     `{title: "Quae repellat consequatur", body: "Quia quae repellat...", tags: ["transport", "wall"], author: "Modesta Hilpert", published_at: "1988-04-05T23:04:18Z"}`,
+		Output:      "map[string]interface",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return searchDocument(r), nil
+		},
+	})
+}
+
+func loremCount(info *Info, m *map[string][]string) (int, error) {
+	count, err := info.GetInt(m, "count")
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, errors.New("Invalid count, must not be negative")
+	}
+
+	return count, nil
+}
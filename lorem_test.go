@@ -0,0 +1,200 @@
+package gofakeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+func ExampleLoremWords() {
+	Seed(11)
+	fmt.Println(LoremWords(5))
+	// Output: quia quae repellat consequatur quidem
+}
+
+func TestLoremWords(t *testing.T) {
+	for n := 0; n <= 50; n++ {
+		if words := strings.Fields(LoremWords(n)); len(words) != n {
+			t.Fatalf("expected %d words got %d", n, len(words))
+		}
+	}
+}
+
+func BenchmarkLoremWords(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LoremWords(10)
+	}
+}
+
+func ExampleLoremSentences() {
+	Seed(11)
+	fmt.Println(LoremSentences(2))
+	// Output: Quae repellat consequatur quidem nisi quo qui. Accusantium quisquam amet quas et ut non dolorem ipsam aut enim.
+}
+
+func TestLoremSentences(t *testing.T) {
+	for n := 0; n <= 20; n++ {
+		value := LoremSentences(n)
+		if count := strings.Count(value, "."); count != n {
+			t.Fatalf("expected %d sentences got %d in %s", n, count, value)
+		}
+		if n > 0 && !strings.HasSuffix(value, ".") {
+			t.Fatalf("expected %s to end with a sentence", value)
+		}
+	}
+}
+
+func BenchmarkLoremSentences(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LoremSentences(5)
+	}
+}
+
+func ExampleLoremParagraphs() {
+	Seed(11)
+	fmt.Println(LoremParagraphs(2))
+	// Output: Repellat consequatur quidem nisi quo qui. Accusantium quisquam amet quas et ut non dolorem ipsam aut enim. Mollitia harum ut dicta similique veniam nulla voluptas at.
+	// Ad maxime at non. Hic repellat praesentium voluptatem. Consequuntur dolor iusto autem velit aut fugit tempore exercitationem harum consequatur. Modi minima aut eaque et et aut ea voluptatem dignissimos expedita. Tempore quod aut beatae ipsam iste minus voluptatibus dolorem.
+}
+
+func TestLoremParagraphs(t *testing.T) {
+	if LoremParagraphs(0) != "" {
+		t.Error("expected no paragraphs to be empty")
+	}
+	for n := 1; n <= 10; n++ {
+		paragraphs := strings.Split(LoremParagraphs(n), "\n")
+		if len(paragraphs) != n {
+			t.Fatalf("expected %d paragraphs got %d", n, len(paragraphs))
+		}
+		for _, p := range paragraphs {
+			if count := strings.Count(p, "."); count < 3 || count > 6 {
+				t.Fatalf("expected 3 to 6 sentences in a paragraph got %d", count)
+			}
+		}
+	}
+}
+
+func BenchmarkLoremParagraphs(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LoremParagraphs(3)
+	}
+}
+
+func ExampleLoremCharacters() {
+	Seed(11)
+	fmt.Println(LoremCharacters(30))
+	// Output: Quia quae repellat praesentium
+}
+
+func TestLoremCharacters(t *testing.T) {
+	for n := 0; n <= 300; n++ {
+		value := LoremCharacters(n)
+		if len(value) != n {
+			t.Fatalf("expected %d characters got %d in %q", n, len(value), value)
+		}
+		if strings.HasPrefix(value, " ") || strings.HasSuffix(value, " ") || strings.Contains(value, "  ") {
+			t.Fatalf("expected %q to only have single spaces between words", value)
+		}
+	}
+
+	// The built in words add up to any length so the text always ends on a whole word
+	f := New(rand.NewSource(11))
+	for n := 1; n <= 200; n++ {
+		value := f.LoremCharacters(n)
+		for _, w := range strings.Fields(strings.ToLower(value)) {
+			if !stringInSlice(w, data.Lorem["word"]) {
+				t.Fatalf("expected %q to only have whole words, got %s", value, w)
+			}
+		}
+	}
+
+	// Words that can not add up to n have the last one cut short
+	f.SetData("lorem.word", []string{"lorem"})
+	if value := f.LoremCharacters(8); value != "Lorem lo" {
+		t.Errorf("expected the last word to be cut got %q", value)
+	}
+	if value := f.LoremCharacters(11); value != "Lorem lorem" {
+		t.Errorf("expected whole words got %q", value)
+	}
+}
+
+func BenchmarkLoremCharacters(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LoremCharacters(200)
+	}
+}
+
+func ExampleSearchDocument() {
+	Seed(11)
+	doc := SearchDocument()
+	fmt.Println(doc.Title)
+	fmt.Println(doc.Tags)
+	fmt.Println(doc.Author)
+	// Output: Quae repellat consequatur
+	// [transport wall river partner college]
+	// Modesta Hilpert
+}
+
+func TestSearchDocument(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for i := 0; i < 1000; i++ {
+		doc := f.SearchDocument()
+		if doc.Title == "" || doc.Author == "" || doc.PublishedAt.IsZero() {
+			t.Fatalf("expected a title, author and publish date got %+v", doc)
+		}
+		if doc.Title[0] < 'A' || doc.Title[0] > 'Z' {
+			t.Fatalf("expected a capitalized title got %s", doc.Title)
+		}
+
+		paragraphs := strings.Split(doc.Body, "\n")
+		if len(paragraphs) < 2 || len(paragraphs) > 5 {
+			t.Fatalf("expected 2 to 5 paragraphs got %d", len(paragraphs))
+		}
+		for _, p := range paragraphs {
+			if p == "" {
+				t.Fatalf("expected non empty paragraphs got %q", doc.Body)
+			}
+		}
+
+		if len(doc.Tags) < 1 || len(doc.Tags) > 5 {
+			t.Fatalf("expected 1 to 5 tags got %v", doc.Tags)
+		}
+		seen := map[string]bool{}
+		for _, tag := range doc.Tags {
+			if tag == "" || seen[tag] {
+				t.Fatalf("expected unique non empty tags got %v", doc.Tags)
+			}
+			seen[tag] = true
+		}
+	}
+}
+
+func TestSearchDocumentLookup(t *testing.T) {
+	info := GetFuncLookup("searchdocument")
+	value, err := info.call(globalFaker.ctx(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := SearchDoc{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Title == "" || doc.Body == "" || len(doc.Tags) == 0 {
+		t.Errorf("expected the lookup json to have a title, body and tags got %s", b)
+	}
+}
+
+func BenchmarkSearchDocument(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SearchDocument()
+	}
+}
@@ -0,0 +1,217 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MarkdownOptions defines values needed for markdown table generation
+type MarkdownOptions struct {
+	RowCount int     `json:"row_count" xml:"row_count"`
+	Fields   []Field `json:"fields" xml:"fields"`
+	Align    string  `json:"align" xml:"align"` // left, center or right, empty leaves alignment to the renderer
+}
+
+// Markdown generates a github flavored markdown table with a header row and RowCount rows of field values.
+// Pipes in values are escaped and newlines are replaced with <br>
+func Markdown(mo *MarkdownOptions) ([]byte, error) { return markdown(globalFaker.ctx(), mo) }
+
+// Markdown generates a github flavored markdown table with a header row and RowCount rows of field values.
+// Pipes in values are escaped and newlines are replaced with <br>
+func (f *Faker) Markdown(mo *MarkdownOptions) ([]byte, error) { return markdown(f.ctx(), mo) }
+
+func markdown(r fakerCtx, mo *MarkdownOptions) ([]byte, error) {
+	// Check fields
+	if mo.Fields == nil || len(mo.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build markdown table")
+	}
+
+	// Make sure you set a row count
+	if mo.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	var separator string
+	switch mo.Align {
+	case "":
+		separator = "---"
+	case "left":
+		separator = ":---"
+	case "center":
+		separator = ":---:"
+	case "right":
+		separator = "---:"
+	default:
+		return nil, errors.New("Invalid align, must be left, center or right")
+	}
+
+	// Ref fields are filled in after the rest of the row
+	refOrder, err := refFieldOrder(mo.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bytes.Buffer{}
+	writeRow := func(cells []string) {
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+
+	header := make([]string, len(mo.Fields))
+	separators := make([]string, len(mo.Fields))
+	for i, field := range mo.Fields {
+		header[i] = markdownEscape(field.Name)
+		separators[i] = separator
+	}
+	writeRow(header)
+	b.WriteString("|" + strings.Join(separators, "|") + "|\n")
+
+	for i := 1; i <= mo.RowCount; i++ {
+		vr := make([]string, len(mo.Fields))
+		for ii, field := range mo.Fields {
+			if field.Function == "ref" {
+				continue
+			}
+
+			if fieldNull(r, &field) {
+				continue
+			}
+
+			if field.Function == "autoincrement" {
+				id, err := autoIncrement(&field, i)
+				if err != nil {
+					return nil, err
+				}
+				vr[ii] = fmt.Sprintf("%d", id)
+				continue
+			}
+
+			if field.Function == "timeseries" {
+				ts, err := timeSeries(r, &field, i)
+				if err != nil {
+					return nil, err
+				}
+				vr[ii] = ts
+				continue
+			}
+
+			// Get function info
+			funcInfo := GetFuncLookup(field.Function)
+			if funcInfo == nil {
+				return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+			}
+
+			value, err := fieldCall(r, &field, funcInfo)
+			if err != nil {
+				return nil, err
+			}
+
+			vr[ii] = markdownValue(value)
+		}
+
+		if len(refOrder) > 0 {
+			row := make(map[string]interface{}, len(mo.Fields))
+			for ii, field := range mo.Fields {
+				row[field.Name] = vr[ii]
+			}
+			err := refEvaluate(r, mo.Fields, refOrder, row, func(ii int, value interface{}) {
+				if value != nil {
+					vr[ii] = markdownValue(value)
+				}
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for ii := range vr {
+			vr[ii] = markdownEscape(vr[ii])
+		}
+		writeRow(vr)
+	}
+
+	return b.Bytes(), nil
+}
+
+// markdownValue converts a generated value into the text of a table cell
+func markdownValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", v)
+	}
+
+	// Anything else like structs or maps gets written as json
+	j, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(j)
+}
+
+// markdownEscape escapes pipes and replaces newlines so a value stays within its cell
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>", "\r", "<br>").Replace(s)
+}
+
+func addFileMarkdownLookup() {
+	AddFuncLookup("markdown", Info{
+		Display:     "Markdown",
+		Category:    "file",
+		Description: "Generates a markdown table of field values",
+		Example: `
+			| id | first_name | last_name |
+			|---|---|---|
+			| 1 | Markus | Moen |
+			| 2 | Osborne | Hilll |
+		`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows in table"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
+			{Field: "align", Display: "Align", Type: "string", Default: "none", Options: []string{"none", "left", "center", "right"}, Description: "Alignment of the table columns"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			mo := MarkdownOptions{}
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			mo.RowCount = rowcount
+
+			align, err := info.GetString(m, "align")
+			if err != nil {
+				return nil, err
+			}
+			if align != "none" {
+				mo.Align = align
+			}
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				mo.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &mo.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			return markdown(r, &mo)
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package gofakeit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleMarkdown() {
+	Seed(11)
+
+	value, err := Markdown(&MarkdownOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output:
+	// | id | first_name | last_name | password |
+	// |---|---|---|---|
+	// | 1 | Markus | Moen | Dc0VYXjkWABx |
+	// | 2 | Osborne | Hilll | XPJ9OVNbs5lm |
+	// | 3 | Mertie | Halvorson | eyl3bhwfV8wA |
+}
+
+func TestMarkdown(t *testing.T) {
+	for _, test := range []struct {
+		align     string
+		separator string
+	}{
+		{"", "|---|---|---|"},
+		{"left", "|:---|:---|:---|"},
+		{"center", "|:---:|:---:|:---:|"},
+		{"right", "|---:|---:|---:|"},
+	} {
+		value, err := Markdown(&MarkdownOptions{
+			RowCount: 10,
+			Align:    test.align,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "piped", Function: "randomstring", Params: map[string][]string{"strs": {"a|b", "|", "c||d"}}},
+				{Name: "multi|line", Function: "randomstring", Params: map[string][]string{"strs": {"one\ntwo"}}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lines := strings.Split(strings.TrimSuffix(string(value), "\n"), "\n")
+		if len(lines) != 12 {
+			t.Fatalf("expected header, separator and 10 rows, got %d lines", len(lines))
+		}
+		if lines[0] != `| id | piped | multi\|line |` {
+			t.Errorf("unexpected header %s", lines[0])
+		}
+		if lines[1] != test.separator {
+			t.Errorf("expected separator %s, got %s", test.separator, lines[1])
+		}
+
+		for _, line := range lines[2:] {
+			// Splitting on unescaped pipes must give back exactly the 3 columns
+			cells := markdownTestCells(line)
+			if len(cells) != 3 {
+				t.Fatalf("expected 3 cells, got %d in %s", len(cells), line)
+			}
+			if cells[1] != `a\|b` && cells[1] != `\|` && cells[1] != `c\|\|d` {
+				t.Errorf("expected escaped pipes, got %s", cells[1])
+			}
+			if cells[2] != "one<br>two" {
+				t.Errorf("expected newline to be replaced, got %s", cells[2])
+			}
+		}
+	}
+}
+
+func TestMarkdownRefAndNull(t *testing.T) {
+	value, err := Markdown(&MarkdownOptions{
+		RowCount: 5,
+		Fields: []Field{
+			{Name: "first", Function: "firstname"},
+			{Name: "empty", Function: "lastname", NullProbability: 1},
+			{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hi {first}"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(value)), "\n")[2:] {
+		cells := markdownTestCells(line)
+		if cells[1] != "" {
+			t.Errorf("expected null field to be empty, got %s", cells[1])
+		}
+		if cells[2] != "hi "+cells[0] {
+			t.Errorf("expected ref field to be built from first, got %s", cells[2])
+		}
+	}
+}
+
+func TestMarkdownLookup(t *testing.T) {
+	info := GetFuncLookup("markdown")
+
+	m := map[string][]string{
+		"rowcount": {"10"},
+		"align":    {"center"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+		},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(value.([]byte))), "\n")
+	if len(lines) != 12 {
+		t.Errorf("expected 12 lines, got %d", len(lines))
+	}
+	if lines[1] != "|:---:|:---:|" {
+		t.Errorf("unexpected separator %s", lines[1])
+	}
+}
+
+func TestMarkdownErrors(t *testing.T) {
+	fields := []Field{{Name: "a", Function: "name"}}
+
+	if _, err := Markdown(&MarkdownOptions{RowCount: 1}); err == nil {
+		t.Error("expected error for missing fields")
+	}
+	if _, err := Markdown(&MarkdownOptions{Fields: fields}); err == nil {
+		t.Error("expected error for missing row count")
+	}
+	if _, err := Markdown(&MarkdownOptions{RowCount: 1, Fields: fields, Align: "justify"}); err == nil {
+		t.Error("expected error for invalid align")
+	}
+	if _, err := Markdown(&MarkdownOptions{RowCount: 1, Fields: []Field{{Name: "a", Function: "notafunction"}}}); err == nil {
+		t.Error("expected error for invalid function")
+	}
+}
+
+func BenchmarkMarkdown100(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Markdown(&MarkdownOptions{
+			RowCount: 100,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname"},
+				{Name: "last_name", Function: "lastname"},
+				{Name: "password", Function: "password"},
+			},
+		})
+	}
+}
+
+// markdownTestCells splits a table row on its unescaped pipes
+func markdownTestCells(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "| "), " |")
+
+	cells := []string{}
+	cell := ""
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' && i+1 < len(line) && line[i+1] == '|' {
+			cell += `\|`
+			i++
+			continue
+		}
+		if strings.HasPrefix(line[i:], " | ") {
+			cells = append(cells, cell)
+			cell = ""
+			i += 2
+			continue
+		}
+		cell += string(line[i])
+	}
+	return append(cells, cell)
+}
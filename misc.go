@@ -1,23 +1,109 @@
 package gofakeit
 
 import (
+	"crypto/sha1"
 	"encoding/hex"
-	"math/rand"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
 
 	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 // Bool will generate a random boolean value
-func Bool() bool {
-	return randIntRange(0, 1) == 1
+func Bool() bool { return boolFunc(globalFaker.ctx()) }
+
+// Bool will generate a random boolean value
+func (f *Faker) Bool() bool { return boolFunc(f.ctx()) }
+
+func boolFunc(r fakerCtx) bool {
+	return randIntRange(r, 0, 1) == 1
+}
+
+// BoolWeighted will generate a random boolean that is true with a chance of trueProbability,
+// which is between 0 and 1. It doesnt validate trueProbability, values below 0 and NaN are always
+// false and values above 1 always true. Use BoolWeightedE to get an error for them instead
+func BoolWeighted(trueProbability float32) bool {
+	return boolWeighted(globalFaker.ctx(), trueProbability)
+}
+
+// BoolWeighted will generate a random boolean that is true with a chance of trueProbability,
+// which is between 0 and 1. It doesnt validate trueProbability, values below 0 and NaN are always
+// false and values above 1 always true. Use BoolWeightedE to get an error for them instead
+func (f *Faker) BoolWeighted(trueProbability float32) bool {
+	return boolWeighted(f.ctx(), trueProbability)
+}
+
+func boolWeighted(r fakerCtx, trueProbability float32) bool {
+	return r.Float32() < trueProbability
+}
+
+// BoolWeightedE will generate a random boolean that is true with a chance of trueProbability.
+// An error is returned if trueProbability is NaN or not between 0 and 1
+func BoolWeightedE(trueProbability float32) (bool, error) {
+	return boolWeightedE(globalFaker.ctx(), trueProbability)
+}
+
+// BoolWeightedE will generate a random boolean that is true with a chance of trueProbability.
+// An error is returned if trueProbability is NaN or not between 0 and 1
+func (f *Faker) BoolWeightedE(trueProbability float32) (bool, error) {
+	return boolWeightedE(f.ctx(), trueProbability)
+}
+
+func boolWeightedE(r fakerCtx, trueProbability float32) (bool, error) {
+	if math.IsNaN(float64(trueProbability)) || trueProbability < 0 || trueProbability > 1 {
+		return false, errors.New("Invalid probability, must be between 0 and 1")
+	}
+
+	return boolWeighted(r, trueProbability), nil
+}
+
+// ShuffleAnySlice will randomize a slice of any type in place, v can be the slice or a pointer to it
+func ShuffleAnySlice(v interface{}) error { return shuffleAnySlice(globalFaker.ctx(), v) }
+
+// ShuffleAnySlice will randomize a slice of any type in place, v can be the slice or a pointer to it
+func (f *Faker) ShuffleAnySlice(v interface{}) error { return shuffleAnySlice(f.ctx(), v) }
+
+func shuffleAnySlice(r fakerCtx, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice {
+		return errors.New("Must pass a slice or a pointer to a slice to shuffle")
+	}
+
+	// Same walk as ShuffleInts so an int slice ends in the same order
+	swap := reflect.Swapper(rv.Interface())
+	for i := 0; i < rv.Len(); i++ {
+		swap(i, r.Intn(i+1))
+	}
+
+	return nil
 }
 
 // UUID (version 4) will generate a random unique identifier based upon random nunbers
 // Format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-func UUID() string {
+func UUID() string { return uuid(globalFaker.ctx()) }
+
+// UUID (version 4) will generate a random unique identifier based upon random nunbers
+// Format: xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+func (f *Faker) UUID() string { return uuid(f.ctx()) }
+
+func uuid(r fakerCtx) string {
 	version := byte(4)
 	uuid := make([]byte, 16)
-	rand.Read(uuid)
+
+	// Fill 7 bytes per Int63 rather than using r.Read, which keeps
+	// leftover bytes on the rand.Rand and isnt safe for concurrent use
+	for i := 0; i < len(uuid); i += 7 {
+		val := r.Int63()
+		for j := i; j < i+7 && j < len(uuid); j++ {
+			uuid[j] = byte(val)
+			val >>= 8
+		}
+	}
 
 	// Set version
 	uuid[6] = (uuid[6] & 0x0f) | (version << 4)
@@ -25,6 +111,11 @@ func UUID() string {
 	// Set variant
 	uuid[8] = (uuid[8] & 0xbf) | 0x80
 
+	return uuidFormat(uuid)
+}
+
+// uuidFormat returns the 16 bytes of a uuid in its canonical dashed hex form
+func uuidFormat(uuid []byte) string {
 	buf := make([]byte, 36)
 	var dash byte = '-'
 	hex.Encode(buf[0:8], uuid[0:4])
@@ -40,6 +131,62 @@ func UUID() string {
 	return string(buf)
 }
 
+// UUIDNamespaces are the predefined namespaces from RFC 4122 for name based uuids
+var UUIDNamespaces = map[string]string{
+	"dns":  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	"url":  "6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+	"oid":  "6ba7b812-9dad-11d1-80b4-00c04fd430c8",
+	"x500": "6ba7b814-9dad-11d1-80b4-00c04fd430c8",
+}
+
+// UUIDv5 will generate a name based unique identifier using sha1 as defined in RFC 4122.
+// The same namespace and name always generate the same uuid. Namespace is a uuid
+// or one of the predefined namespaces dns, url, oid or x500
+func UUIDv5(namespace string, name string) (string, error) { return uuidV5(namespace, name) }
+
+// UUIDv5 will generate a name based unique identifier using sha1 as defined in RFC 4122.
+// The same namespace and name always generate the same uuid. Namespace is a uuid
+// or one of the predefined namespaces dns, url, oid or x500
+func (f *Faker) UUIDv5(namespace string, name string) (string, error) { return uuidV5(namespace, name) }
+
+func uuidV5(namespace string, name string) (string, error) {
+	if ns, ok := UUIDNamespaces[strings.ToLower(namespace)]; ok {
+		namespace = ns
+	}
+
+	ns, err := uuidParse(namespace)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha1.New()
+	h.Write(ns)
+	h.Write([]byte(name))
+	uuid := h.Sum(nil)[:16]
+
+	// Set version
+	uuid[6] = (uuid[6] & 0x0f) | (5 << 4)
+
+	// Set variant
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+
+	return uuidFormat(uuid), nil
+}
+
+// uuidParse returns the 16 bytes of a uuid in its canonical dashed hex form
+func uuidParse(s string) ([]byte, error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return nil, errors.New("Invalid uuid " + s)
+	}
+
+	uuid, err := hex.DecodeString(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:])
+	if err != nil {
+		return nil, errors.New("Invalid uuid " + s)
+	}
+
+	return uuid, nil
+}
+
 // Categories will return a map string array of available data categories and sub categories
 func Categories() map[string][]string {
 	types := make(map[string][]string)
@@ -60,8 +207,33 @@ func addMiscLookup() {
 		Description: "Random uuid",
 		Example:     "590c1440-9888-45b0-bd51-a817ee07c3f2",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return UUID(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return uuid(r), nil
+		},
+	})
+
+	AddFuncLookup("uuidv5", Info{
+		Display:     "UUID v5",
+		Category:    "misc",
+		Description: "Name based uuid that is the same for every namespace and name pair",
+		Example:     "886313e1-3b8a-5372-9b90-0c9aee199e5d",
+		Output:      "string",
+		Params: []Param{
+			{Field: "namespace", Display: "Namespace", Type: "string", Default: "dns", Description: "Namespace uuid or one of dns, url, oid or x500"},
+			{Field: "name", Display: "Name", Type: "string", Description: "Name within the namespace"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			namespace, err := info.GetString(m, "namespace")
+			if err != nil {
+				return nil, err
+			}
+
+			name, err := info.GetString(m, "name")
+			if err != nil {
+				return nil, err
+			}
+
+			return uuidV5(namespace, name)
 		},
 	})
 
@@ -71,9 +243,26 @@ func addMiscLookup() {
 		Description: "Random boolean",
 		Example:     "true",
 		Output:      "bool",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Bool(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return boolFunc(r), nil
 		},
 	})
 
+	AddFuncLookup("boolweighted", Info{
+		Display:     "Boolean Weighted",
+		Category:    "misc",
+		Description: "Random boolean that is true with a probability",
+		Example:     "false",
+		Output:      "bool",
+		Params: []Param{
+			{Field: "prob", Display: "Probability", Type: "float", Default: "0.5", Description: "Chance of true between 0 and 1"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			prob, err := info.GetFloat32(m, "prob")
+			if err != nil {
+				return nil, err
+			}
+			return boolWeightedE(r, prob)
+		},
+	})
 }
@@ -2,6 +2,8 @@ package gofakeit
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"reflect"
 	"sort"
 	"testing"
@@ -21,6 +23,84 @@ func BenchmarkBool(b *testing.B) {
 	}
 }
 
+func ExampleBoolWeighted() {
+	Seed(11)
+	fmt.Println(BoolWeighted(0.9))
+	// Output: true
+}
+
+func TestBoolWeighted(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for _, prob := range []float32{0.1, 0.5, 0.9} {
+		trues := 0
+		for i := 0; i < 10000; i++ {
+			if f.BoolWeighted(prob) {
+				trues++
+			}
+		}
+		if rate := float32(trues) / 10000; rate < prob-0.02 || rate > prob+0.02 {
+			t.Errorf("expected a true rate near %v got %v", prob, rate)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		if f.BoolWeighted(0) || f.BoolWeighted(-1) {
+			t.Fatal("expected a probability of 0 or less to always be false")
+		}
+		if !f.BoolWeighted(1) || !f.BoolWeighted(2) {
+			t.Fatal("expected a probability of 1 or more to always be true")
+		}
+	}
+
+	info := GetFuncLookup("boolweighted")
+	for _, prob := range []string{"-0.1", "1.5", "NaN"} {
+		m := map[string][]string{"prob": {prob}}
+		if _, err := info.call(f.ctx(), &m); err == nil {
+			t.Errorf("expected an error for probability %s", prob)
+		}
+	}
+}
+
+func ExampleBoolWeightedE() {
+	Seed(11)
+
+	value, err := BoolWeightedE(0.9)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+	// Output: true
+}
+
+func TestBoolWeightedE(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for _, prob := range []float32{0, 0.5, 1} {
+		if _, err := f.BoolWeightedE(prob); err != nil {
+			t.Errorf("expected no error for probability %v got %s", prob, err)
+		}
+	}
+	for _, prob := range []float32{-0.1, 1.5, float32(math.NaN()), float32(math.Inf(1))} {
+		if _, err := f.BoolWeightedE(prob); err == nil {
+			t.Errorf("expected an error for probability %v", prob)
+		}
+	}
+
+	// Valid probabilities draw the same as BoolWeighted
+	a, b := New(rand.NewSource(11)), New(rand.NewSource(11))
+	for i := 0; i < 100; i++ {
+		if value, _ := a.BoolWeightedE(0.3); value != b.BoolWeighted(0.3) {
+			t.Fatal("expected BoolWeightedE to match BoolWeighted")
+		}
+	}
+}
+
+func BenchmarkBoolWeighted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		BoolWeighted(0.3)
+	}
+}
+
 func TestUUID(t *testing.T) {
 	id := UUID()
 
@@ -29,6 +109,61 @@ func TestUUID(t *testing.T) {
 	}
 }
 
+func ExampleShuffleAnySlice() {
+	Seed(11)
+
+	floats := []float64{1.1, 2.2, 3.3, 4.4, 5.5}
+	ShuffleAnySlice(floats)
+	fmt.Println(floats)
+	// Output: [4.4 2.2 3.3 1.1 5.5]
+}
+
+func TestShuffleAnySlice(t *testing.T) {
+	a := []float64{1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8}
+	b := []float64{1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8}
+
+	if err := New(rand.NewSource(11)).ShuffleAnySlice(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := New(rand.NewSource(11)).ShuffleAnySlice(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected the same seed to shuffle the same way, got %v and %v", a, b)
+	}
+
+	sorted := append([]float64{}, a...)
+	sort.Float64s(sorted)
+	if !reflect.DeepEqual(sorted, []float64{1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8}) {
+		t.Errorf("expected a permutation of the original values, got %v", a)
+	}
+
+	// Matches ShuffleInts for the same seed
+	ints := []int{52, 854, 941, 74125, 8413, 777, 89416, 841657}
+	intsAny := append([]int{}, ints...)
+	New(rand.NewSource(11)).ShuffleInts(ints)
+	New(rand.NewSource(11)).ShuffleAnySlice(intsAny)
+	if !reflect.DeepEqual(ints, intsAny) {
+		t.Errorf("expected %v to match ShuffleInts %v", intsAny, ints)
+	}
+
+	var nilPtr *[]int
+	for _, v := range []interface{}{nil, 1, "abc", nilPtr, &[2]int{1, 2}} {
+		if err := ShuffleAnySlice(v); err == nil {
+			t.Errorf("expected an error for %#v", v)
+		}
+	}
+	if err := ShuffleAnySlice([]string{}); err != nil {
+		t.Errorf("expected an empty slice to shuffle, got %v", err)
+	}
+}
+
+func BenchmarkShuffleAnySlice(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ShuffleAnySlice([]float64{1.1, 2.2, 3.3, 4.4, 5.5, 6.6, 7.7, 8.8})
+	}
+}
+
 func ExampleUUID() {
 	Seed(11)
 	fmt.Println(UUID())
@@ -41,6 +176,52 @@ func BenchmarkUUID(b *testing.B) {
 	}
 }
 
+func ExampleUUIDv5() {
+	value, err := UUIDv5("dns", "python.org")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 886313e1-3b8a-5372-9b90-0c9aee199e5d
+}
+
+func TestUUIDv5(t *testing.T) {
+	tests := []struct {
+		namespace string
+		name      string
+		expected  string
+	}{
+		{"dns", "python.org", "886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "www.example.com", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"URL", "https://github.com/brianvoe/gofakeit", "e58d77cd-ec18-5fb9-9f3d-f37e81617200"},
+		{"oid", "1.3.6.1", "1447fa61-5277-5fef-a9b3-fbc6e44f4af3"},
+		{"x500", "cn=John Smith", "d1407efb-6828-5023-9fde-962c4ecc2adb"},
+		{"590C1440-9888-45B0-BD51-A817EE07C3F2", "", "c606622e-b72c-5d1c-a501-a55896cc8536"},
+	}
+
+	for _, test := range tests {
+		value, err := UUIDv5(test.namespace, test.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != test.expected {
+			t.Errorf("UUIDv5(%s, %s) expected %s, got %s", test.namespace, test.name, test.expected, value)
+		}
+	}
+
+	for _, namespace := range []string{"", "isbn", "6ba7b810-9dad-11d1-80b4-00c04fd430", "6ba7b810x9dad-11d1-80b4-00c04fd430c8", "zba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		if _, err := UUIDv5(namespace, "python.org"); err == nil {
+			t.Errorf("expected error for namespace %q", namespace)
+		}
+	}
+}
+
+func BenchmarkUUIDv5(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		UUIDv5("dns", "python.org")
+	}
+}
+
 func TestCategories(t *testing.T) {
 	var got, expected []string
 	for k := range Categories() {
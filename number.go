@@ -3,84 +3,201 @@ package gofakeit
 import (
 	"errors"
 	"math"
-	"math/rand"
 )
 
 // Number will generate a random number between given min And max
-func Number(min int, max int) int {
-	return randIntRange(min, max)
+func Number(min int, max int) int { return number(globalFaker.ctx(), min, max) }
+
+// Number will generate a random number between given min And max
+func (f *Faker) Number(min int, max int) int { return number(f.ctx(), min, max) }
+
+func number(r fakerCtx, min int, max int) int {
+	return randIntRange(r, min, max)
 }
 
+// NumberDist will generate a random number between given min and max following a distribution.
+// Dist can be uniform, normal (gaussian centered in the range) or exponential (skewed towards min)
+func NumberDist(min int, max int, dist string) (int, error) {
+	return numberDist(globalFaker.ctx(), min, max, dist)
+}
+
+// NumberDist will generate a random number between given min and max following a distribution.
+// Dist can be uniform, normal (gaussian centered in the range) or exponential (skewed towards min)
+func (f *Faker) NumberDist(min int, max int, dist string) (int, error) {
+	return numberDist(f.ctx(), min, max, dist)
+}
+
+func numberDist(r fakerCtx, min int, max int, dist string) (int, error) {
+	if min > max {
+		return 0, errors.New("Max integer must be larger than Min")
+	}
+
+	span := float64(max) - float64(min)
+	switch dist {
+	case "", "uniform":
+		return number(r, min, max), nil
+	case "normal":
+		// Values outside of the range are redrawn, 6 standard deviations covers the range
+		mean := float64(min) + span/2
+		for {
+			v := math.Round(r.NormFloat64()*span/6 + mean)
+			if v >= float64(min) && v <= float64(max) {
+				return int(v), nil
+			}
+		}
+	case "exponential":
+		// Mean of a fifth of the range, values past max are redrawn
+		for {
+			v := math.Floor(r.ExpFloat64() * span / 5)
+			if v <= span {
+				return int(float64(min) + v), nil
+			}
+		}
+	}
+
+	return 0, errors.New("Invalid distribution " + dist + ", must be uniform, normal or exponential")
+}
+
+// Uint8 will generate a random uint8 value
+func Uint8() uint8 { return uint8Func(globalFaker.ctx()) }
+
 // Uint8 will generate a random uint8 value
-func Uint8() uint8 {
-	return uint8(randIntRange(0, math.MaxUint8))
+func (f *Faker) Uint8() uint8 { return uint8Func(f.ctx()) }
+
+func uint8Func(r fakerCtx) uint8 {
+	return uint8(randIntRange(r, 0, math.MaxUint8))
 }
 
 // Uint16 will generate a random uint16 value
-func Uint16() uint16 {
-	return uint16(randIntRange(0, math.MaxUint16))
+func Uint16() uint16 { return uint16Func(globalFaker.ctx()) }
+
+// Uint16 will generate a random uint16 value
+func (f *Faker) Uint16() uint16 { return uint16Func(f.ctx()) }
+
+func uint16Func(r fakerCtx) uint16 {
+	return uint16(randIntRange(r, 0, math.MaxUint16))
 }
 
 // Uint32 will generate a random uint32 value
-func Uint32() uint32 {
-	return uint32(randIntRange(0, math.MaxInt32))
+func Uint32() uint32 { return uint32Func(globalFaker.ctx()) }
+
+// Uint32 will generate a random uint32 value
+func (f *Faker) Uint32() uint32 { return uint32Func(f.ctx()) }
+
+func uint32Func(r fakerCtx) uint32 {
+	return uint32(randIntRange(r, 0, math.MaxInt32))
 }
 
 // Uint64 will generate a random uint64 value
-func Uint64() uint64 {
-	return uint64(rand.Int63n(math.MaxInt64))
+func Uint64() uint64 { return uint64Func(globalFaker.ctx()) }
+
+// Uint64 will generate a random uint64 value
+func (f *Faker) Uint64() uint64 { return uint64Func(f.ctx()) }
+
+func uint64Func(r fakerCtx) uint64 {
+	return uint64(r.Int63n(math.MaxInt64))
 }
 
 // Int8 will generate a random Int8 value
-func Int8() int8 {
-	return int8(randIntRange(math.MinInt8, math.MaxInt8))
+func Int8() int8 { return int8Func(globalFaker.ctx()) }
+
+// Int8 will generate a random Int8 value
+func (f *Faker) Int8() int8 { return int8Func(f.ctx()) }
+
+func int8Func(r fakerCtx) int8 {
+	return int8(randIntRange(r, math.MinInt8, math.MaxInt8))
 }
 
 // Int16 will generate a random int16 value
-func Int16() int16 {
-	return int16(randIntRange(math.MinInt16, math.MaxInt16))
+func Int16() int16 { return int16Func(globalFaker.ctx()) }
+
+// Int16 will generate a random int16 value
+func (f *Faker) Int16() int16 { return int16Func(f.ctx()) }
+
+func int16Func(r fakerCtx) int16 {
+	return int16(randIntRange(r, math.MinInt16, math.MaxInt16))
 }
 
 // Int32 will generate a random int32 value
-func Int32() int32 {
-	return int32(randIntRange(math.MinInt32, math.MaxInt32))
+func Int32() int32 { return int32Func(globalFaker.ctx()) }
+
+// Int32 will generate a random int32 value
+func (f *Faker) Int32() int32 { return int32Func(f.ctx()) }
+
+func int32Func(r fakerCtx) int32 {
+	return int32(randIntRange(r, math.MinInt32, math.MaxInt32))
 }
 
 // Int64 will generate a random int64 value
-func Int64() int64 {
-	return rand.Int63n(math.MaxInt64) + math.MinInt64
+func Int64() int64 { return int64Func(globalFaker.ctx()) }
+
+// Int64 will generate a random int64 value
+func (f *Faker) Int64() int64 { return int64Func(f.ctx()) }
+
+func int64Func(r fakerCtx) int64 {
+	return r.Int63n(math.MaxInt64) + math.MinInt64
 }
 
 // Float32 will generate a random float32 value
-func Float32() float32 {
-	return randFloat32Range(math.SmallestNonzeroFloat32, math.MaxFloat32)
+func Float32() float32 { return float32Func(globalFaker.ctx()) }
+
+// Float32 will generate a random float32 value
+func (f *Faker) Float32() float32 { return float32Func(f.ctx()) }
+
+func float32Func(r fakerCtx) float32 {
+	return randFloat32Range(r, math.SmallestNonzeroFloat32, math.MaxFloat32)
 }
 
 // Float32Range will generate a random float32 value between min and max
-func Float32Range(min, max float32) float32 {
-	return randFloat32Range(min, max)
+func Float32Range(min, max float32) float32 { return float32Range(globalFaker.ctx(), min, max) }
+
+// Float32Range will generate a random float32 value between min and max
+func (f *Faker) Float32Range(min, max float32) float32 { return float32Range(f.ctx(), min, max) }
+
+func float32Range(r fakerCtx, min, max float32) float32 {
+	return randFloat32Range(r, min, max)
 }
 
 // Float64 will generate a random float64 value
-func Float64() float64 {
-	return randFloat64Range(math.SmallestNonzeroFloat64, math.MaxFloat64)
+func Float64() float64 { return float64Func(globalFaker.ctx()) }
+
+// Float64 will generate a random float64 value
+func (f *Faker) Float64() float64 { return float64Func(f.ctx()) }
+
+func float64Func(r fakerCtx) float64 {
+	return randFloat64Range(r, math.SmallestNonzeroFloat64, math.MaxFloat64)
 }
 
 // Float64Range will generate a random float64 value between min and max
-func Float64Range(min, max float64) float64 {
-	return randFloat64Range(min, max)
+func Float64Range(min, max float64) float64 { return float64Range(globalFaker.ctx(), min, max) }
+
+// Float64Range will generate a random float64 value between min and max
+func (f *Faker) Float64Range(min, max float64) float64 { return float64Range(f.ctx(), min, max) }
+
+func float64Range(r fakerCtx, min, max float64) float64 {
+	return randFloat64Range(r, min, max)
 }
 
 // ShuffleInts will randomize a slice of ints
-func ShuffleInts(a []int) {
+func ShuffleInts(a []int) { shuffleInts(globalFaker.ctx(), a) }
+
+// ShuffleInts will randomize a slice of ints
+func (f *Faker) ShuffleInts(a []int) { shuffleInts(f.ctx(), a) }
+
+func shuffleInts(r fakerCtx, a []int) {
 	for i := range a {
-		j := rand.Intn(i + 1)
+		j := r.Intn(i + 1)
 		a[i], a[j] = a[j], a[i]
 	}
 }
 
 // RandomInt will take in a slice of int and return a randomly selected value
-func RandomInt(i []int) int {
+func RandomInt(i []int) int { return randomInt(globalFaker.ctx(), i) }
+
+// RandomInt will take in a slice of int and return a randomly selected value
+func (f *Faker) RandomInt(i []int) int { return randomInt(f.ctx(), i) }
+
+func randomInt(r fakerCtx, i []int) int {
 	size := len(i)
 	if size == 0 {
 		return 0
@@ -88,11 +205,16 @@ func RandomInt(i []int) int {
 	if size == 1 {
 		return i[0]
 	}
-	return i[rand.Intn(size)]
+	return i[r.Intn(size)]
 }
 
 // RandomUint will take in a slice of uint and return a randomly selected value
-func RandomUint(i []uint) uint {
+func RandomUint(i []uint) uint { return randomUint(globalFaker.ctx(), i) }
+
+// RandomUint will take in a slice of uint and return a randomly selected value
+func (f *Faker) RandomUint(i []uint) uint { return randomUint(f.ctx(), i) }
+
+func randomUint(r fakerCtx, i []uint) uint {
 	size := len(i)
 	if size == 0 {
 		return 0
@@ -100,7 +222,7 @@ func RandomUint(i []uint) uint {
 	if size == 1 {
 		return i[0]
 	}
-	return i[rand.Intn(size)]
+	return i[r.Intn(size)]
 }
 
 func addNumberLookup() {
@@ -114,7 +236,7 @@ func addNumberLookup() {
 			{Field: "min", Display: "Min", Type: "int", Default: "-2147483648", Description: "Minimum integer value"},
 			{Field: "max", Display: "Max", Type: "int", Default: "2147483647", Description: "Maximum integer value"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			min, err := info.GetInt(m, "min")
 			if err != nil {
 				return nil, err
@@ -129,7 +251,42 @@ func addNumberLookup() {
 				return nil, errors.New("Max integer must be larger than Min")
 			}
 
-			return Number(min, max), nil
+			return number(r, min, max), nil
+		},
+	})
+
+	AddFuncLookup("numberdist", Info{
+		Display:     "Number Distribution",
+		Category:    "number",
+		Description: "Random number between given range following a uniform, normal or exponential distribution",
+		Example:     "14866",
+		Output:      "int",
+		Params: []Param{
+			{Field: "min", Display: "Min", Type: "int", Default: "0", Description: "Minimum integer value"},
+			{Field: "max", Display: "Max", Type: "int", Default: "100", Description: "Maximum integer value"},
+			{
+				Field: "dist", Display: "Distribution", Type: "string", Default: "uniform",
+				Options:     []string{"uniform", "normal", "exponential"},
+				Description: "Distribution of the generated numbers",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetInt(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetInt(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			dist, err := info.GetString(m, "dist")
+			if err != nil {
+				return nil, err
+			}
+
+			return numberDist(r, min, max, dist)
 		},
 	})
 
@@ -139,8 +296,8 @@ func addNumberLookup() {
 		Description: "Random uint8 value",
 		Example:     "152",
 		Output:      "uint8",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Uint8(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return uint8Func(r), nil
 		},
 	})
 
@@ -150,8 +307,8 @@ func addNumberLookup() {
 		Description: "Random uint16 value",
 		Example:     "34968",
 		Output:      "uint16",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Uint16(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return uint16Func(r), nil
 		},
 	})
 
@@ -161,8 +318,8 @@ func addNumberLookup() {
 		Description: "Random uint32 value",
 		Example:     "1075055705",
 		Output:      "uint32",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Uint32(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return uint32Func(r), nil
 		},
 	})
 
@@ -172,8 +329,8 @@ func addNumberLookup() {
 		Description: "Random uint64 value",
 		Example:     "843730692693298265",
 		Output:      "uint64",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Uint64(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return uint64Func(r), nil
 		},
 	})
 
@@ -183,8 +340,8 @@ func addNumberLookup() {
 		Description: "Random int8 value",
 		Example:     "24",
 		Output:      "int8",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Int8(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return int8Func(r), nil
 		},
 	})
 
@@ -194,8 +351,8 @@ func addNumberLookup() {
 		Description: "Random int16 value",
 		Example:     "2200",
 		Output:      "int16",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Int16(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return int16Func(r), nil
 		},
 	})
 
@@ -205,8 +362,8 @@ func addNumberLookup() {
 		Description: "Random int32 value",
 		Example:     "-1072427943",
 		Output:      "int32",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Int32(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return int32Func(r), nil
 		},
 	})
 
@@ -216,8 +373,8 @@ func addNumberLookup() {
 		Description: "Random int64 value",
 		Example:     "-8379641344161477543",
 		Output:      "int64",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Int64(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return int64Func(r), nil
 		},
 	})
 
@@ -227,8 +384,8 @@ func addNumberLookup() {
 		Description: "Random float32 value",
 		Example:     "3.1128167e+37",
 		Output:      "float32",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Float32(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return float32Func(r), nil
 		},
 	})
 
@@ -242,7 +399,7 @@ func addNumberLookup() {
 			{Field: "min", Display: "Min", Type: "int", Description: "Minimum float32 value"},
 			{Field: "max", Display: "Max", Type: "int", Description: "Maximum float32 value"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			min, err := info.GetFloat32(m, "min")
 			if err != nil {
 				return nil, err
@@ -253,7 +410,7 @@ func addNumberLookup() {
 				return nil, err
 			}
 
-			return Float32Range(min, max), nil
+			return float32Range(r, min, max), nil
 		},
 	})
 
@@ -263,8 +420,8 @@ func addNumberLookup() {
 		Description: "Random float64 value",
 		Example:     "1.644484108270445e+307",
 		Output:      "float64",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Float64(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return float64Func(r), nil
 		},
 	})
 
@@ -278,7 +435,7 @@ func addNumberLookup() {
 			{Field: "min", Display: "Min", Type: "int", Description: "Minimum float64 value"},
 			{Field: "max", Display: "Max", Type: "int", Description: "Maximum float64 value"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			min, err := info.GetFloat64(m, "min")
 			if err != nil {
 				return nil, err
@@ -289,7 +446,7 @@ func addNumberLookup() {
 				return nil, err
 			}
 
-			return Float64Range(min, max), nil
+			return float64Range(r, min, max), nil
 		},
 	})
 
@@ -302,13 +459,13 @@ func addNumberLookup() {
 		Params: []Param{
 			{Field: "ints", Display: "Integers", Type: "[]int", Description: "Delimited separated integers"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			ints, err := info.GetIntArray(m, "ints")
 			if err != nil {
 				return nil, err
 			}
 
-			ShuffleInts(ints)
+			shuffleInts(r, ints)
 
 			return ints, nil
 		},
@@ -2,6 +2,7 @@ package gofakeit
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -17,6 +18,70 @@ func BenchmarkNumber(b *testing.B) {
 	}
 }
 
+func ExampleNumberDist() {
+	Seed(11)
+	value, err := NumberDist(1, 100, "normal")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 54
+}
+
+func TestNumberDist(t *testing.T) {
+	Seed(11)
+
+	tests := []struct {
+		dist string
+		mean float64
+	}{
+		{"uniform", 500},
+		{"normal", 500},
+		// Mean of 200 lowered by truncation at max and rounding down
+		{"exponential", 192.7},
+	}
+
+	for _, test := range tests {
+		iterations := 100000
+		var sum float64
+		for i := 0; i < iterations; i++ {
+			value, err := NumberDist(0, 1000, test.dist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value < 0 || value > 1000 {
+				t.Fatalf("%s value %d out of range", test.dist, value)
+			}
+			sum += float64(value)
+		}
+
+		mean := sum / float64(iterations)
+		if math.Abs(mean-test.mean) > 5 {
+			t.Errorf("%s expected mean near %.1f got %.1f", test.dist, test.mean, mean)
+		}
+	}
+}
+
+func TestNumberDistErrors(t *testing.T) {
+	if _, err := NumberDist(0, 10, "poisson"); err == nil {
+		t.Error("expected error for unknown distribution")
+	}
+	if _, err := NumberDist(10, 0, "normal"); err == nil {
+		t.Error("expected error for min larger than max")
+	}
+
+	value, err := NumberDist(5, 5, "exponential")
+	if err != nil || value != 5 {
+		t.Errorf("expected 5 got %d %v", value, err)
+	}
+}
+
+func BenchmarkNumberDist(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NumberDist(10, 999999, "normal")
+	}
+}
+
 func ExampleUint8() {
 	Seed(11)
 	fmt.Println(Uint8())
@@ -0,0 +1,438 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ParquetOptions defines values needed for parquet generation
+type ParquetOptions struct {
+	RowCount int     `json:"row_count" xml:"row_count"`
+	Fields   []Field `json:"fields" xml:"fields"`
+}
+
+// Parquet physical types
+const (
+	parquetBoolean   int32 = 0
+	parquetInt64     int32 = 2
+	parquetDouble    int32 = 5
+	parquetByteArray int32 = 6
+)
+
+// Thrift compact protocol types
+const (
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftStruct byte = 12
+)
+
+var parquetMagic = []byte("PAR1")
+
+type parquetColumn struct {
+	Name   string
+	Type   int32
+	Values []interface{}
+}
+
+// Parquet generates a parquet file with a column for each field.
+// Column types are inferred from the functions output, integers become INT64,
+// floats become DOUBLE, bools become BOOLEAN and everything else is stored as UTF8.
+// Data is written uncompressed with PLAIN encoding in a single row group
+func Parquet(po *ParquetOptions) ([]byte, error) { return parquet(globalFaker.ctx(), po) }
+
+// Parquet generates a parquet file with a column for each field.
+// Column types are inferred from the functions output, integers become INT64,
+// floats become DOUBLE, bools become BOOLEAN and everything else is stored as UTF8.
+// Data is written uncompressed with PLAIN encoding in a single row group
+func (f *Faker) Parquet(po *ParquetOptions) ([]byte, error) { return parquet(f.ctx(), po) }
+
+func parquet(r fakerCtx, po *ParquetOptions) ([]byte, error) {
+	// Check fields
+	if po.Fields == nil || len(po.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build parquet columns")
+	}
+
+	// Make sure you set a row count
+	if po.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	// Set up columns with their inferred types
+	columns := make([]*parquetColumn, len(po.Fields))
+	for i, field := range po.Fields {
+		columns[i] = &parquetColumn{Name: field.Name, Values: make([]interface{}, 0, po.RowCount)}
+		if field.Function == "autoincrement" {
+			columns[i].Type = parquetInt64
+			continue
+		}
+		if field.Function == "timeseries" {
+			columns[i].Type = parquetByteArray
+			continue
+		}
+
+		funcInfo := GetFuncLookup(field.Function)
+		if funcInfo == nil {
+			return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+		}
+		columns[i].Type = parquetType(funcInfo.Output)
+	}
+
+	// Generate values row by row
+	for i := 1; i <= po.RowCount; i++ {
+		for ii, field := range po.Fields {
+			if field.Function == "autoincrement" {
+				id, err := autoIncrement(&field, i)
+				if err != nil {
+					return nil, err
+				}
+				columns[ii].Values = append(columns[ii].Values, int64(id))
+				continue
+			}
+
+			if field.Function == "timeseries" {
+				ts, err := timeSeries(r, &field, i)
+				if err != nil {
+					return nil, err
+				}
+				columns[ii].Values = append(columns[ii].Values, ts)
+				continue
+			}
+
+			funcInfo := GetFuncLookup(field.Function)
+			value, err := fieldCall(r, &field, funcInfo)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err = parquetConvert(columns[ii].Type, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s field %s", field.Name, err)
+			}
+			columns[ii].Values = append(columns[ii].Values, value)
+		}
+	}
+
+	b := &bytes.Buffer{}
+	b.Write(parquetMagic)
+
+	// Write a single data page per column and keep track of where it landed
+	offsets := make([]int64, len(columns))
+	sizes := make([]int64, len(columns))
+	for i, col := range columns {
+		data := parquetPlain(col)
+
+		header := &thriftWriter{}
+		header.i32(1, 0) // page type DATA_PAGE
+		header.i32(2, int32(len(data)))
+		header.i32(3, int32(len(data)))
+		header.beginStruct(5) // data page header
+		header.i32(1, int32(len(col.Values)))
+		header.i32(2, 0) // encoding PLAIN
+		header.i32(3, 3) // definition level encoding RLE
+		header.i32(4, 3) // repetition level encoding RLE
+		header.endStruct()
+		header.stop()
+
+		offsets[i] = int64(b.Len())
+		sizes[i] = int64(header.buf.Len() + len(data))
+		b.Write(header.buf.Bytes())
+		b.Write(data)
+	}
+
+	// File metadata footer
+	var totalSize int64
+	for _, size := range sizes {
+		totalSize += size
+	}
+
+	meta := &thriftWriter{}
+	meta.i32(1, 1) // version
+	meta.listBegin(2, thriftStruct, len(columns)+1)
+	meta.elemBegin() // root schema element
+	meta.str(4, "schema")
+	meta.i32(5, int32(len(columns)))
+	meta.elemEnd()
+	for _, col := range columns {
+		meta.elemBegin()
+		meta.i32(1, col.Type)
+		meta.i32(3, 0) // repetition REQUIRED
+		meta.str(4, col.Name)
+		if col.Type == parquetByteArray {
+			meta.i32(6, 0) // converted type UTF8
+		}
+		meta.elemEnd()
+	}
+	meta.i64(3, int64(po.RowCount))
+	meta.listBegin(4, thriftStruct, 1)
+	meta.elemBegin() // row group
+	meta.listBegin(1, thriftStruct, len(columns))
+	for i, col := range columns {
+		meta.elemBegin() // column chunk
+		meta.i64(2, offsets[i])
+		meta.beginStruct(3) // column metadata
+		meta.i32(1, col.Type)
+		meta.listBegin(2, thriftI32, 1)
+		meta.varint(0) // encoding PLAIN
+		meta.listBegin(3, thriftBinary, 1)
+		meta.binary(col.Name)
+		meta.i32(4, 0) // codec UNCOMPRESSED
+		meta.i64(5, int64(len(col.Values)))
+		meta.i64(6, sizes[i])
+		meta.i64(7, sizes[i])
+		meta.i64(9, offsets[i])
+		meta.endStruct()
+		meta.elemEnd()
+	}
+	meta.i64(2, totalSize)
+	meta.i64(3, int64(po.RowCount))
+	meta.elemEnd()
+	meta.str(6, "gofakeit")
+	meta.stop()
+
+	b.Write(meta.buf.Bytes())
+	binary.Write(b, binary.LittleEndian, uint32(meta.buf.Len()))
+	b.Write(parquetMagic)
+
+	return b.Bytes(), nil
+}
+
+// parquetType maps a lookup output type to a parquet physical type
+func parquetType(output string) int32 {
+	switch output {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return parquetInt64
+	case "float32", "float64":
+		return parquetDouble
+	case "bool":
+		return parquetBoolean
+	}
+
+	return parquetByteArray
+}
+
+// parquetConvert converts a generated value into the go type stored for the column
+func parquetConvert(typ int32, value interface{}) (interface{}, error) {
+	v := reflect.ValueOf(value)
+
+	switch typ {
+	case parquetInt64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return v.Int(), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return int64(v.Uint()), nil
+		case reflect.Float32, reflect.Float64:
+			return int64(v.Float()), nil
+		case reflect.String:
+			i, err := strconv.ParseInt(v.String(), 10, 64)
+			if err == nil {
+				return i, nil
+			}
+		}
+		return nil, errors.New("value could not convert to int64")
+	case parquetDouble:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(v.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return float64(v.Uint()), nil
+		case reflect.Float32, reflect.Float64:
+			return v.Float(), nil
+		case reflect.String:
+			f, err := strconv.ParseFloat(v.String(), 64)
+			if err == nil {
+				return f, nil
+			}
+		}
+		return nil, errors.New("value could not convert to double")
+	case parquetBoolean:
+		switch v.Kind() {
+		case reflect.Bool:
+			return v.Bool(), nil
+		case reflect.String:
+			b, err := strconv.ParseBool(v.String())
+			if err == nil {
+				return b, nil
+			}
+		}
+		return nil, errors.New("value could not convert to boolean")
+	}
+
+	switch val := value.(type) {
+	case string:
+		return val, nil
+	case []byte:
+		return string(val), nil
+	}
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct, reflect.Ptr:
+		j, err := json.Marshal(value)
+		if err == nil {
+			return string(j), nil
+		}
+	}
+
+	return fmt.Sprintf("%v", value), nil
+}
+
+// parquetPlain encodes column values with PLAIN encoding
+func parquetPlain(col *parquetColumn) []byte {
+	b := &bytes.Buffer{}
+
+	switch col.Type {
+	case parquetBoolean:
+		// Bit packed, least significant bit first
+		packed := make([]byte, (len(col.Values)+7)/8)
+		for i, v := range col.Values {
+			if v.(bool) {
+				packed[i/8] |= 1 << uint(i%8)
+			}
+		}
+		b.Write(packed)
+	case parquetInt64:
+		for _, v := range col.Values {
+			binary.Write(b, binary.LittleEndian, v.(int64))
+		}
+	case parquetDouble:
+		for _, v := range col.Values {
+			binary.Write(b, binary.LittleEndian, math.Float64bits(v.(float64)))
+		}
+	case parquetByteArray:
+		for _, v := range col.Values {
+			binary.Write(b, binary.LittleEndian, uint32(len(v.(string))))
+			b.WriteString(v.(string))
+		}
+	}
+
+	return b.Bytes()
+}
+
+// thriftWriter writes the subset of the thrift compact protocol needed for parquet metadata
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID []int16
+	last   int16
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	t.buf.Write(tmp[:n])
+}
+
+func (t *thriftWriter) zigzag(v int64) {
+	t.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	delta := id - t.last
+	if delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.zigzag(int64(id))
+	}
+	t.last = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.zigzag(int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.zigzag(v)
+}
+
+func (t *thriftWriter) binary(s string) {
+	t.varint(uint64(len(s)))
+	t.buf.WriteString(s)
+}
+
+func (t *thriftWriter) str(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.binary(s)
+}
+
+func (t *thriftWriter) listBegin(id int16, elemType byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		t.buf.WriteByte(0xF0 | elemType)
+		t.varint(uint64(size))
+	}
+}
+
+func (t *thriftWriter) beginStruct(id int16) {
+	t.field(id, thriftStruct)
+	t.elemBegin()
+}
+
+func (t *thriftWriter) endStruct() { t.elemEnd() }
+
+// elemBegin starts a struct with its own field id sequence, used directly for list elements
+func (t *thriftWriter) elemBegin() {
+	t.lastID = append(t.lastID, t.last)
+	t.last = 0
+}
+
+func (t *thriftWriter) elemEnd() {
+	t.stop()
+	t.last = t.lastID[len(t.lastID)-1]
+	t.lastID = t.lastID[:len(t.lastID)-1]
+}
+
+func (t *thriftWriter) stop() { t.buf.WriteByte(0) }
+
+func addFileParquetLookup() {
+	AddFuncLookup("parquet", Info{
+		Display:     "Parquet",
+		Category:    "file",
+		Description: "Generates a parquet file with a column per field",
+		Example:     "PAR1...PAR1",
+		Output:      "[]byte",
+		Params: []Param{
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing column name and function to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			po := ParquetOptions{}
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			po.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				po.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &po.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			return parquet(r, &po)
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleParquet() {
+	Seed(11)
+
+	value, err := Parquet(&ParquetOptions{
+		RowCount: 3,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value[:4]), string(value[len(value)-4:]))
+
+	// Output: PAR1 PAR1
+}
+
+func TestParquet(t *testing.T) {
+	value, err := Parquet(&ParquetOptions{
+		RowCount: 25,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+			{Name: "active", Function: "bool"},
+			{Name: "age", Function: "number", Params: map[string][]string{"min": {"1"}, "max": {"99"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := readParquetMeta(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if numRows := meta[3].(int64); numRows != 25 {
+		t.Errorf("expected 25 rows, got %d", numRows)
+	}
+
+	schema := meta[2].([]interface{})
+	got := []string{}
+	types := []int64{}
+	for _, el := range schema[1:] {
+		got = append(got, el.(map[int16]interface{})[4].(string))
+		types = append(types, el.(map[int16]interface{})[1].(int64))
+	}
+	expected := []string{"id", "first_name", "price", "active", "age"}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("expected columns %v, got %v", expected, got)
+	}
+	expectedTypes := []int64{int64(parquetInt64), int64(parquetByteArray), int64(parquetDouble), int64(parquetBoolean), int64(parquetInt64)}
+	if fmt.Sprint(types) != fmt.Sprint(expectedTypes) {
+		t.Errorf("expected column types %v, got %v", expectedTypes, types)
+	}
+}
+
+func TestParquetLookup(t *testing.T) {
+	info := GetFuncLookup("parquet")
+	m := map[string][]string{
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+		},
+	}
+
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := readParquetMeta(value.([]byte))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if numRows := meta[3].(int64); numRows != 10 {
+		t.Errorf("expected 10 rows, got %d", numRows)
+	}
+}
+
+func TestParquetErrors(t *testing.T) {
+	_, err := Parquet(&ParquetOptions{RowCount: 1})
+	if err == nil {
+		t.Error("expected error for missing fields")
+	}
+
+	_, err = Parquet(&ParquetOptions{RowCount: 1, Fields: []Field{{Name: "x", Function: "notafunction"}}})
+	if err == nil {
+		t.Error("expected error for invalid function")
+	}
+}
+
+// readParquetMeta reads the file metadata footer of a parquet file
+// into maps of thrift field ids to values
+func readParquetMeta(b []byte) (map[int16]interface{}, error) {
+	if len(b) < 12 || string(b[:4]) != "PAR1" || string(b[len(b)-4:]) != "PAR1" {
+		return nil, errors.New("missing parquet magic")
+	}
+
+	size := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	if size > len(b)-12 {
+		return nil, errors.New("invalid footer size")
+	}
+
+	r := bytes.NewReader(b[len(b)-8-size : len(b)-8])
+	return readThriftStruct(r)
+}
+
+func readThriftStruct(r *bytes.Reader) (map[int16]interface{}, error) {
+	out := map[int16]interface{}{}
+	var last int16
+	for {
+		h, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if h == 0 {
+			return out, nil
+		}
+
+		typ := h & 0x0F
+		if delta := int16(h >> 4); delta != 0 {
+			last += delta
+		} else {
+			id, err := binary.ReadVarint(r)
+			if err != nil {
+				return nil, err
+			}
+			last = int16(id)
+		}
+
+		v, err := readThriftValue(r, typ)
+		if err != nil {
+			return nil, err
+		}
+		out[last] = v
+	}
+}
+
+func readThriftValue(r *bytes.Reader, typ byte) (interface{}, error) {
+	switch typ {
+	case 1:
+		return true, nil
+	case 2:
+		return false, nil
+	case thriftI32, thriftI64:
+		return binary.ReadVarint(r)
+	case thriftBinary:
+		l, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		s := make([]byte, l)
+		if _, err := r.Read(s); err != nil {
+			return nil, err
+		}
+		return string(s), nil
+	case thriftList:
+		h, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size := uint64(h >> 4)
+		if size == 15 {
+			size, err = binary.ReadUvarint(r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i], err = readThriftValue(r, h&0x0F)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case thriftStruct:
+		return readThriftStruct(r)
+	}
+
+	return nil, fmt.Errorf("unsupported thrift type %d", typ)
+}
@@ -1,8 +1,10 @@
 package gofakeit
 
 import (
+	"errors"
+	"fmt"
 	"math"
-	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,8 +19,13 @@ type CurrencyInfo struct {
 }
 
 // Currency will generate a struct with random currency information
-func Currency() *CurrencyInfo {
-	index := rand.Intn(len(data.Data["currency"]["short"]))
+func Currency() *CurrencyInfo { return currency(globalFaker.ctx()) }
+
+// Currency will generate a struct with random currency information
+func (f *Faker) Currency() *CurrencyInfo { return currency(f.ctx()) }
+
+func currency(r fakerCtx) *CurrencyInfo {
+	index := r.Intn(len(data.Data["currency"]["short"]))
 	return &CurrencyInfo{
 		Short: data.Data["currency"]["short"][index],
 		Long:  data.Data["currency"]["long"][index],
@@ -26,18 +33,100 @@ func Currency() *CurrencyInfo {
 }
 
 // CurrencyShort will generate a random short currency value
-func CurrencyShort() string {
-	return getRandValue([]string{"currency", "short"})
+func CurrencyShort() string { return currencyShort(globalFaker.ctx()) }
+
+// CurrencyShort will generate a random short currency value
+func (f *Faker) CurrencyShort() string { return currencyShort(f.ctx()) }
+
+func currencyShort(r fakerCtx) string {
+	return getRandValue(r, []string{"currency", "short"})
 }
 
 // CurrencyLong will generate a random long currency name
-func CurrencyLong() string {
-	return getRandValue([]string{"currency", "long"})
+func CurrencyLong() string { return currencyLong(globalFaker.ctx()) }
+
+// CurrencyLong will generate a random long currency name
+func (f *Faker) CurrencyLong() string { return currencyLong(f.ctx()) }
+
+func currencyLong(r fakerCtx) string {
+	return getRandValue(r, []string{"currency", "long"})
 }
 
 // Price will take in a min and max value and return a formatted price
-func Price(min, max float64) float64 {
-	return math.Floor(randFloat64Range(min, max)*100) / 100
+func Price(min, max float64) float64 { return price(globalFaker.ctx(), min, max) }
+
+// Price will take in a min and max value and return a formatted price
+func (f *Faker) Price(min, max float64) float64 { return price(f.ctx(), min, max) }
+
+func price(r fakerCtx, min, max float64) float64 {
+	return math.Floor(randFloat64Range(r, min, max)*100) / 100
+}
+
+// PriceCurrency will take in an ISO 4217 currency code with a min and max value and return
+// a price formatted with the currencies symbol, decimal places and digit grouping, like $1,234.56
+func PriceCurrency(currencyCode string, min, max float64) (string, error) {
+	return priceCurrency(globalFaker.ctx(), currencyCode, min, max)
+}
+
+// PriceCurrency will take in an ISO 4217 currency code with a min and max value and return
+// a price formatted with the currencies symbol, decimal places and digit grouping, like $1,234.56
+func (f *Faker) PriceCurrency(currencyCode string, min, max float64) (string, error) {
+	return priceCurrency(f.ctx(), currencyCode, min, max)
+}
+
+func priceCurrency(r fakerCtx, currencyCode string, min, max float64) (string, error) {
+	cf, ok := data.CurrencyFormats[strings.ToUpper(currencyCode)]
+	if !ok {
+		return "", errors.New("Unknown currency code " + currencyCode)
+	}
+	if min > max {
+		return "", errors.New("Min must be less than or equal to max")
+	}
+
+	// Drop digits past the currencies decimal places so the price stays within max
+	unit := math.Pow(10, float64(cf.Decimals))
+	amount := math.Floor(randFloat64Range(r, min, max)*unit) / unit
+	if amount < min {
+		amount = math.Ceil(min*unit) / unit
+	}
+
+	num := strconv.FormatFloat(math.Abs(amount), 'f', cf.Decimals, 64)
+	whole, decimals := num, ""
+	if i := strings.IndexByte(num, '.'); i >= 0 {
+		whole, decimals = num[:i], num[i+1:]
+	}
+
+	grouping := cf.Grouping
+	if len(grouping) == 0 {
+		grouping = []int{3}
+	}
+
+	// Split the whole number into groups from the right
+	var groups []string
+	for gi := 0; len(whole) > 0; gi++ {
+		size := grouping[len(grouping)-1]
+		if gi < len(grouping) {
+			size = grouping[gi]
+		}
+		if size >= len(whole) {
+			groups = append([]string{whole}, groups...)
+			break
+		}
+		groups = append([]string{whole[len(whole)-size:]}, groups...)
+		whole = whole[:len(whole)-size]
+	}
+
+	value := strings.Join(groups, cf.Group)
+	if decimals != "" {
+		value += cf.Decimal + decimals
+	}
+
+	value = strings.Replace(cf.Pattern, "#", value, 1)
+	if amount < 0 {
+		value = "-" + value
+	}
+
+	return value, nil
 }
 
 // CreditCardInfo is a struct containing credit variables
@@ -48,20 +137,45 @@ type CreditCardInfo struct {
 	Cvv    string `json:"cvv" xml:"cvv"`
 }
 
-// CreditCard will generate a struct full of credit card information
-func CreditCard() *CreditCardInfo {
-	ccType := RandomString(data.CreditCardTypes)
+// CreditCard will generate a struct full of credit card information. The number, which is luhn valid,
+// and the cvv match the type, American Express has a 15 digit number with a 4 digit cvv
+// and every other type a 16 digit number with a 3 digit cvv
+func CreditCard() *CreditCardInfo { return creditCard(globalFaker.ctx()) }
+
+// CreditCard will generate a struct full of credit card information. The number, which is luhn valid,
+// and the cvv match the type, American Express has a 15 digit number with a 4 digit cvv
+// and every other type a 16 digit number with a 3 digit cvv
+func (f *Faker) CreditCard() *CreditCardInfo { return creditCard(f.ctx()) }
+
+func creditCard(r fakerCtx) *CreditCardInfo {
+	ccType := randomString(r, data.CreditCardTypes)
+	cardInfo := data.CreditCards[ccType]
+
+	// 16 digits when the type allows it, which is every type but American Express
+	length := int(cardInfo.Lengths[0])
+	for _, l := range cardInfo.Lengths {
+		if l == 16 {
+			length = 16
+		}
+	}
+	prefix := strconv.FormatUint(uint64(randomUint(r, cardInfo.Patterns)), 10)
+
 	return &CreditCardInfo{
-		Type:   data.CreditCards[RandomString(data.CreditCardTypes)].Display,
-		Number: CreditCardNumber(&CreditCardOptions{Types: []string{ccType}}),
-		Exp:    CreditCardExp(),
-		Cvv:    Generate(strings.Repeat("#", int(data.CreditCards[RandomString(data.CreditCardTypes)].Code.Size))),
+		Type:   cardInfo.Display,
+		Number: luhnNumber(r, prefix, length),
+		Exp:    creditCardExp(r),
+		Cvv:    generate(r, strings.Repeat("#", int(cardInfo.Code.Size))),
 	}
 }
 
 // CreditCardType will generate a random credit card type string
-func CreditCardType() string {
-	return data.CreditCards[RandomString(data.CreditCardTypes)].Display
+func CreditCardType() string { return creditCardType(globalFaker.ctx()) }
+
+// CreditCardType will generate a random credit card type string
+func (f *Faker) CreditCardType() string { return creditCardType(f.ctx()) }
+
+func creditCardType(r fakerCtx) string {
+	return data.CreditCards[randomString(r, data.CreditCardTypes)].Display
 }
 
 // CreditCardOptions is the options for credit card number
@@ -72,34 +186,41 @@ type CreditCardOptions struct {
 }
 
 // CreditCardNumber will generate a random luhn credit card number
-func CreditCardNumber(cco *CreditCardOptions) string {
+func CreditCardNumber(cco *CreditCardOptions) string { return creditCardNumber(globalFaker.ctx(), cco) }
+
+// CreditCardNumber will generate a random luhn credit card number
+func (f *Faker) CreditCardNumber(cco *CreditCardOptions) string {
+	return creditCardNumber(f.ctx(), cco)
+}
+
+func creditCardNumber(r fakerCtx, cco *CreditCardOptions) string {
 	if cco == nil {
 		cco = &CreditCardOptions{}
 	}
 	if cco.Types == nil || len(cco.Types) == 0 {
 		cco.Types = data.CreditCardTypes
 	}
-	ccType := RandomString(cco.Types)
+	ccType := randomString(r, cco.Types)
 
 	// Get Card info
 	var cardInfo data.CreditCardInfo
 	if info, ok := data.CreditCards[ccType]; ok {
 		cardInfo = info
 	} else {
-		ccType = RandomString(data.CreditCardTypes)
+		ccType = randomString(r, data.CreditCardTypes)
 		cardInfo = data.CreditCards[ccType]
 	}
 
 	// Get length and pattern
-	length := RandomUint(cardInfo.Lengths)
+	length := randomUint(r, cardInfo.Lengths)
 	numStr := ""
 	if len(cco.Bins) >= 1 {
-		numStr = RandomString(cco.Bins)
+		numStr = randomString(r, cco.Bins)
 	} else {
-		numStr = strconv.FormatUint(uint64(RandomUint(cardInfo.Patterns)), 10)
+		numStr = strconv.FormatUint(uint64(randomUint(r, cardInfo.Patterns)), 10)
 	}
 	numStr += strings.Repeat("#", int(length)-len(numStr))
-	numStr = Numerify(numStr)
+	numStr = numerify(r, numStr)
 	ui, _ := strconv.ParseUint(numStr, 10, 64)
 
 	// Loop through until its a valid luhn
@@ -114,9 +235,117 @@ func CreditCardNumber(cco *CreditCardOptions) string {
 
 	// Add gaps to number
 	if cco.Gaps {
-		for i, spot := range cardInfo.Gaps {
-			numStr = numStr[:(int(spot)+i)] + " " + numStr[(int(spot)+i):]
+		numStr = creditCardGaps(numStr, cardInfo.Gaps)
+	}
+
+	return numStr
+}
+
+// creditCardTypeAliases maps common short names to their credit card type
+var creditCardTypeAliases = map[string]string{
+	"amex":   "american-express",
+	"diners": "diners-club",
+}
+
+// CreditCardNumberFiltered will generate a random luhn credit card number for one of the networks in Types,
+// such as visa, mastercard, amex or discover. The number always has a valid length and prefix for the network.
+// Gaps will space the number into the networks groups, otherwise only digits are returned
+func CreditCardNumberFiltered(cco *CreditCardOptions) (string, error) {
+	return creditCardNumberFiltered(globalFaker.ctx(), cco)
+}
+
+// CreditCardNumberFiltered will generate a random luhn credit card number for one of the networks in Types,
+// such as visa, mastercard, amex or discover. The number always has a valid length and prefix for the network.
+// Gaps will space the number into the networks groups, otherwise only digits are returned
+func (f *Faker) CreditCardNumberFiltered(cco *CreditCardOptions) (string, error) {
+	return creditCardNumberFiltered(f.ctx(), cco)
+}
+
+func creditCardNumberFiltered(r fakerCtx, cco *CreditCardOptions) (string, error) {
+	if cco == nil {
+		cco = &CreditCardOptions{}
+	}
+
+	// Validate types and resolve aliases
+	types := make([]string, 0, len(cco.Types))
+	for _, t := range cco.Types {
+		t = strings.ToLower(t)
+		if alias, ok := creditCardTypeAliases[t]; ok {
+			t = alias
+		}
+		if _, ok := data.CreditCards[t]; !ok {
+			return "", errors.New("Invalid credit card type " + t)
+		}
+		types = append(types, t)
+	}
+	if len(types) == 0 {
+		types = data.CreditCardTypes
+	}
+
+	// Bins are used as is for the luhn check digit so they have to be digits
+	for _, bin := range cco.Bins {
+		if bin == "" || strings.TrimLeft(bin, "0123456789") != "" {
+			return "", errors.New("Invalid bin " + bin + ", must only contain digits")
+		}
+	}
+
+	cardInfo := data.CreditCards[randomString(r, types)]
+	length := int(randomUint(r, cardInfo.Lengths))
+
+	prefix := ""
+	if len(cco.Bins) >= 1 {
+		prefix = randomString(r, cco.Bins)
+	} else {
+		prefix = strconv.FormatUint(uint64(randomUint(r, cardInfo.Patterns)), 10)
+	}
+	if len(prefix) >= length {
+		return "", errors.New("Bin " + prefix + " is too long for a card of length " + strconv.Itoa(length))
+	}
+
+	numStr := luhnNumber(r, prefix, length)
+
+	if cco.Gaps {
+		numStr = creditCardGaps(numStr, cardInfo.Gaps)
+	}
+
+	return numStr, nil
+}
+
+// luhnNumber fills prefix with random digits to length, finishing with the luhn check digit
+func luhnNumber(r fakerCtx, prefix string, length int) string {
+	b := []byte(prefix)
+	for len(b) < length-1 {
+		b = append(b, byte(randDigit(r)))
+	}
+
+	return string(append(b, luhnCheckDigit(string(b))))
+}
+
+// luhnCheckDigit returns the digit that makes s plus the digit a valid luhn number
+func luhnCheckDigit(s string) byte {
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		// Double every other digit starting with the one next to the check digit
+		if (len(s)-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return byte((10-sum%10)%10) + '0'
+}
+
+// creditCardGaps adds spaces into a credit card number at the gap positions
+func creditCardGaps(numStr string, gaps []uint) string {
+	for i, spot := range gaps {
+		if int(spot)+i >= len(numStr) {
+			break
 		}
+		numStr = numStr[:(int(spot)+i)] + " " + numStr[(int(spot)+i):]
 	}
 
 	return numStr
@@ -124,20 +353,32 @@ func CreditCardNumber(cco *CreditCardOptions) string {
 
 // CreditCardExp will generate a random credit card expiration date string
 // Exp date will always be a future date
-func CreditCardExp() string {
-	month := strconv.Itoa(randIntRange(1, 12))
+func CreditCardExp() string { return creditCardExp(globalFaker.ctx()) }
+
+// CreditCardExp will generate a random credit card expiration date string
+// Exp date will always be a future date
+func (f *Faker) CreditCardExp() string { return creditCardExp(f.ctx()) }
+
+func creditCardExp(r fakerCtx) string {
+	month := strconv.Itoa(randIntRange(r, 1, 12))
 	if len(month) == 1 {
 		month = "0" + month
 	}
 
 	var currentYear = time.Now().Year() - 2000
-	return month + "/" + strconv.Itoa(randIntRange(currentYear+1, currentYear+10))
+	return month + "/" + strconv.Itoa(randIntRange(r, currentYear+1, currentYear+10))
 }
 
 // CreditCardCvv will generate a random CVV number
 // Its a string because you could have 017 as an exp date
-func CreditCardCvv() string {
-	return Numerify("###")
+func CreditCardCvv() string { return creditCardCvv(globalFaker.ctx()) }
+
+// CreditCardCvv will generate a random CVV number
+// Its a string because you could have 017 as an exp date
+func (f *Faker) CreditCardCvv() string { return creditCardCvv(f.ctx()) }
+
+func creditCardCvv(r fakerCtx) string {
+	return numerify(r, "###")
 }
 
 // isLuhn check is used for checking if credit card is a valid luhn card
@@ -159,23 +400,159 @@ func isLuhn(s string) bool {
 }
 
 // AchRouting will generate a 9 digit routing number
-func AchRouting() string {
-	return Numerify("#########")
+func AchRouting() string { return achRouting(globalFaker.ctx()) }
+
+// AchRouting will generate a 9 digit routing number
+func (f *Faker) AchRouting() string { return achRouting(f.ctx()) }
+
+func achRouting(r fakerCtx) string {
+	return numerify(r, "#########")
 }
 
 // AchAccount will generate a 12 digit account number
-func AchAccount() string {
-	return Numerify("############")
+func AchAccount() string { return achAccount(globalFaker.ctx()) }
+
+// AchAccount will generate a 12 digit account number
+func (f *Faker) AchAccount() string { return achAccount(f.ctx()) }
+
+func achAccount(r fakerCtx) string {
+	return numerify(r, "############")
+}
+
+// BankAccountInfo is a struct containing a us bank account with its routing number
+type BankAccountInfo struct {
+	AccountNumber string `json:"account_number" xml:"account_number"`
+	RoutingNumber string `json:"routing_number" xml:"routing_number"`
+	AccountType   string `json:"account_type" xml:"account_type"`
 }
 
+// BankAccount will generate a struct with a checking or savings account number
+// and a 9 digit routing number that passes the ABA checksum
+func BankAccount() *BankAccountInfo { return bankAccount(globalFaker.ctx()) }
+
+// BankAccount will generate a struct with a checking or savings account number
+// and a 9 digit routing number that passes the ABA checksum
+func (f *Faker) BankAccount() *BankAccountInfo { return bankAccount(f.ctx()) }
+
+func bankAccount(r fakerCtx) *BankAccountInfo {
+	return &BankAccountInfo{
+		AccountNumber: achAccount(r),
+		RoutingNumber: abaRouting(r),
+		AccountType:   randomString(r, []string{"checking", "savings"}),
+	}
+}
+
+// abaRouting will generate a routing number starting with a federal reserve
+// district 01 to 12, or 21 to 32 for thrifts, and ending with its ABA check digit
+func abaRouting(r fakerCtx) string {
+	district := number(r, 1, 12)
+	if r.Intn(2) == 0 {
+		district += 20
+	}
+
+	routing := fmt.Sprintf("%02d", district) + numerify(r, "######")
+	return routing + string(abaCheckDigit(routing))
+}
+
+// abaCheckDigit returns the digit that makes the first 8 digits of a routing number
+// plus the digit have a 3, 7, 1 weighted sum that is a multiple of 10
+func abaCheckDigit(s string) byte {
+	weights := []int{3, 7, 1}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		sum += int(s[i]-'0') * weights[i%3]
+	}
+
+	return byte((10-sum%10)%10) + '0'
+}
+
+// IBAN will generate a random iban for the given country code with valid mod-97 check digits.
+// Pass an empty country code to pick from a random supported country
+func IBAN(countryCode string) (string, error) { return iban(globalFaker.ctx(), countryCode) }
+
+// IBAN will generate a random iban for the given country code with valid mod-97 check digits.
+// Pass an empty country code to pick from a random supported country
+func (f *Faker) IBAN(countryCode string) (string, error) { return iban(f.ctx(), countryCode) }
+
+func iban(r fakerCtx, countryCode string) (string, error) {
+	countryCode = strings.ToUpper(countryCode)
+	if countryCode == "" {
+		countries := make([]string, 0, len(data.IBANFormats))
+		for country := range data.IBANFormats {
+			countries = append(countries, country)
+		}
+		sort.Strings(countries)
+		countryCode = randomString(r, countries)
+	}
+
+	format, ok := data.IBANFormats[countryCode]
+	if !ok {
+		return "", errors.New("Unsupported iban country code " + countryCode)
+	}
+
+	// Build bban from the country structure
+	bban := make([]byte, len(format))
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case 'n':
+			bban[i] = byte(randDigit(r))
+		case 'a':
+			bban[i] = randCharacter(r, upperStr)[0]
+		case 'c':
+			bban[i] = randCharacter(r, upperStr+numericStr)[0]
+		}
+	}
+
+	return countryCode + ibanCheckDigits(countryCode, string(bban)) + string(bban), nil
+}
+
+// ibanCheckDigits computes the ISO 7064 mod-97 check digits for a country and bban
+func ibanCheckDigits(countryCode string, bban string) string {
+	return fmt.Sprintf("%02d", 98-ibanMod97(bban+countryCode+"00"))
+}
+
+// ibanMod97 converts letters to numbers, A = 10 through Z = 35, and returns the remainder of mod 97
+func ibanMod97(s string) int {
+	mod := 0
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			mod = (mod*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			mod = (mod*100 + int(c-'A') + 10) % 97
+		}
+	}
+
+	return mod
+}
+
+// isIBAN checks an iban string for a valid mod-97 checksum
+func isIBAN(s string) bool {
+	if len(s) < 5 {
+		return false
+	}
+
+	return ibanMod97(s[4:]+s[:4]) == 1
+}
+
+// BitcoinAddress will generate a random bitcoin address consisting of numbers, upper and lower characters
+func BitcoinAddress() string { return bitcoinAddress(globalFaker.ctx()) }
+
 // BitcoinAddress will generate a random bitcoin address consisting of numbers, upper and lower characters
-func BitcoinAddress() string {
-	return RandomString([]string{"1", "3"}) + Password(true, true, true, false, false, Number(25, 34))
+func (f *Faker) BitcoinAddress() string { return bitcoinAddress(f.ctx()) }
+
+func bitcoinAddress(r fakerCtx) string {
+	return randomString(r, []string{"1", "3"}) + password(r, true, true, true, false, false, number(r, 25, 34))
 }
 
 // BitcoinPrivateKey will generate a random bitcoin private key consisting of numbers, upper and lower characters
-func BitcoinPrivateKey() string {
-	return "5" + Password(true, true, true, false, false, 50)
+func BitcoinPrivateKey() string { return bitcoinPrivateKey(globalFaker.ctx()) }
+
+// BitcoinPrivateKey will generate a random bitcoin private key consisting of numbers, upper and lower characters
+func (f *Faker) BitcoinPrivateKey() string { return bitcoinPrivateKey(f.ctx()) }
+
+func bitcoinPrivateKey(r fakerCtx) string {
+	return "5" + password(r, true, true, true, false, false, 50)
 }
 
 func addPaymentLookup() {
@@ -185,8 +562,8 @@ func addPaymentLookup() {
 		Description: "Random currency data set",
 		Example:     `{short: "USD", long: "United States Dollar"}`,
 		Output:      "map[string]string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CurrencyShort(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return currencyShort(r), nil
 		},
 	})
 
@@ -196,8 +573,8 @@ func addPaymentLookup() {
 		Description: "Random currency abbreviated",
 		Example:     "USD",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CurrencyShort(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return currencyShort(r), nil
 		},
 	})
 
@@ -207,8 +584,8 @@ func addPaymentLookup() {
 		Description: "Random currency",
 		Example:     "United States Dollar",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CurrencyLong(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return currencyLong(r), nil
 		},
 	})
 
@@ -222,7 +599,38 @@ func addPaymentLookup() {
 			{Field: "min", Display: "Min", Type: "float", Default: "0", Description: "Minimum price value"},
 			{Field: "max", Display: "Max", Type: "float", Default: "1000", Description: "Maximum price value"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			min, err := info.GetFloat64(m, "min")
+			if err != nil {
+				return nil, err
+			}
+
+			max, err := info.GetFloat64(m, "max")
+			if err != nil {
+				return nil, err
+			}
+
+			return price(r, min, max), nil
+		},
+	})
+
+	AddFuncLookup("pricecurrency", Info{
+		Display:     "Price Currency",
+		Category:    "payment",
+		Description: "Random monitary price formatted for an ISO 4217 currency",
+		Example:     "USD => $1,234.56, JPY => ¥1,234",
+		Output:      "string",
+		Params: []Param{
+			{Field: "currency", Display: "Currency", Type: "string", Default: "USD", Description: "ISO 4217 currency code"},
+			{Field: "min", Display: "Min", Type: "float", Default: "0", Description: "Minimum price value"},
+			{Field: "max", Display: "Max", Type: "float", Default: "1000", Description: "Maximum price value"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			currency, err := info.GetString(m, "currency")
+			if err != nil {
+				return nil, err
+			}
+
 			min, err := info.GetFloat64(m, "min")
 			if err != nil {
 				return nil, err
@@ -233,7 +641,7 @@ func addPaymentLookup() {
 				return nil, err
 			}
 
-			return Price(min, max), nil
+			return priceCurrency(r, currency, min, max)
 		},
 	})
 
@@ -241,10 +649,10 @@ func addPaymentLookup() {
 		Display:     "Credit Card",
 		Category:    "payment",
 		Description: "Random credit card data set",
-		Example:     `{type: "Visa", number: "4136459948995369", exp: "01/21", cvv: "513"}`,
+		Example:     `{type: "Visa", number: "4136459948995367", exp: "06/27", cvv: "635"}`,
 		Output:      "map[string]interface",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CreditCard(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return creditCard(r), nil
 		},
 	})
 
@@ -254,8 +662,8 @@ func addPaymentLookup() {
 		Description: "Random credit card type",
 		Example:     "Visa",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CreditCardType(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return creditCardType(r), nil
 		},
 	})
 
@@ -264,17 +672,17 @@ func addPaymentLookup() {
 		Category:    "payment",
 		Description: "Random credit card number",
 		Example:     "4136459948995369",
-		Output:      "int",
+		Output:      "string",
 		Params: []Param{
 			{
 				Field: "types", Display: "Types", Type: "[]string", Default: "all",
 				Options:     []string{"visa", "mastercard", "american-express", "diners-club", "discover", "jcb", "unionpay", "maestro", "elo", "hiper", "hipercard"},
 				Description: "A select number of types you want to use when generating a credit card number",
 			},
-			{Field: "bins", Display: "Bins", Type: "[]string", Description: "Optional list of prepended bin numbers to pick from"},
+			{Field: "bins", Display: "Bins", Type: "[]string", Optional: true, Description: "Optional list of prepended bin numbers to pick from"},
 			{Field: "gaps", Display: "Gaps", Type: "bool", Default: "false", Description: "Whether or not to have gaps in number"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			types, err := info.GetStringArray(m, "types")
 			if err != nil {
 				return nil, err
@@ -299,7 +707,39 @@ func addPaymentLookup() {
 				options.Bins = bins
 			}
 
-			return CreditCardNumber(&options), nil
+			return creditCardNumber(r, &options), nil
+		},
+	})
+
+	AddFuncLookup("creditcardnumberfiltered", Info{
+		Display:     "Credit Card Number Filtered",
+		Category:    "payment",
+		Description: "Random luhn credit card number with a valid length and prefix for the chosen networks",
+		Example:     "4136 4599 4899 5369",
+		Output:      "string",
+		Params: []Param{
+			{
+				Field: "types", Display: "Types", Type: "[]string", Default: "all",
+				Options:     []string{"visa", "mastercard", "amex", "discover", "diners-club", "jcb", "unionpay", "maestro", "elo", "hiper", "hipercard"},
+				Description: "A select number of networks you want to use when generating a credit card number",
+			},
+			{Field: "gaps", Display: "Gaps", Type: "bool", Default: "false", Description: "Whether or not to space the number into groups"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			types, err := info.GetStringArray(m, "types")
+			if err != nil {
+				return nil, err
+			}
+			if len(types) == 1 && types[0] == "all" {
+				types = []string{}
+			}
+
+			gaps, err := info.GetBool(m, "gaps")
+			if err != nil {
+				return nil, err
+			}
+
+			return creditCardNumberFiltered(r, &CreditCardOptions{Types: types, Gaps: gaps})
 		},
 	})
 
@@ -309,8 +749,8 @@ func addPaymentLookup() {
 		Description: "Random credit card expiraction date",
 		Example:     "01/21",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CreditCardExp(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return creditCardExp(r), nil
 		},
 	})
 
@@ -320,8 +760,8 @@ func addPaymentLookup() {
 		Description: "Random credit card number",
 		Example:     "513",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return CreditCardCvv(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return creditCardCvv(r), nil
 		},
 	})
 
@@ -331,8 +771,8 @@ func addPaymentLookup() {
 		Description: "Random 9 digit ach routing number",
 		Example:     "513715684",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AchRouting(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return achRouting(r), nil
 		},
 	})
 
@@ -342,8 +782,45 @@ func addPaymentLookup() {
 		Description: "Random 12 digit ach account number",
 		Example:     "491527954328",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return AchAccount(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return achAccount(r), nil
+		},
+	})
+
+	AddFuncLookup("bankaccount", Info{
+		Display:     "Bank Account",
+		Category:    "payment",
+		Description: "Random bank account with a routing number that passes the ABA checksum",
+		Example:     `{account_number: "413645994899", routing_number: "229063530", account_type: "checking"}`,
+		Output:      "map[string]string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return bankAccount(r), nil
+		},
+	})
+
+	AddFuncLookup("iban", Info{
+		Display:     "IBAN",
+		Category:    "payment",
+		Description: "Random international bank account number with valid check digits",
+		Example:     "DE89370400440532013000",
+		Output:      "string",
+		Params: []Param{
+			{
+				Field: "country", Display: "Country", Type: "string", Default: "all",
+				Options:     []string{"all", "AT", "BE", "CH", "DE", "ES", "FR", "GB", "IT", "NL"},
+				Description: "Two letter country code of the iban to generate",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			country, err := info.GetString(m, "country")
+			if err != nil {
+				return nil, err
+			}
+			if country == "all" {
+				country = ""
+			}
+
+			return iban(r, country)
 		},
 	})
 
@@ -353,8 +830,8 @@ func addPaymentLookup() {
 		Description: "Random 26-35 characters representing a bitcoin address",
 		Example:     "1lWLbxojXq6BqWX7X60VkcDIvYA",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BitcoinAddress(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return bitcoinAddress(r), nil
 		},
 	})
 
@@ -364,8 +841,8 @@ func addPaymentLookup() {
 		Description: "Random 51 characters representing a bitcoin private key",
 		Example:     "5vrbXTADWJ6sQBSYd6lLkG97jljNc0X9VPBvbVqsIH9lWOLcoqg",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return BitcoinPrivateKey(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return bitcoinPrivateKey(r), nil
 		},
 	})
 }
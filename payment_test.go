@@ -2,7 +2,13 @@ package gofakeit
 
 import (
 	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 func ExampleCurrency() {
@@ -54,6 +60,79 @@ func BenchmarkPrice(b *testing.B) {
 	}
 }
 
+func ExamplePriceCurrency() {
+	Seed(11)
+	usd, _ := PriceCurrency("USD", 1000, 100000)
+	jpy, _ := PriceCurrency("JPY", 1000, 100000)
+	eur, _ := PriceCurrency("EUR", 1000, 100000)
+	fmt.Println(usd)
+	fmt.Println(jpy)
+	fmt.Println(eur)
+	// Output: $10,056.26
+	// ¥80,688
+	// 81.781,12 €
+}
+
+func TestPriceCurrency(t *testing.T) {
+	for _, test := range []struct {
+		code    string
+		pattern string
+	}{
+		{"USD", `^\$\d{1,3}(,\d{3})*\.\d{2}$`},
+		{"JPY", `^¥\d{1,3}(,\d{3})*$`},
+		{"EUR", `^\d{1,3}(\.\d{3})*,\d{2} €$`},
+		{"KWD", `^KD \d{1,3}(,\d{3})*\.\d{3}$`},
+		{"INR", `^₹(\d{1,2},)?(\d{2},)*\d{3}\.\d{2}$`},
+		{"chf", `^CHF \d{1,3}('\d{3})*\.\d{2}$`},
+	} {
+		re := regexp.MustCompile(test.pattern)
+		for i := 0; i < 200; i++ {
+			value, err := PriceCurrency(test.code, 100, 100000000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !re.MatchString(value) {
+				t.Fatalf("%s price %s does not match %s", test.code, value, test.pattern)
+			}
+		}
+	}
+}
+
+func TestPriceCurrencyRange(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		value, err := PriceCurrency("USD", 10.5, 11)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := strconv.ParseFloat(strings.TrimPrefix(value, "$"), 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f < 10.5 || f > 11 {
+			t.Fatalf("%s is outside of 10.5 to 11", value)
+		}
+	}
+
+	if value, _ := PriceCurrency("USD", -1500, -1500); value != "-$1,500.00" {
+		t.Errorf("expected -$1,500.00, got %s", value)
+	}
+}
+
+func TestPriceCurrencyErrors(t *testing.T) {
+	if _, err := PriceCurrency("XYZ", 0, 100); err == nil {
+		t.Error("expected error for unknown currency")
+	}
+	if _, err := PriceCurrency("USD", 100, 0); err == nil {
+		t.Error("expected error for min greater than max")
+	}
+}
+
+func BenchmarkPriceCurrency(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		PriceCurrency("USD", 0, 1000)
+	}
+}
+
 func ExampleCreditCard() {
 	Seed(11)
 	ccInfo := CreditCard()
@@ -62,10 +141,39 @@ func ExampleCreditCard() {
 	fmt.Println(ccInfo.Exp)
 	fmt.Println(ccInfo.Cvv)
 	// Output:
-	// UnionPay
-	// 4645994899536906358
-	// 11/21
-	// 259
+	// Visa
+	// 4136459948995367
+	// 06/27
+	// 635
+}
+
+func TestCreditCardConsistent(t *testing.T) {
+	f := New(rand.NewSource(11))
+	types := map[string]bool{}
+	for i := 0; i < 10000; i++ {
+		cc := f.CreditCard()
+		types[cc.Type] = true
+
+		numLen, cvvLen := 16, 3
+		if cc.Type == "American Express" {
+			numLen, cvvLen = 15, 4
+			if cc.Number[:2] != "34" && cc.Number[:2] != "37" {
+				t.Fatalf("expected american express number %s to start with 34 or 37", cc.Number)
+			}
+		}
+		if len(cc.Number) != numLen || !isLuhn(cc.Number) {
+			t.Fatalf("%s expected a luhn valid %d digit number got %s", cc.Type, numLen, cc.Number)
+		}
+		if len(cc.Cvv) != cvvLen {
+			t.Fatalf("%s expected a %d digit cvv got %s", cc.Type, cvvLen, cc.Cvv)
+		}
+		if _, err := strconv.Atoi(cc.Cvv); err != nil {
+			t.Fatalf("expected a numeric cvv got %s", cc.Cvv)
+		}
+	}
+	if !types["American Express"] || len(types) != len(data.CreditCardTypes) {
+		t.Errorf("expected every card type to be generated got %v", types)
+	}
 }
 
 func BenchmarkCreditCard(b *testing.B) {
@@ -113,7 +221,7 @@ func TestCreditCardNumberLookup(t *testing.T) {
 	m := map[string][]string{
 		"gaps": {"true"},
 	}
-	_, err := info.Call(&m, info)
+	_, err := info.call(globalFaker.ctx(), &m)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -127,6 +235,128 @@ func BenchmarkCreditCardNumber(b *testing.B) {
 	}
 }
 
+func ExampleCreditCardNumberFiltered() {
+	Seed(11)
+
+	value, err := CreditCardNumberFiltered(&CreditCardOptions{Types: []string{"amex"}, Gaps: true})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+	// Output: 3413 645994 89950
+}
+
+func TestCreditCardNumberFiltered(t *testing.T) {
+	prefixes := map[string]func(s string) bool{
+		"visa": func(s string) bool { return s[0] == '4' },
+		"mastercard": func(s string) bool {
+			two, _ := strconv.Atoi(s[:2])
+			four, _ := strconv.Atoi(s[:4])
+			return (two >= 51 && two <= 55) || (four >= 2221 && four <= 2720)
+		},
+		"amex": func(s string) bool { return s[:2] == "34" || s[:2] == "37" },
+		"discover": func(s string) bool {
+			three, _ := strconv.Atoi(s[:3])
+			return s[:4] == "6011" || (three >= 644 && three <= 649) || s[:2] == "65"
+		},
+	}
+	lengths := map[string][]int{
+		"visa":       {16, 18, 19},
+		"mastercard": {16},
+		"amex":       {15},
+		"discover":   {16, 19},
+	}
+
+	for network, hasPrefix := range prefixes {
+		for _, gaps := range []bool{false, true} {
+			for i := 0; i < 1000; i++ {
+				value, err := CreditCardNumberFiltered(&CreditCardOptions{Types: []string{network}, Gaps: gaps})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if gaps != strings.Contains(value, " ") {
+					t.Fatalf("%s number %s gaps should be %v", network, value, gaps)
+				}
+				digits := strings.Replace(value, " ", "", -1)
+
+				if !isLuhn(digits) {
+					t.Fatalf("%s number %s is not luhn", network, value)
+				}
+				if !hasPrefix(digits) {
+					t.Fatalf("%s number %s has the wrong prefix", network, value)
+				}
+				validLength := false
+				for _, l := range lengths[network] {
+					if len(digits) == l {
+						validLength = true
+					}
+				}
+				if !validLength {
+					t.Fatalf("%s number %s has invalid length %d", network, value, len(digits))
+				}
+			}
+		}
+	}
+}
+
+func TestCreditCardNumberFilteredAmexGaps(t *testing.T) {
+	value, err := CreditCardNumberFiltered(&CreditCardOptions{Types: []string{"amex"}, Gaps: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groups := strings.Split(value, " ")
+	if len(groups) != 3 || len(groups[0]) != 4 || len(groups[1]) != 6 || len(groups[2]) != 5 {
+		t.Errorf("expected amex 4-6-5 grouping, got %s", value)
+	}
+}
+
+func TestCreditCardNumberFilteredErrors(t *testing.T) {
+	_, err := CreditCardNumberFiltered(&CreditCardOptions{Types: []string{"notacard"}})
+	if err == nil {
+		t.Error("expected error for invalid type")
+	}
+
+	_, err = CreditCardNumberFiltered(&CreditCardOptions{Types: []string{"amex"}, Bins: []string{"3412345678901234"}})
+	if err == nil {
+		t.Error("expected error for bin longer than the card")
+	}
+
+	for _, bin := range []string{"4a", "41 11", "-411", "４１", ""} {
+		_, err = CreditCardNumberFiltered(&CreditCardOptions{Types: []string{"visa"}, Bins: []string{"4111", bin}})
+		if err == nil {
+			t.Errorf("expected error for non digit bin %q", bin)
+		}
+	}
+}
+
+func TestCreditCardNumberFilteredLookup(t *testing.T) {
+	info := GetFuncLookup("creditcardnumberfiltered")
+
+	m := map[string][]string{
+		"types": {"visa", "mastercard"},
+		"gaps":  {"true"},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isLuhn(strings.Replace(value.(string), " ", "", -1)) {
+		t.Errorf("%s is not luhn", value)
+	}
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	if d := luhnCheckDigit("411111111111111"); d != '1' {
+		t.Errorf("expected check digit 1 got %c", d)
+	}
+	if d := luhnCheckDigit("7992739871"); d != '3' {
+		t.Errorf("expected check digit 3 got %c", d)
+	}
+}
+
 func TestIsLuhn(t *testing.T) {
 	// Lets make sure this card is invalid
 	if isLuhn("867gfsd5309") {
@@ -175,6 +405,61 @@ func BenchmarkAchRouting(b *testing.B) {
 	}
 }
 
+func ExampleBankAccount() {
+	Seed(11)
+	account := BankAccount()
+	fmt.Println(account.AccountNumber)
+	fmt.Println(account.RoutingNumber)
+	fmt.Println(account.AccountType)
+	// Output: 413645994899
+	// 229063530
+	// checking
+}
+
+func TestBankAccount(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for i := 0; i < 1000; i++ {
+		account := f.BankAccount()
+		routing := account.RoutingNumber
+		if len(routing) != 9 {
+			t.Fatalf("expected a 9 digit routing number got %s", routing)
+		}
+
+		sum := 0
+		for ii, c := range routing {
+			if c < '0' || c > '9' {
+				t.Fatalf("expected only digits in routing number %s", routing)
+			}
+			sum += int(c-'0') * []int{3, 7, 1}[ii%3]
+		}
+		if sum%10 != 0 {
+			t.Fatalf("routing number %s fails the ABA checksum", routing)
+		}
+
+		district, _ := strconv.Atoi(routing[:2])
+		if (district < 1 || district > 12) && (district < 21 || district > 32) {
+			t.Fatalf("routing number %s has invalid district %02d", routing, district)
+		}
+		if len(account.AccountNumber) != 12 {
+			t.Fatalf("expected a 12 digit account number got %s", account.AccountNumber)
+		}
+		if account.AccountType != "checking" && account.AccountType != "savings" {
+			t.Fatalf("unexpected account type %s", account.AccountType)
+		}
+	}
+
+	// Known good routing number
+	if abaCheckDigit("02100002") != '1' {
+		t.Error("expected check digit 1 for 021000021")
+	}
+}
+
+func BenchmarkBankAccount(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		BankAccount()
+	}
+}
+
 func ExampleAchAccount() {
 	Seed(11)
 	fmt.Println(AchAccount())
@@ -187,6 +472,91 @@ func BenchmarkAchAccount(b *testing.B) {
 	}
 }
 
+func ExampleIBAN() {
+	Seed(11)
+	value, err := IBAN("DE")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: DE13013645994899536906
+}
+
+func TestIBAN(t *testing.T) {
+	lengths := map[string]int{"AT": 20, "BE": 16, "CH": 21, "DE": 22, "ES": 24, "FR": 27, "GB": 22, "IT": 27, "NL": 18}
+
+	for country, length := range lengths {
+		for i := 0; i < 1000; i++ {
+			value, err := IBAN(country)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(value) != length {
+				t.Fatalf("%s iban %s expected length %d got %d", country, value, length, len(value))
+			}
+			if value[:2] != country {
+				t.Fatalf("%s iban %s has wrong country prefix", country, value)
+			}
+			if !isIBAN(value) {
+				t.Fatalf("%s iban %s failed mod-97 check", country, value)
+			}
+		}
+	}
+}
+
+func TestIBANRandomCountry(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		value, err := IBAN("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isIBAN(value) {
+			t.Fatalf("iban %s failed mod-97 check", value)
+		}
+	}
+}
+
+func TestIBANUnsupported(t *testing.T) {
+	_, err := IBAN("ZZ")
+	if err == nil {
+		t.Error("expected error for unsupported country")
+	}
+}
+
+func TestIsIBAN(t *testing.T) {
+	// Known valid ibans from the country specs
+	for _, value := range []string{"DE89370400440532013000", "GB29NWBK60161331926819", "FR1420041010050500013M02606", "ES9121000418450200051332", "NL91ABNA0417164300"} {
+		if !isIBAN(value) {
+			t.Errorf("%s should be valid", value)
+		}
+	}
+
+	if isIBAN("DE88370400440532013000") {
+		t.Error("iban with wrong check digits should have failed")
+	}
+}
+
+func TestIBANLookup(t *testing.T) {
+	info := GetFuncLookup("iban")
+
+	m := map[string][]string{
+		"country": {"nl"},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !isIBAN(value.(string)) || len(value.(string)) != 18 {
+		t.Errorf("invalid nl iban %s", value)
+	}
+}
+
+func BenchmarkIBAN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		IBAN("DE")
+	}
+}
+
 func ExampleBitcoinAddress() {
 	Seed(11)
 	fmt.Println(BitcoinAddress())
@@ -1,15 +1,20 @@
 package gofakeit
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
+
+	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 // PersonInfo is a struct of person information
 type PersonInfo struct {
 	FirstName  string          `json:"first_name" xml:"first_name"`
 	LastName   string          `json:"last_name" xml:"last_name"`
+	Prefix     string          `json:"prefix" xml:"prefix"`
 	Gender     string          `json:"gender" xml:"gender"`
 	SSN        string          `json:"ssn" xml:"ssn"`
 	Image      string          `json:"image" xml:"image"`
@@ -19,54 +24,250 @@ type PersonInfo struct {
 	CreditCard *CreditCardInfo `json:"credit_card" xml:"credit_card"`
 }
 
-// Person will generate a struct with person information
-func Person() *PersonInfo {
-	return &PersonInfo{
-		FirstName:  FirstName(),
-		LastName:   LastName(),
-		Gender:     Gender(),
-		SSN:        SSN(),
+// Person will generate a struct with person information, the prefix matches the gender.
+// The first name is picked independently so seeded output stays the same, use PersonGender
+// for a first name that matches the gender as well
+func Person() *PersonInfo { return person(globalFaker.ctx()) }
+
+// Person will generate a struct with person information, the prefix matches the gender.
+// The first name is picked independently so seeded output stays the same, use PersonGender
+// for a first name that matches the gender as well
+func (f *Faker) Person() *PersonInfo { return person(f.ctx()) }
+
+func person(r fakerCtx) *PersonInfo {
+	p := &PersonInfo{
+		FirstName:  firstName(r),
+		LastName:   lastName(r),
+		Gender:     gender(r),
+		SSN:        ssn(r),
 		Image:      ImageURL(300, 300) + "/people",
-		Job:        Job(),
-		Address:    Address(),
-		Contact:    Contact(),
-		CreditCard: CreditCard(),
+		Job:        job(r),
+		Address:    address(r),
+		Contact:    contact(r),
+		CreditCard: creditCard(r),
 	}
+
+	// Prefix is picked last so seeded output of the other fields stays the same
+	p.Prefix = getRandValue(r, []string{"person", p.Gender + "_prefix"})
+
+	return p
+}
+
+// PersonGender will generate a struct with person information where the prefix and first name match the gender.
+// Gender can be male, female or random
+func PersonGender(genderStr string) (*PersonInfo, error) {
+	return personGender(globalFaker.ctx(), genderStr)
+}
+
+// PersonGender will generate a struct with person information where the prefix and first name match the gender.
+// Gender can be male, female or random
+func (f *Faker) PersonGender(genderStr string) (*PersonInfo, error) {
+	return personGender(f.ctx(), genderStr)
 }
 
+func personGender(r fakerCtx, genderStr string) (*PersonInfo, error) {
+	genderStr = strings.ToLower(genderStr)
+	switch genderStr {
+	case "", "random":
+		genderStr = gender(r)
+	case "male", "female":
+	default:
+		return nil, errors.New("Invalid gender, must be male, female or random")
+	}
+
+	// First names set with SetData have no gender so they replace both gendered lists
+	first := []string{"person", genderStr + "_first"}
+	if _, ok := dataOverride(r, []string{"person", "first"}); ok {
+		first = []string{"person", "first"}
+	}
+
+	return &PersonInfo{
+		FirstName:  getRandValue(r, first),
+		LastName:   lastName(r),
+		Prefix:     getRandValue(r, []string{"person", genderStr + "_prefix"}),
+		Gender:     genderStr,
+		SSN:        ssn(r),
+		Image:      ImageURL(300, 300) + "/people",
+		Job:        job(r),
+		Address:    address(r),
+		Contact:    contact(r),
+		CreditCard: creditCard(r),
+	}, nil
+}
+
+// Name will generate a random First and Last Name
+func Name() string { return name(globalFaker.ctx()) }
+
 // Name will generate a random First and Last Name
-func Name() string {
-	return getRandValue([]string{"person", "first"}) + " " + getRandValue([]string{"person", "last"})
+func (f *Faker) Name() string { return name(f.ctx()) }
+
+func name(r fakerCtx) string {
+	return getRandValue(r, []string{"person", "first"}) + " " + getRandValue(r, []string{"person", "last"})
 }
 
 // FirstName will generate a random first name
-func FirstName() string {
-	return getRandValue([]string{"person", "first"})
+func FirstName() string { return firstName(globalFaker.ctx()) }
+
+// FirstName will generate a random first name
+func (f *Faker) FirstName() string { return firstName(f.ctx()) }
+
+func firstName(r fakerCtx) string {
+	return getRandValue(r, []string{"person", "first"})
 }
 
 // LastName will generate a random last name
-func LastName() string {
-	return getRandValue([]string{"person", "last"})
+func LastName() string { return lastName(globalFaker.ctx()) }
+
+// LastName will generate a random last name
+func (f *Faker) LastName() string { return lastName(f.ctx()) }
+
+func lastName(r fakerCtx) string {
+	return getRandValue(r, []string{"person", "last"})
+}
+
+// NameLocale will generate a random first and last name for the given locale (en, fr, de, es, ja).
+// Japanese names are ordered family name first
+func NameLocale(locale string) (string, error) { return nameLocale(globalFaker.ctx(), locale) }
+
+// NameLocale will generate a random first and last name for the given locale (en, fr, de, es, ja).
+// Japanese names are ordered family name first
+func (f *Faker) NameLocale(locale string) (string, error) { return nameLocale(f.ctx(), locale) }
+
+func nameLocale(r fakerCtx, locale string) (string, error) {
+	first, err := personLocaleValue(r, locale, "first")
+	if err != nil {
+		return "", err
+	}
+	last, err := personLocaleValue(r, locale, "last")
+	if err != nil {
+		return "", err
+	}
+
+	if strings.ToLower(locale) == "ja" {
+		return last + " " + first, nil
+	}
+
+	return first + " " + last, nil
 }
 
+// FirstNameLocale will generate a random first name for the given locale (en, fr, de, es, ja)
+func FirstNameLocale(locale string) (string, error) {
+	return firstNameLocale(globalFaker.ctx(), locale)
+}
+
+// FirstNameLocale will generate a random first name for the given locale (en, fr, de, es, ja)
+func (f *Faker) FirstNameLocale(locale string) (string, error) {
+	return firstNameLocale(f.ctx(), locale)
+}
+
+func firstNameLocale(r fakerCtx, locale string) (string, error) {
+	return personLocaleValue(r, locale, "first")
+}
+
+// LastNameLocale will generate a random last name for the given locale (en, fr, de, es, ja)
+func LastNameLocale(locale string) (string, error) { return lastNameLocale(globalFaker.ctx(), locale) }
+
+// LastNameLocale will generate a random last name for the given locale (en, fr, de, es, ja)
+func (f *Faker) LastNameLocale(locale string) (string, error) { return lastNameLocale(f.ctx(), locale) }
+
+func lastNameLocale(r fakerCtx, locale string) (string, error) {
+	return personLocaleValue(r, locale, "last")
+}
+
+// personLocaleValue picks a random value from a locales person data
+func personLocaleValue(r fakerCtx, locale string, category string) (string, error) {
+	names, ok := data.PersonLocales[strings.ToLower(locale)]
+	if !ok {
+		return "", errors.New("Unsupported locale " + locale)
+	}
+
+	// Names set with SetData replace every locale
+	if values, ok := dataOverride(r, []string{"person", category}); ok {
+		return values[r.Intn(len(values))], nil
+	}
+
+	return names[category][r.Intn(len(names[category]))], nil
+}
+
+// NamePrefix will generate a random name prefix
+func NamePrefix() string { return namePrefix(globalFaker.ctx()) }
+
 // NamePrefix will generate a random name prefix
-func NamePrefix() string {
-	return getRandValue([]string{"person", "prefix"})
+func (f *Faker) NamePrefix() string { return namePrefix(f.ctx()) }
+
+func namePrefix(r fakerCtx) string {
+	return getRandValue(r, []string{"person", "prefix"})
 }
 
 // NameSuffix will generate a random name suffix
-func NameSuffix() string {
-	return getRandValue([]string{"person", "suffix"})
+func NameSuffix() string { return nameSuffix(globalFaker.ctx()) }
+
+// NameSuffix will generate a random name suffix
+func (f *Faker) NameSuffix() string { return nameSuffix(f.ctx()) }
+
+func nameSuffix(r fakerCtx) string {
+	return getRandValue(r, []string{"person", "suffix"})
 }
 
 // SSN will generate a random Social Security Number
-func SSN() string {
-	return strconv.Itoa(randIntRange(100000000, 999999999))
+func SSN() string { return ssn(globalFaker.ctx()) }
+
+// SSN will generate a random Social Security Number
+func (f *Faker) SSN() string { return ssn(f.ctx()) }
+
+func ssn(r fakerCtx) string {
+	return strconv.Itoa(randIntRange(r, 100000000, 999999999))
+}
+
+// SSNValid will generate a random Social Security Number following the issuance rules,
+// the area is never 000, 666 or 900-999 and the group and serial are never all zeros.
+// Format: xxx-xx-xxxx
+func SSNValid() string { return ssnValid(globalFaker.ctx()) }
+
+// SSNValid will generate a random Social Security Number following the issuance rules,
+// the area is never 000, 666 or 900-999 and the group and serial are never all zeros.
+// Format: xxx-xx-xxxx
+func (f *Faker) SSNValid() string { return ssnValid(f.ctx()) }
+
+func ssnValid(r fakerCtx) string {
+	// 001-899 without 666
+	area := randIntRange(r, 1, 898)
+	if area >= 666 {
+		area++
+	}
+
+	return fmt.Sprintf("%03d-%02d-%04d", area, randIntRange(r, 1, 99), randIntRange(r, 1, 9999))
+}
+
+// itinGroups are the group numbers an ITIN is issued with
+var itinGroups = []int{
+	50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65,
+	70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88,
+	90, 91, 92, 94, 95, 96, 97, 98, 99,
 }
 
+// ITIN will generate a random Individual Taxpayer Identification Number,
+// which starts with 9 and has a group of 50-65, 70-88, 90-92 or 94-99.
+// Format: 9xx-xx-xxxx
+func ITIN() string { return itin(globalFaker.ctx()) }
+
+// ITIN will generate a random Individual Taxpayer Identification Number,
+// which starts with 9 and has a group of 50-65, 70-88, 90-92 or 94-99.
+// Format: 9xx-xx-xxxx
+func (f *Faker) ITIN() string { return itin(f.ctx()) }
+
+func itin(r fakerCtx) string {
+	return fmt.Sprintf("9%02d-%02d-%04d", r.Intn(100), itinGroups[r.Intn(len(itinGroups))], r.Intn(10000))
+}
+
+// Gender will generate a random gender string
+func Gender() string { return gender(globalFaker.ctx()) }
+
 // Gender will generate a random gender string
-func Gender() string {
-	if Bool() {
+func (f *Faker) Gender() string { return gender(f.ctx()) }
+
+func gender(r fakerCtx) string {
+	if boolFunc(r) {
 		return "male"
 	}
 
@@ -80,38 +281,212 @@ type ContactInfo struct {
 }
 
 // Contact will generate a struct with information randomly populated contact information
-func Contact() *ContactInfo {
+func Contact() *ContactInfo { return contact(globalFaker.ctx()) }
+
+// Contact will generate a struct with information randomly populated contact information
+func (f *Faker) Contact() *ContactInfo { return contact(f.ctx()) }
+
+func contact(r fakerCtx) *ContactInfo {
 	return &ContactInfo{
-		Phone: Phone(),
-		Email: Email(),
+		Phone: phone(r),
+		Email: email(r),
 	}
 }
 
 // Phone will generate a random phone number string
-func Phone() string {
-	return replaceWithNumbers("##########")
+func Phone() string { return phone(globalFaker.ctx()) }
+
+// Phone will generate a random phone number string
+func (f *Faker) Phone() string { return phone(f.ctx()) }
+
+func phone(r fakerCtx) string {
+	return replaceWithNumbers(r, "##########")
 }
 
 // PhoneFormatted will generate a random phone number string
-func PhoneFormatted() string {
-	return replaceWithNumbers(getRandValue([]string{"person", "phone"}))
+func PhoneFormatted() string { return phoneFormatted(globalFaker.ctx()) }
+
+// PhoneFormatted will generate a random phone number string
+func (f *Faker) PhoneFormatted() string { return phoneFormatted(f.ctx()) }
+
+func phoneFormatted(r fakerCtx) string {
+	return replaceWithNumbers(r, getRandValue(r, []string{"person", "phone"}))
+}
+
+// PhoneLocale will generate a random phone number in the national format of the country code (US, GB, DE, IN, BR)
+func PhoneLocale(countryCode string) (string, error) {
+	return phoneLocale(globalFaker.ctx(), countryCode, false)
+}
+
+// PhoneLocale will generate a random phone number in the national format of the country code (US, GB, DE, IN, BR)
+func (f *Faker) PhoneLocale(countryCode string) (string, error) {
+	return phoneLocale(f.ctx(), countryCode, false)
+}
+
+// PhoneLocaleInternational will generate a random phone number for the country code (US, GB, DE, IN, BR)
+// prefixed with its +CC dialing code
+func PhoneLocaleInternational(countryCode string) (string, error) {
+	return phoneLocale(globalFaker.ctx(), countryCode, true)
+}
+
+// PhoneLocaleInternational will generate a random phone number for the country code (US, GB, DE, IN, BR)
+// prefixed with its +CC dialing code
+func (f *Faker) PhoneLocaleInternational(countryCode string) (string, error) {
+	return phoneLocale(f.ctx(), countryCode, true)
+}
+
+func phoneLocale(r fakerCtx, countryCode string, international bool) (string, error) {
+	format, ok := data.PhoneFormats[strings.ToUpper(countryCode)]
+	if !ok {
+		return "", errors.New("Unsupported phone country code " + countryCode)
+	}
+
+	b := []byte(randomString(r, format.Formats))
+	for i := 0; i < len(b); i++ {
+		switch b[i] {
+		case '#':
+			b[i] = byte(randDigit(r))
+		case 'N':
+			b[i] = byte(randIntRange(r, 2, 9)) + '0'
+		}
+	}
+	num := string(b)
+
+	if !international {
+		return num, nil
+	}
+
+	// Drop the trunk prefix and area code parentheses when dialing from abroad
+	num = strings.TrimPrefix(num, "0")
+	num = strings.Replace(strings.Replace(num, "(", "", 1), ")", "", 1)
+
+	return "+" + format.DialCode + " " + num, nil
+}
+
+// PhoneE164 will generate a random phone number for the country code (US, GB, DE, IN, BR)
+// in E.164 format, the +CC dialing code followed by only the digits of the number
+func PhoneE164(countryCode string) (string, error) { return phoneE164(globalFaker.ctx(), countryCode) }
+
+// PhoneE164 will generate a random phone number for the country code (US, GB, DE, IN, BR)
+// in E.164 format, the +CC dialing code followed by only the digits of the number
+func (f *Faker) PhoneE164(countryCode string) (string, error) {
+	return phoneE164(f.ctx(), countryCode)
+}
+
+func phoneE164(r fakerCtx, countryCode string) (string, error) {
+	num, err := phoneLocale(r, countryCode, true)
+	if err != nil {
+		return "", err
+	}
+
+	b := []byte{'+'}
+	for i := 0; i < len(num); i++ {
+		if num[i] >= '0' && num[i] <= '9' {
+			b = append(b, num[i])
+		}
+	}
+	return string(b), nil
 }
 
 // Email will generate a random email string
-func Email() string {
-	email := getRandValue([]string{"person", "first"}) + getRandValue([]string{"person", "last"})
+func Email() string { return email(globalFaker.ctx()) }
+
+// Email will generate a random email string
+func (f *Faker) Email() string { return email(f.ctx()) }
+
+func email(r fakerCtx) string {
+	email := getRandValue(r, []string{"person", "first"}) + getRandValue(r, []string{"person", "last"})
 	email += "@"
-	email += getRandValue([]string{"person", "last"}) + "." + getRandValue([]string{"internet", "domain_suffix"})
+	email += getRandValue(r, []string{"person", "last"}) + "." + getRandValue(r, []string{"internet", "domain_suffix"})
 
 	return strings.ToLower(email)
 }
 
+// EmailOptions defines values needed for email generation with weighted domains
+type EmailOptions struct {
+	Domains   []string  `json:"domains" xml:"domains"`       // domains to pick from, a random domain is used when empty
+	Weights   []float32 `json:"weights" xml:"weights"`       // one weight per domain, equally likely when empty
+	UseName   bool      `json:"use_name" xml:"use_name"`     // base the local part on a name, like markus.moen
+	FirstName string    `json:"first_name" xml:"first_name"` // name to use with UseName, generated when empty
+	LastName  string    `json:"last_name" xml:"last_name"`
+}
+
+// EmailProvider will generate a random email with a domain picked from the options by weight.
+// With UseName the local part is built from a first and last name, otherwise it is a username
+func EmailProvider(eo *EmailOptions) (string, error) { return emailProvider(globalFaker.ctx(), eo) }
+
+// EmailProvider will generate a random email with a domain picked from the options by weight.
+// With UseName the local part is built from a first and last name, otherwise it is a username
+func (f *Faker) EmailProvider(eo *EmailOptions) (string, error) { return emailProvider(f.ctx(), eo) }
+
+func emailProvider(r fakerCtx, eo *EmailOptions) (string, error) {
+	if eo == nil {
+		eo = &EmailOptions{}
+	}
+
+	var domain string
+	switch {
+	case len(eo.Domains) == 0:
+		if len(eo.Weights) > 0 {
+			return "", errors.New("Weights need domains to apply to")
+		}
+		domain = domainName(r)
+	case len(eo.Weights) == 0:
+		domain = randomString(r, eo.Domains)
+	default:
+		domains := make([]interface{}, len(eo.Domains))
+		for i, d := range eo.Domains {
+			domains[i] = d
+		}
+		d, err := weighted(r, domains, eo.Weights)
+		if err != nil {
+			return "", err
+		}
+		domain = d.(string)
+	}
+
+	var local string
+	if eo.UseName {
+		first, last := eo.FirstName, eo.LastName
+		if first == "" {
+			first = firstName(r)
+		}
+		if last == "" {
+			last = lastName(r)
+		}
+		local = emailLocal(first) + "." + emailLocal(last)
+	} else {
+		local = emailLocal(username(r))
+	}
+
+	return local + "@" + strings.ToLower(domain), nil
+}
+
+// emailLocal lowercases s and drops anything other than letters and numbers
+func emailLocal(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= 'a' && c <= 'z' || c >= '0' && c <= '9' {
+			return c
+		}
+		return -1
+	}, strings.ToLower(s))
+}
+
 // Teams takes in an array of people and team names and randomly places the people into teams as evenly as possible
 func Teams(people []string, teams []string) map[string][]string {
+	return teamsFunc(globalFaker.ctx(), people, teams)
+}
+
+// Teams takes in an array of people and team names and randomly places the people into teams as evenly as possible
+func (f *Faker) Teams(people []string, teams []string) map[string][]string {
+	return teamsFunc(f.ctx(), people, teams)
+}
+
+func teamsFunc(r fakerCtx, people []string, teams []string) map[string][]string {
 
 	// Shuffle the people if more than 1
 	if len(people) > 1 {
-		ShuffleStrings(people)
+		shuffleStrings(r, people)
 	}
 
 	peopleIndex := 0
@@ -138,6 +513,7 @@ func addPersonLookup() {
 		Example: `{
 			first_name: "Markus",
 			last_name: "Moen",
+			prefix: "Mr.",
 			gender: "male",
 			ssn: "420776036",
 			image: "https://picsum.photos/300/300/people",
@@ -169,8 +545,23 @@ func addPersonLookup() {
 			}
 		}`,
 		Output: "map[string]interface",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Person(), nil
+		Params: []Param{
+			{
+				Field: "gender", Display: "Gender", Type: "string", Default: "none",
+				Options:     []string{"none", "random", "male", "female"},
+				Description: "Gender to keep the prefix and first name consistent with, none only matches the prefix to a random gender",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			genderStr, err := info.GetString(m, "gender")
+			if err != nil {
+				return nil, err
+			}
+			if genderStr == "none" {
+				return person(r), nil
+			}
+
+			return personGender(r, genderStr)
 		},
 	})
 
@@ -180,8 +571,20 @@ func addPersonLookup() {
 		Description: "Random name",
 		Example:     "Markus Moen",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Name(), nil
+		Params: []Param{
+			{
+				Field: "locale", Display: "Locale", Type: "string", Default: "en",
+				Options:     []string{"en", "fr", "de", "es", "ja"},
+				Description: "Locale of the name data",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			locale, err := info.GetString(m, "locale")
+			if err != nil {
+				return nil, err
+			}
+
+			return nameLocale(r, locale)
 		},
 	})
 
@@ -191,8 +594,8 @@ func addPersonLookup() {
 		Description: "Random name prefix",
 		Example:     "Mr.",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return NamePrefix(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return namePrefix(r), nil
 		},
 	})
 
@@ -202,8 +605,8 @@ func addPersonLookup() {
 		Description: "Random name suffix",
 		Example:     "Jr.",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return NameSuffix(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return nameSuffix(r), nil
 		},
 	})
 
@@ -213,8 +616,20 @@ func addPersonLookup() {
 		Description: "Random first name",
 		Example:     "Markus",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return FirstName(), nil
+		Params: []Param{
+			{
+				Field: "locale", Display: "Locale", Type: "string", Default: "en",
+				Options:     []string{"en", "fr", "de", "es", "ja"},
+				Description: "Locale of the name data",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			locale, err := info.GetString(m, "locale")
+			if err != nil {
+				return nil, err
+			}
+
+			return firstNameLocale(r, locale)
 		},
 	})
 
@@ -224,8 +639,20 @@ func addPersonLookup() {
 		Description: "Random last name",
 		Example:     "Daniel",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return LastName(), nil
+		Params: []Param{
+			{
+				Field: "locale", Display: "Locale", Type: "string", Default: "en",
+				Options:     []string{"en", "fr", "de", "es", "ja"},
+				Description: "Locale of the name data",
+			},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			locale, err := info.GetString(m, "locale")
+			if err != nil {
+				return nil, err
+			}
+
+			return lastNameLocale(r, locale)
 		},
 	})
 
@@ -235,8 +662,8 @@ func addPersonLookup() {
 		Description: "Random gender",
 		Example:     "male",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Gender(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return gender(r), nil
 		},
 	})
 
@@ -246,8 +673,30 @@ func addPersonLookup() {
 		Description: "Random social security number",
 		Example:     "296446360",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return SSN(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return ssn(r), nil
+		},
+	})
+
+	AddFuncLookup("ssnvalid", Info{
+		Display:     "SSN Valid",
+		Category:    "person",
+		Description: "Random social security number following the issuance rules",
+		Example:     "296-44-6360",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return ssnValid(r), nil
+		},
+	})
+
+	AddFuncLookup("itin", Info{
+		Display:     "ITIN",
+		Category:    "person",
+		Description: "Random individual taxpayer identification number",
+		Example:     "912-70-4821",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return itin(r), nil
 		},
 	})
 
@@ -257,8 +706,46 @@ func addPersonLookup() {
 		Description: "Random email",
 		Example:     "markusmoen@pagac.net",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Email(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return email(r), nil
+		},
+	})
+
+	AddFuncLookup("emailprovider", Info{
+		Display:     "Email Provider",
+		Category:    "person",
+		Description: "Random email with a domain picked by weight",
+		Example:     "[gmail.com, company.com] [5, 3] => markus.moen@gmail.com",
+		Output:      "string",
+		Params: []Param{
+			{Field: "domains", Display: "Domains", Type: "[]string", Description: "Array of domains to pick from"},
+			{Field: "weights", Display: "Weights", Type: "[]float", Optional: true, Description: "Optional array of weights, one per domain"},
+			{Field: "usename", Display: "Use Name", Type: "bool", Default: "true", Description: "Whether or not to base the local part on a name"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			eo := EmailOptions{}
+
+			domains, err := info.GetStringArray(m, "domains")
+			if err != nil {
+				return nil, err
+			}
+			eo.Domains = domains
+
+			if m != nil && len((*m)["weights"]) > 0 {
+				weights, err := info.GetFloat32Array(m, "weights")
+				if err != nil {
+					return nil, err
+				}
+				eo.Weights = weights
+			}
+
+			useName, err := info.GetBool(m, "usename")
+			if err != nil {
+				return nil, err
+			}
+			eo.UseName = useName
+
+			return emailProvider(r, &eo)
 		},
 	})
 
@@ -268,8 +755,48 @@ func addPersonLookup() {
 		Description: "Random phone number",
 		Example:     "6136459948",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Phone(), nil
+		Params: []Param{
+			{
+				Field: "country", Display: "Country", Type: "string", Default: "none",
+				Options:     []string{"none", "US", "GB", "DE", "IN", "BR"},
+				Description: "Country code of the national phone format, none generates 10 plain digits",
+			},
+			{Field: "international", Display: "International", Type: "bool", Default: "false", Description: "Whether or not to prefix the country phone with its +CC dialing code"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			country, err := info.GetString(m, "country")
+			if err != nil {
+				return nil, err
+			}
+			if country == "none" {
+				return phone(r), nil
+			}
+
+			international, err := info.GetBool(m, "international")
+			if err != nil {
+				return nil, err
+			}
+
+			return phoneLocale(r, country, international)
+		},
+	})
+
+	AddFuncLookup("phonee164", Info{
+		Display:     "Phone E.164",
+		Category:    "person",
+		Description: "Random phone number in E.164 format",
+		Example:     "+447136459948",
+		Output:      "string",
+		Params: []Param{
+			{Field: "country", Display: "Country", Type: "string", Default: "US", Options: []string{"US", "GB", "DE", "IN", "BR"}, Description: "Country code of the phone number"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			country, err := info.GetString(m, "country")
+			if err != nil {
+				return nil, err
+			}
+
+			return phoneE164(r, country)
 		},
 	})
 
@@ -279,8 +806,8 @@ func addPersonLookup() {
 		Description: "Random formatted phone number",
 		Example:     "136-459-9489",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return PhoneFormatted(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return phoneFormatted(r), nil
 		},
 	})
 
@@ -294,7 +821,7 @@ func addPersonLookup() {
 			{Field: "people", Display: "Strings", Type: "[]string", Description: "Array of people"},
 			{Field: "teams", Display: "Strings", Type: "[]string", Description: "Array of teams"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			people, err := info.GetStringArray(m, "people")
 			if err != nil {
 				return nil, err
@@ -305,7 +832,7 @@ func addPersonLookup() {
 				return nil, err
 			}
 
-			return Teams(people, teams), nil
+			return teamsFunc(r, people, teams), nil
 		},
 	})
 }
@@ -2,7 +2,12 @@ package gofakeit
 
 import (
 	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
 	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
 )
 
 func ExampleName() {
@@ -41,6 +46,87 @@ func BenchmarkLastName(b *testing.B) {
 	}
 }
 
+func ExampleFirstNameLocale() {
+	Seed(11)
+	value, err := FirstNameLocale("fr")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: Antoine
+}
+
+func TestNameLocale(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		first, err := FirstNameLocale("ja")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stringInSlice(first, data.PersonLocales["ja"]["first"]) {
+			t.Fatalf("%s is not in the japanese first name list", first)
+		}
+
+		last, err := LastNameLocale("JA")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stringInSlice(last, data.PersonLocales["ja"]["last"]) {
+			t.Fatalf("%s is not in the japanese last name list", last)
+		}
+
+		name, err := NameLocale("ja")
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts := strings.Split(name, " ")
+		if len(parts) != 2 || !stringInSlice(parts[0], data.PersonLocales["ja"]["last"]) || !stringInSlice(parts[1], data.PersonLocales["ja"]["first"]) {
+			t.Fatalf("%s should be a japanese family name followed by a given name", name)
+		}
+	}
+}
+
+func TestNameLocaleEnglishMatchesDefault(t *testing.T) {
+	Seed(11)
+	expected := FirstName()
+
+	Seed(11)
+	value, err := FirstNameLocale("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != expected {
+		t.Errorf("expected %s got %s", expected, value)
+	}
+}
+
+func TestNameLocaleUnknown(t *testing.T) {
+	_, err := FirstNameLocale("xx")
+	if err == nil || err.Error() != "Unsupported locale xx" {
+		t.Errorf("expected unsupported locale error, got %v", err)
+	}
+
+	_, err = NameLocale("xx")
+	if err == nil {
+		t.Error("expected error for unknown locale")
+	}
+}
+
+func TestNameLocaleLookup(t *testing.T) {
+	info := GetFuncLookup("lastname")
+	m := map[string][]string{
+		"locale": {"de"},
+	}
+
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !stringInSlice(value.(string), data.PersonLocales["de"]["last"]) {
+		t.Errorf("%s is not in the german last name list", value)
+	}
+}
+
 func ExampleNamePrefix() {
 	Seed(11)
 	fmt.Println(NamePrefix())
@@ -77,6 +163,57 @@ func BenchmarkSSN(b *testing.B) {
 	}
 }
 
+func ExampleSSNValid() {
+	Seed(11)
+	fmt.Println(SSNValid())
+	// Output: 780-84-9459
+}
+
+func TestSSNValid(t *testing.T) {
+	format := regexp.MustCompile(`^\d{3}-\d{2}-\d{4}$`)
+	for i := 0; i < 10000; i++ {
+		ssn := SSNValid()
+		if !format.MatchString(ssn) {
+			t.Fatalf("%s does not match the ssn format", ssn)
+		}
+		area, group, serial := ssn[:3], ssn[4:6], ssn[7:]
+		if area == "000" || area == "666" || area[0] == '9' {
+			t.Fatalf("%s has an invalid area number", ssn)
+		}
+		if group == "00" || serial == "0000" {
+			t.Fatalf("%s has an invalid group or serial number", ssn)
+		}
+	}
+}
+
+func BenchmarkSSNValid(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		SSNValid()
+	}
+}
+
+func ExampleITIN() {
+	Seed(11)
+	fmt.Println(ITIN())
+	// Output: 960-95-4213
+}
+
+func TestITIN(t *testing.T) {
+	format := regexp.MustCompile(`^9\d{2}-(5\d|6[0-5]|7\d|8[0-8]|9[0-2]|9[4-9])-\d{4}$`)
+	for i := 0; i < 10000; i++ {
+		itin := ITIN()
+		if !format.MatchString(itin) {
+			t.Fatalf("%s does not match the itin format", itin)
+		}
+	}
+}
+
+func BenchmarkITIN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ITIN()
+	}
+}
+
 func ExampleGender() {
 	Seed(11)
 	fmt.Println(Gender())
@@ -145,10 +282,112 @@ func ExamplePerson() {
 	// 23.921575
 	// 3023202027
 	// lamarkoelpin@heaney.biz
-	// Maestro
-	// 39800889982276
-	// 05/29
-	// 932
+	// Diners Club
+	// 3614800889982273
+	// 11/27
+	// 899
+}
+
+func ExamplePersonGender() {
+	Seed(11)
+	person, err := PersonGender("female")
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(person.Prefix)
+	fmt.Println(person.FirstName)
+	fmt.Println(person.Gender)
+	// Output:
+	// Ms.
+	// Elizabeth
+	// female
+}
+
+func TestPersonGender(t *testing.T) {
+	for _, g := range []string{"male", "female"} {
+		for i := 0; i < 1000; i++ {
+			person, err := PersonGender(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if person.Gender != g {
+				t.Fatalf("expected gender %s got %s", g, person.Gender)
+			}
+			if g == "female" && person.Prefix == "Mr." {
+				t.Fatalf("female person was given a male prefix")
+			}
+			if g == "male" && stringInSlice(person.Prefix, []string{"Mrs.", "Ms.", "Miss"}) {
+				t.Fatalf("male person was given a female prefix %s", person.Prefix)
+			}
+			if !stringInSlice(person.FirstName, data.Person[g+"_first"]) {
+				t.Fatalf("%s is not a %s first name", person.FirstName, g)
+			}
+		}
+	}
+}
+
+func TestPersonGenderRandom(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		person, err := PersonGender("random")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if person.Gender == "female" && person.Prefix == "Mr." {
+			t.Fatalf("female person was given a male prefix")
+		}
+		if !stringInSlice(person.FirstName, data.Person[person.Gender+"_first"]) {
+			t.Fatalf("%s is not a %s first name", person.FirstName, person.Gender)
+		}
+	}
+}
+
+func TestPersonGenderInvalid(t *testing.T) {
+	_, err := PersonGender("unknown")
+	if err == nil {
+		t.Error("expected error for invalid gender")
+	}
+}
+
+func TestPersonPrefixMatchesGender(t *testing.T) {
+	for seed := int64(1); seed <= 1000; seed++ {
+		person := New(rand.NewSource(seed)).Person()
+		if person.Gender != "male" && person.Gender != "female" {
+			t.Fatalf("seed %d expected male or female got %s", seed, person.Gender)
+		}
+		if !stringInSlice(person.Prefix, data.Person[person.Gender+"_prefix"]) {
+			t.Fatalf("seed %d %s is not a %s prefix", seed, person.Prefix, person.Gender)
+		}
+
+		// A random gender also keeps the first name consistent
+		person, err := New(rand.NewSource(seed)).PersonGender("random")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stringInSlice(person.Prefix, data.Person[person.Gender+"_prefix"]) {
+			t.Fatalf("seed %d %s is not a %s prefix", seed, person.Prefix, person.Gender)
+		}
+		if !stringInSlice(person.FirstName, data.Person[person.Gender+"_first"]) {
+			t.Fatalf("seed %d %s is not a %s first name", seed, person.FirstName, person.Gender)
+		}
+	}
+}
+
+func TestPersonGenderLookup(t *testing.T) {
+	info := GetFuncLookup("person")
+	m := map[string][]string{
+		"gender": {"female"},
+	}
+
+	for i := 0; i < 100; i++ {
+		value, err := info.call(globalFaker.ctx(), &m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value.(*PersonInfo).Gender != "female" {
+			t.Fatalf("expected female got %s", value.(*PersonInfo).Gender)
+		}
+	}
 }
 
 func BenchmarkPerson(b *testing.B) {
@@ -184,6 +423,140 @@ func BenchmarkPhone(b *testing.B) {
 	}
 }
 
+func ExamplePhoneLocale() {
+	Seed(11)
+
+	value, err := PhoneLocale("GB")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+
+	value, err = PhoneLocaleInternational("GB")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+
+	// Output:
+	// 07136 459948
+	// +44 1953 690635
+}
+
+func TestPhoneLocale(t *testing.T) {
+	tests := map[string]struct {
+		national      string
+		international string
+	}{
+		"US": {`^\([2-9]\d{2}\) [2-9]\d{2}-\d{4}$`, `^\+1 [2-9]\d{2} [2-9]\d{2}-\d{4}$`},
+		"GB": {`^0(7\d{3} \d{6}|20 \d{4} \d{4}|1\d{3} \d{6})$`, `^\+44 (7\d{3} \d{6}|20 \d{4} \d{4}|1\d{3} \d{6})$`},
+		"DE": {`^0(1[5-7]\d \d{7,8}|30 \d{8}|89 \d{8})$`, `^\+49 (1[5-7]\d \d{7,8}|30 \d{8}|89 \d{8})$`},
+		"IN": {`^[6-9]\d{4} \d{5}$`, `^\+91 [6-9]\d{4} \d{5}$`},
+		"BR": {`^\([1-9]\d\) (9\d{4}|[2-9]\d{3})-\d{4}$`, `^\+55 [1-9]\d (9\d{4}|[2-9]\d{3})-\d{4}$`},
+	}
+
+	for country, test := range tests {
+		national := regexp.MustCompile(test.national)
+		international := regexp.MustCompile(test.international)
+
+		for i := 0; i < 1000; i++ {
+			value, err := PhoneLocale(country)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !national.MatchString(value) {
+				t.Fatalf("%s phone %s does not match %s", country, value, test.national)
+			}
+
+			value, err = PhoneLocaleInternational(strings.ToLower(country))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !international.MatchString(value) {
+				t.Fatalf("%s phone %s does not match %s", country, value, test.international)
+			}
+			if !strings.HasPrefix(value, "+"+data.PhoneFormats[country].DialCode+" ") {
+				t.Fatalf("%s phone %s has the wrong dialing prefix", country, value)
+			}
+		}
+	}
+}
+
+func ExamplePhoneE164() {
+	Seed(11)
+
+	value, err := PhoneE164("GB")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+
+	// Output: +447136459948
+}
+
+func TestPhoneE164(t *testing.T) {
+	e164 := regexp.MustCompile(`^\+\d{8,15}$`)
+	lengths := map[string]int{"US": 11, "IN": 12}
+	for country, format := range data.PhoneFormats {
+		for i := 0; i < 1000; i++ {
+			value, err := PhoneE164(country)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !e164.MatchString(value) {
+				t.Fatalf("%s phone %s is not E.164", country, value)
+			}
+			if !strings.HasPrefix(value, "+"+format.DialCode) {
+				t.Fatalf("%s phone %s does not start with +%s", country, value, format.DialCode)
+			}
+			if n, ok := lengths[country]; ok && len(value)-1 != n {
+				t.Fatalf("%s phone %s expected %d digits", country, value, n)
+			}
+		}
+	}
+
+	if _, err := PhoneE164("ZZ"); err == nil {
+		t.Error("expected error for unsupported country")
+	}
+}
+
+func BenchmarkPhoneE164(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		PhoneE164("US")
+	}
+}
+
+func TestPhoneLocaleUnsupported(t *testing.T) {
+	_, err := PhoneLocale("ZZ")
+	if err == nil {
+		t.Error("expected error for unsupported country")
+	}
+}
+
+func TestPhoneLookupCountry(t *testing.T) {
+	info := GetFuncLookup("phone")
+
+	m := map[string][]string{
+		"country":       {"IN"},
+		"international": {"true"},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(value.(string), "+91 ") {
+		t.Errorf("expected +91 prefix, got %s", value)
+	}
+
+	value, err = info.call(globalFaker.ctx(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(value.(string)) != 10 {
+		t.Errorf("expected 10 digit phone by default, got %s", value)
+	}
+}
+
 func ExamplePhoneFormatted() {
 	Seed(11)
 	fmt.Println(PhoneFormatted())
@@ -202,6 +575,115 @@ func ExampleEmail() {
 	// Output: markusmoen@pagac.net
 }
 
+func ExampleEmailProvider() {
+	Seed(11)
+
+	email, err := EmailProvider(&EmailOptions{
+		Domains: []string{"gmail.com", "company.com", "yahoo.com"},
+		Weights: []float32{5, 3, 2},
+		UseName: true,
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(email)
+
+	// Output: marcel.pagac@gmail.com
+}
+
+func TestEmailProviderWeights(t *testing.T) {
+	Seed(11)
+
+	eo := &EmailOptions{Domains: []string{"gmail.com", "company.com", "yahoo.com"}, Weights: []float32{50, 30, 20}}
+	counts := map[string]int{}
+	total := 10000
+	for i := 0; i < total; i++ {
+		email, err := EmailProvider(eo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[email[strings.Index(email, "@")+1:]]++
+	}
+
+	for i, domain := range eo.Domains {
+		expected := float64(eo.Weights[i]) / 100
+		got := float64(counts[domain]) / float64(total)
+		if got < expected-0.03 || got > expected+0.03 {
+			t.Errorf("expected %s about %.2f of the time, got %.2f", domain, expected, got)
+		}
+	}
+	if len(counts) != 3 {
+		t.Errorf("expected only the given domains, got %v", counts)
+	}
+
+	// Without weights every domain is equally likely
+	counts = map[string]int{}
+	for i := 0; i < total; i++ {
+		email, _ := EmailProvider(&EmailOptions{Domains: eo.Domains})
+		counts[email[strings.Index(email, "@")+1:]]++
+	}
+	for _, domain := range eo.Domains {
+		if got := float64(counts[domain]) / float64(total); got < 0.3 || got > 0.37 {
+			t.Errorf("expected %s about a third of the time, got %.2f", domain, got)
+		}
+	}
+}
+
+func TestEmailProviderName(t *testing.T) {
+	firsts := map[string]bool{}
+	for _, name := range data.Person["first"] {
+		firsts[emailLocal(name)] = true
+	}
+	lasts := map[string]bool{}
+	for _, name := range data.Person["last"] {
+		lasts[emailLocal(name)] = true
+	}
+
+	for i := 0; i < 1000; i++ {
+		email, err := EmailProvider(&EmailOptions{UseName: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts := strings.Split(email[:strings.Index(email, "@")], ".")
+		if len(parts) != 2 || !firsts[parts[0]] || !lasts[parts[1]] {
+			t.Fatalf("expected local part made of a first and last name, got %s", email)
+		}
+	}
+
+	email, err := EmailProvider(&EmailOptions{Domains: []string{"Company.com"}, UseName: true, FirstName: "Mary Ann", LastName: "O'Neil"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email != "maryann.oneil@company.com" {
+		t.Errorf("expected the given name to be used, got %s", email)
+	}
+
+	email, _ = EmailProvider(&EmailOptions{Domains: []string{"company.com"}})
+	if !regexp.MustCompile(`^[a-z]+[0-9]{4}@company\.com$`).MatchString(email) {
+		t.Errorf("expected username local part, got %s", email)
+	}
+}
+
+func TestEmailProviderErrors(t *testing.T) {
+	if _, err := EmailProvider(&EmailOptions{Domains: []string{"a.com", "b.com"}, Weights: []float32{1}}); err == nil {
+		t.Error("expected error for mismatched weights")
+	}
+	if _, err := EmailProvider(&EmailOptions{Weights: []float32{1}}); err == nil {
+		t.Error("expected error for weights without domains")
+	}
+	if _, err := EmailProvider(nil); err != nil {
+		t.Errorf("expected nil options to use a random domain, got %s", err)
+	}
+}
+
+func BenchmarkEmailProvider(b *testing.B) {
+	eo := &EmailOptions{Domains: []string{"gmail.com", "company.com"}, Weights: []float32{5, 3}, UseName: true}
+	for i := 0; i < b.N; i++ {
+		EmailProvider(eo)
+	}
+}
+
 func BenchmarkEmail(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Email()
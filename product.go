@@ -0,0 +1,114 @@
+package gofakeit
+
+import "strings"
+
+// ProductInfo is a struct of product information, the sku starts with a code of the category
+type ProductInfo struct {
+	Name        string  `json:"name" xml:"name"`
+	Description string  `json:"description" xml:"description"`
+	Category    string  `json:"category" xml:"category"`
+	Price       float64 `json:"price" xml:"price"`
+	SKU         string  `json:"sku" xml:"sku"`
+	UPC         string  `json:"upc" xml:"upc"`
+}
+
+// productPrices are the price ranges of each product category
+var productPrices = map[string][2]float64{
+	"automotive":  {10, 250},
+	"beauty":      {5, 120},
+	"books":       {5, 60},
+	"clothing":    {8, 200},
+	"electronics": {15, 1500},
+	"garden":      {5, 150},
+	"grocery":     {2, 40},
+	"health":      {5, 200},
+	"home":        {8, 300},
+	"kitchen":     {8, 400},
+	"office":      {3, 500},
+	"pet":         {5, 200},
+	"sports":      {5, 300},
+	"toys":        {5, 120},
+}
+
+// Product will generate a struct with a random product of a category,
+// with a price in the range of the category, a sku starting with the category code and a valid upc
+func Product() *ProductInfo { return product(globalFaker.ctx()) }
+
+// Product will generate a struct with a random product of a category,
+// with a price in the range of the category, a sku starting with the category code and a valid upc
+func (f *Faker) Product() *ProductInfo { return product(f.ctx()) }
+
+func product(r fakerCtx) *ProductInfo {
+	category := productCategory(r)
+	item := getRandValue(r, []string{"product", category})
+
+	name := getRandValue(r, []string{"product", "adjective"}) + " "
+	if boolFunc(r) {
+		name += getRandValue(r, []string{"product", "material"}) + " "
+	}
+	name += item
+
+	prices, ok := productPrices[category]
+	if !ok {
+		prices = [2]float64{1, 1000}
+	}
+
+	return &ProductInfo{
+		Name:        name,
+		Description: "The " + name + " " + strings.ToLower(sentence(r, number(r, 6, 12))),
+		Category:    category,
+		Price:       price(r, prices[0], prices[1]),
+		SKU:         productSKUCode(category) + "-" + strings.ToUpper(replaceWithLetters(r, "??")) + replaceWithNumbers(r, "-#####"),
+		UPC:         upca(r),
+	}
+}
+
+// ProductCategory will generate a random product category
+func ProductCategory() string { return productCategory(globalFaker.ctx()) }
+
+// ProductCategory will generate a random product category
+func (f *Faker) ProductCategory() string { return productCategory(f.ctx()) }
+
+func productCategory(r fakerCtx) string {
+	return getRandValue(r, []string{"product", "category"})
+}
+
+// productSKUCode is the first three letters of the category in upper case
+func productSKUCode(category string) string {
+	code := strings.ToUpper(emailLocal(category))
+	if len(code) > 3 {
+		code = code[:3]
+	}
+	return code
+}
+
+func addProductLookup() {
+	AddFuncLookup("product", Info{
+		Display:     "Product",
+		Category:    "product",
+		Description: "Random product with a category, price, sku and upc",
+		Example: `{
+			name: "Rustic Yoga Mat",
+			description: "The Rustic Yoga Mat ...",
+			category: "sports",
+			price: 190.29,
+			sku: "SPO-NS-04259",
+			upc: "145830232020"
+		}`,
+		Output: "map[string]interface",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return product(r), nil
+		},
+	})
+
+	AddFuncLookup("productcategory", Info{
+		Display:     "Product Category",
+		Category:    "product",
+		Description: "Random product category",
+		Example:     "electronics",
+		Output:      "string",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return productCategory(r), nil
+		},
+	})
+}
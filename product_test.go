@@ -0,0 +1,88 @@
+package gofakeit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v5/data"
+)
+
+func ExampleProduct() {
+	Seed(11)
+	product := Product()
+	fmt.Println(product.Name)
+	fmt.Println(product.Category)
+	fmt.Println(product.Price)
+	fmt.Println(product.SKU)
+	fmt.Println(product.UPC)
+	// Output: Rustic Yoga Mat
+	// sports
+	// 190.29
+	// SPO-NS-04259
+	// 145830232020
+}
+
+func TestProduct(t *testing.T) {
+	sku := regexp.MustCompile(`^([A-Z]{3})-[A-Z]{2}-\d{5}$`)
+	codes := map[string]string{}
+	for _, category := range data.Product["category"] {
+		code := productSKUCode(category)
+		if other, ok := codes[code]; ok {
+			t.Fatalf("categories %s and %s share the sku code %s", category, other, code)
+		}
+		codes[code] = category
+	}
+
+	for i := 0; i < 1000; i++ {
+		p := Product()
+
+		match := sku.FindStringSubmatch(p.SKU)
+		if match == nil {
+			t.Fatalf("invalid sku %s", p.SKU)
+		}
+		if codes[match[1]] != p.Category {
+			t.Fatalf("expected sku %s to start with the code of %s", p.SKU, p.Category)
+		}
+
+		if len(p.UPC) != 12 || gs1CheckDigit(p.UPC[:11]) != p.UPC[11] {
+			t.Fatalf("invalid upc check digit %s", p.UPC)
+		}
+
+		prices := productPrices[p.Category]
+		if p.Price < prices[0] || p.Price > prices[1] {
+			t.Fatalf("expected %s price %v in %v", p.Category, p.Price, prices)
+		}
+		found := false
+		for _, item := range data.Product[p.Category] {
+			if strings.HasSuffix(p.Name, " "+item) {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected name %s to be a %s product", p.Name, p.Category)
+		}
+		if !strings.HasPrefix(p.Description, "The "+p.Name+" ") {
+			t.Fatalf("expected description %s to be about %s", p.Description, p.Name)
+		}
+	}
+}
+
+func BenchmarkProduct(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Product()
+	}
+}
+
+func ExampleProductCategory() {
+	Seed(11)
+	fmt.Println(ProductCategory())
+	// Output: sports
+}
+
+func BenchmarkProductCategory(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ProductCategory()
+	}
+}
@@ -0,0 +1,148 @@
+package gofakeit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// A field with the function "ref" builds its value from the other fields in the same row.
+// Its template param references fields by name within {}, for example {first_name}.{last_name}@example.com
+//
+// Ref fields are evaluated after every other field in the row, so generation of the
+// independent fields is unchanged by adding them. A ref field that references another
+// ref field is evaluated after it and cyclic references are rejected, as are references to
+// object and array fields. A ref fields Transform and MaxLen apply to the value it builds.
+
+// refFieldOrder returns the indexes of the ref fields in the order they should be evaluated
+func refFieldOrder(fields []Field) ([]int, error) {
+	index := make(map[string]int, len(fields))
+	for i, field := range fields {
+		index[field.Name] = i
+	}
+
+	// 0 is unvisited, 1 is being visited and 2 is done
+	state := make([]int, len(fields))
+	order := []int{}
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case 1:
+			return errors.New("Ref field " + fields[i].Name + " has a cyclic reference")
+		case 2:
+			return nil
+		}
+		state[i] = 1
+
+		names, err := refNames(&fields[i])
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			ii, ok := index[name]
+			if !ok {
+				return errors.New("Ref field " + fields[i].Name + " references unknown field " + name)
+			}
+			if fields[ii].Function == "object" || fields[ii].Function == "array" {
+				return errors.New("Ref field " + fields[i].Name + " can not reference object or array field " + name)
+			}
+			if fields[ii].Function == "ref" {
+				if err := visit(ii); err != nil {
+					return err
+				}
+			}
+		}
+
+		state[i] = 2
+		order = append(order, i)
+		return nil
+	}
+
+	for i, field := range fields {
+		if field.Function != "ref" {
+			continue
+		}
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// refNames returns the field names referenced by a ref fields template
+func refNames(field *Field) ([]string, error) {
+	template, err := refTemplate(field)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for {
+		start := strings.Index(template, "{")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(template[start:], "}")
+		if end == -1 {
+			return nil, errors.New("Ref field " + field.Name + " has an unclosed { in its template")
+		}
+		names = append(names, template[start+1:start+end])
+		template = template[start+end+1:]
+	}
+
+	return names, nil
+}
+
+func refTemplate(field *Field) (string, error) {
+	template, ok := field.Params["template"]
+	if !ok || len(template) == 0 {
+		return "", errors.New("Must pass template param for ref field " + field.Name)
+	}
+
+	return template[0], nil
+}
+
+// refEvaluate generates the ref fields in order, row holds the values of the fields already generated.
+// set is called with each ref fields index and value, nil when the field was picked to be null
+func refEvaluate(r fakerCtx, fields []Field, order []int, row map[string]interface{}, set func(i int, value interface{})) error {
+	for _, i := range order {
+		field := fields[i]
+		if fieldNull(r, &field) {
+			row[field.Name] = nil
+			set(i, nil)
+			continue
+		}
+
+		template, err := refTemplate(&field)
+		if err != nil {
+			return err
+		}
+
+		// Templates were validated by refFieldOrder so every { has a closing }
+		var b strings.Builder
+		for {
+			start := strings.Index(template, "{")
+			if start == -1 {
+				b.WriteString(template)
+				break
+			}
+			end := start + strings.Index(template[start:], "}")
+			b.WriteString(template[:start])
+			if v := row[template[start+1:end]]; v != nil {
+				b.WriteString(fmt.Sprintf("%v", v))
+			}
+			template = template[end+1:]
+		}
+
+		value, err := fieldFormat(&field, b.String())
+		if err != nil {
+			return err
+		}
+		row[field.Name] = value
+		set(i, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Example_ref() {
+	Seed(11)
+
+	value, err := CSV(&CSVOptions{
+		RowCount: 2,
+		Fields: []Field{
+			{Name: "email", Function: "ref", Params: map[string][]string{"template": {"{first_name}.{last_name}@example.com"}}},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(string(value))
+
+	// Output:
+	// email,first_name,last_name
+	// Santos.Rohan@example.com,Santos,Rohan
+	// Sebastian.Wiza@example.com,Sebastian,Wiza
+}
+
+func TestRefCSV(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 100,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "email", Function: "ref", Params: map[string][]string{"template": {"{first_name}.{last_name}@example.com"}}},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows[1:] {
+		expected := row[2] + "." + row[3] + "@example.com"
+		if row[1] != expected {
+			t.Fatalf("expected email %s got %s", expected, row[1])
+		}
+	}
+}
+
+func TestRefJSON(t *testing.T) {
+	value, err := JSON(&JSONOptions{
+		Type:     "array",
+		RowCount: 100,
+		Fields: []Field{
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "id", Function: "autoincrement"},
+			{Name: "email", Function: "ref", Params: map[string][]string{"template": {"{username}@example.com"}}},
+			{Name: "username", Function: "ref", Params: map[string][]string{"template": {"{first_name}{last_name}{id}"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(value, &rows); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		username := fmt.Sprintf("%s%s%v", row["first_name"], row["last_name"], row["id"])
+		if row["username"] != username {
+			t.Fatalf("expected username %s got %v", username, row["username"])
+		}
+		if row["email"] != username+"@example.com" {
+			t.Fatalf("expected email %s@example.com got %v", username, row["email"])
+		}
+	}
+}
+
+func TestRefDoesNotChangeOtherFields(t *testing.T) {
+	fields := []Field{
+		{Name: "first_name", Function: "firstname"},
+		{Name: "last_name", Function: "lastname"},
+	}
+
+	Seed(11)
+	without, err := CSV(&CSVOptions{RowCount: 10, Fields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Seed(11)
+	with, err := CSV(&CSVOptions{RowCount: 10, Fields: append([]Field{
+		{Name: "full_name", Function: "ref", Params: map[string][]string{"template": {"{first_name} {last_name}"}}},
+	}, fields...)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withLines := strings.Split(string(with), "\n")
+	for i, line := range strings.Split(string(without), "\n") {
+		if i == 0 || line == "" {
+			continue
+		}
+		if !strings.HasSuffix(withLines[i], ","+line) {
+			t.Fatalf("expected row %q to end with %q", withLines[i], line)
+		}
+	}
+}
+
+func TestRefErrors(t *testing.T) {
+	tests := map[string][]Field{
+		"cycle": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{b}"}}},
+			{Name: "b", Function: "ref", Params: map[string][]string{"template": {"{a}"}}},
+		},
+		"self": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{a}"}}},
+		},
+		"unknown": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{missing}"}}},
+		},
+		"unclosed": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{b"}}},
+			{Name: "b", Function: "firstname"},
+		},
+		"no template": {
+			{Name: "a", Function: "ref"},
+		},
+		"object": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{b}"}}},
+			{Name: "b", Function: "object", Params: map[string][]string{"fields": {`{"name":"c","function":"firstname"}`}}},
+		},
+		"array": {
+			{Name: "a", Function: "ref", Params: map[string][]string{"template": {"{b}"}}},
+			{Name: "b", Function: "array", Params: map[string][]string{"fields": {`{"name":"c","function":"firstname"}`}}},
+		},
+	}
+
+	for name, fields := range tests {
+		if _, err := CSV(&CSVOptions{RowCount: 1, Fields: fields}); err == nil {
+			t.Errorf("%s expected csv error", name)
+		}
+		if _, err := JSON(&JSONOptions{Type: "object", Fields: fields}); err == nil {
+			t.Errorf("%s expected json error", name)
+		}
+	}
+}
+
+func TestRefFormat(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 10,
+		NoHeader: true,
+		Fields: []Field{
+			{Name: "first_name", Function: "firstname"},
+			{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hello {first_name}"}}, Transform: "upper", MaxLen: 8},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(value), "\n"), "\n") {
+		cells := strings.Split(line, ",")
+		if cells[1] != strings.ToUpper("hello " + cells[0])[:8] {
+			t.Fatalf("expected the ref to be transformed and clamped got %s", line)
+		}
+	}
+}
+
+func TestRefNull(t *testing.T) {
+	value, err := JSON(&JSONOptions{
+		Type:     "array",
+		RowCount: 10,
+		Fields: []Field{
+			{Name: "first_name", Function: "firstname", NullProbability: 1},
+			{Name: "greeting", Function: "ref", Params: map[string][]string{"template": {"hi {first_name}"}}},
+			{Name: "nothing", Function: "ref", Params: map[string][]string{"template": {"{first_name}"}}, NullProbability: 1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(value, &rows); err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if row["greeting"] != "hi " {
+			t.Fatalf("expected null reference to be empty, got %v", row["greeting"])
+		}
+		if row["nothing"] != nil {
+			t.Fatalf("expected null ref field, got %v", row["nothing"])
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SQLOptions defines values needed for sql generation
+type SQLOptions struct {
+	Table    string  `json:"table" xml:"table"`
+	RowCount int     `json:"row_count" xml:"row_count"`
+	Fields   []Field `json:"fields" xml:"fields"`
+	Batch    int     `json:"batch" xml:"batch"`     // max rows per insert statement, 0 is all rows in one
+	Dialect  string  `json:"dialect" xml:"dialect"` // ansi, the default, quotes identifiers with "" and mysql with ``
+}
+
+// SQL generates insert statements for a table with rows of field values.
+// Strings are quoted with single quotes doubled, numbers and booleans are left bare
+// and nil values are written as NULL. The table and column names are quoted for the dialect,
+// a table can be schema qualified like public.people
+func SQL(so *SQLOptions) ([]byte, error) { return sqlFunc(globalFaker.ctx(), so) }
+
+// SQL generates insert statements for a table with rows of field values.
+// Strings are quoted with single quotes doubled, numbers and booleans are left bare
+// and nil values are written as NULL. The table and column names are quoted for the dialect,
+// a table can be schema qualified like public.people
+func (f *Faker) SQL(so *SQLOptions) ([]byte, error) { return sqlFunc(f.ctx(), so) }
+
+func sqlFunc(r fakerCtx, so *SQLOptions) ([]byte, error) {
+	if so.Table == "" {
+		return nil, errors.New("Must provide table name to generate sql")
+	}
+
+	// Check fields
+	if so.Fields == nil || len(so.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build sql insert(s)")
+	}
+
+	// Make sure you set a row count
+	if so.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	dialect := strings.ToLower(so.Dialect)
+	quote, err := sqlIdentifierQuote(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	if so.Batch < 0 {
+		return nil, errors.New("Batch must be 0 or greater")
+	}
+	batch := so.Batch
+	if batch == 0 {
+		batch = so.RowCount
+	}
+
+	// Build column list once
+	columns := make([]string, len(so.Fields))
+	for i, field := range so.Fields {
+		columns[i] = sqlIdentifier(field.Name, quote)
+	}
+	tables := strings.Split(so.Table, ".")
+	for i, table := range tables {
+		tables[i] = sqlIdentifier(table, quote)
+	}
+	insert := "INSERT INTO " + strings.Join(tables, ".") + " (" + strings.Join(columns, ", ") + ") VALUES "
+
+	b := &bytes.Buffer{}
+	for i := 1; i <= so.RowCount; i++ {
+		// Start a new statement at the beginning of each batch
+		if (i-1)%batch == 0 {
+			b.WriteString(insert)
+		} else {
+			b.WriteString(", ")
+		}
+
+		vr := make([]string, len(so.Fields))
+		for ii, field := range so.Fields {
+			if fieldNull(r, &field) {
+				vr[ii] = "NULL"
+				continue
+			}
+
+			if field.Function == "autoincrement" {
+				id, err := autoIncrement(&field, i)
+				if err != nil {
+					return nil, err
+				}
+				vr[ii] = strconv.Itoa(id)
+				continue
+			}
+
+			if field.Function == "timeseries" {
+				ts, err := timeSeries(r, &field, i)
+				if err != nil {
+					return nil, err
+				}
+				vr[ii] = sqlValue(ts, dialect)
+				continue
+			}
+
+			// Get function info
+			funcInfo := GetFuncLookup(field.Function)
+			if funcInfo == nil {
+				return nil, errors.New("Invalid function, " + field.Function + " does not exist")
+			}
+
+			value, err := fieldCall(r, &field, funcInfo)
+			if err != nil {
+				return nil, err
+			}
+
+			vr[ii] = sqlValue(value, dialect)
+		}
+		b.WriteString("(" + strings.Join(vr, ", ") + ")")
+
+		// End statement at the end of each batch or the last row
+		if i%batch == 0 || i == so.RowCount {
+			b.WriteString(";\n")
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// sqlIdentifierQuote returns the character the dialect quotes identifiers with
+func sqlIdentifierQuote(dialect string) (string, error) {
+	switch strings.ToLower(dialect) {
+	case "", "ansi":
+		return `"`, nil
+	case "mysql":
+		return "`", nil
+	}
+
+	return "", errors.New("Invalid dialect " + dialect + ", must be ansi or mysql")
+}
+
+// sqlIdentifier wraps a table or column name in quote, escaping quote by doubling it,
+// so reserved words and names with spaces stay valid
+func sqlIdentifier(name string, quote string) string {
+	return quote + strings.Replace(name, quote, quote+quote, -1) + quote
+}
+
+// sqlValue converts a generated value into its sql literal for dialect
+func sqlValue(value interface{}, dialect string) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		return strconv.FormatBool(v)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return sqlQuote(v, dialect)
+	case []byte:
+		return sqlQuote(string(v), dialect)
+	}
+
+	// Anything else like structs or maps gets stored as json
+	j, err := json.Marshal(value)
+	if err != nil {
+		return sqlQuote(fmt.Sprintf("%v", value), dialect)
+	}
+	return sqlQuote(string(j), dialect)
+}
+
+// sqlQuote wraps a string in single quotes, escaping single quotes by doubling them.
+// Mysql also treats a backslash as an escape character so it gets doubled as well
+func sqlQuote(s string, dialect string) string {
+	if dialect == "mysql" {
+		s = strings.Replace(s, `\`, `\\`, -1)
+	}
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func addFileSQLLookup() {
+	AddFuncLookup("sql", Info{
+		Display:     "SQL",
+		Category:    "file",
+		Description: "Generates sql insert statements with rows of field values",
+		Example: `
+			INSERT INTO "people" ("id", "first_name", "price") VALUES (1, 'Markus', 804.92), (2, 'Alayna', 568.46);
+		`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "table", Display: "Table", Type: "string", Description: "Name of the table to insert into"},
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows to insert"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing column name and function to run in json format"},
+			{Field: "batch", Display: "Batch", Type: "int", Default: "0", Description: "Max number of rows per insert statement, 0 puts all rows in one statement"},
+			{Field: "dialect", Display: "Dialect", Type: "string", Default: "ansi", Options: []string{"ansi", "mysql"}, Description: "Quoting of the table and column names, ansi uses double quotes and mysql backticks"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			so := SQLOptions{}
+
+			table, err := info.GetString(m, "table")
+			if err != nil {
+				return nil, err
+			}
+			so.Table = table
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			so.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				so.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &so.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			batch, err := info.GetInt(m, "batch")
+			if err != nil {
+				return nil, err
+			}
+			so.Batch = batch
+
+			dialect, err := info.GetString(m, "dialect")
+			if err != nil {
+				return nil, err
+			}
+			so.Dialect = dialect
+
+			return sqlFunc(r, &so)
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package gofakeit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleSQL() {
+	Seed(11)
+
+	value, err := SQL(&SQLOptions{
+		Table:    "people",
+		RowCount: 2,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "price", Function: "price"},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Print(string(value))
+
+	// Output:
+	// INSERT INTO "people" ("id", "first_name", "price") VALUES (1, 'Markus', 804.92), (2, 'Alayna', 568.46);
+}
+
+func TestSQLEscaping(t *testing.T) {
+	value, err := SQL(&SQLOptions{
+		Table:    "people",
+		RowCount: 1,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "last_name", Function: "generate", Params: map[string][]string{"str": {"O'Brien"}}},
+			{Name: "active", Function: "bool"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !strings.HasPrefix(string(value), `INSERT INTO "people" ("id", "last_name", "active") VALUES (1, 'O''Brien', `) {
+		t.Errorf("unexpected sql output %s", value)
+	}
+	if !strings.HasSuffix(string(value), ");\n") {
+		t.Errorf("expected statement to end with semicolon %s", value)
+	}
+
+	// Mysql reads a backslash as an escape so a trailing one would end the string early
+	value, err = SQL(&SQLOptions{
+		Table:    "files",
+		RowCount: 1,
+		Dialect:  "mysql",
+		Fields: []Field{
+			{Name: "path", Function: "generate", Params: map[string][]string{"str": {`C:\path\`}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(value) != "INSERT INTO `files` (`path`) VALUES ('C:\\\\path\\\\');\n" {
+		t.Errorf("expected backslashes to be escaped for mysql got %s", value)
+	}
+}
+
+func TestSQLIdentifiers(t *testing.T) {
+	fields := []Field{
+		{Name: "order", Function: "autoincrement"},
+		{Name: "group", Function: "generate", Params: map[string][]string{"str": {"a"}}},
+		{Name: "first name", Function: "generate", Params: map[string][]string{"str": {"b"}}},
+		{Name: `say "hi"`, Function: "generate", Params: map[string][]string{"str": {"c"}}},
+		{Name: "tick`s", Function: "generate", Params: map[string][]string{"str": {"d"}}},
+	}
+
+	tests := []struct {
+		dialect  string
+		table    string
+		expected string
+	}{
+		{"", "select", `INSERT INTO "select" ("order", "group", "first name", "say ""hi""", "tick` + "`" + `s") VALUES (1, 'a', 'b', 'c', 'd');` + "\n"},
+		{"ansi", "public.people", `INSERT INTO "public"."people" ("order", "group", "first name", "say ""hi""", "tick` + "`" + `s") VALUES (1, 'a', 'b', 'c', 'd');` + "\n"},
+		{"mysql", "select", "INSERT INTO `select` (`order`, `group`, `first name`, `say \"hi\"`, `tick``s`) VALUES (1, 'a', 'b', 'c', 'd');\n"},
+	}
+
+	for _, test := range tests {
+		value, err := SQL(&SQLOptions{Table: test.table, Dialect: test.dialect, RowCount: 1, Fields: fields})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if string(value) != test.expected {
+			t.Errorf("dialect %q expected %s got %s", test.dialect, test.expected, value)
+		}
+	}
+
+	if _, err := SQL(&SQLOptions{Table: "people", Dialect: "oracle", RowCount: 1, Fields: fields}); err == nil {
+		t.Error("expected error for unknown dialect")
+	}
+}
+
+func TestSQLBatch(t *testing.T) {
+	value, err := SQL(&SQLOptions{
+		Table:    "people",
+		RowCount: 5,
+		Batch:    2,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if count := strings.Count(string(value), "INSERT INTO"); count != 3 {
+		t.Errorf("expected 3 insert statements got %d\n%s", count, value)
+	}
+	if count := strings.Count(string(value), ";\n"); count != 3 {
+		t.Errorf("expected 3 terminated statements got %d\n%s", count, value)
+	}
+}
+
+func TestSQLValue(t *testing.T) {
+	tests := []struct {
+		in      interface{}
+		dialect string
+		out     string
+	}{
+		{nil, "ansi", "NULL"},
+		{5, "ansi", "5"},
+		{uint8(7), "ansi", "7"},
+		{1.5, "ansi", "1.5"},
+		{true, "ansi", "true"},
+		{"it's", "ansi", "'it''s'"},
+		{[]string{"a"}, "ansi", `'["a"]'`},
+		{`C:\path\`, "ansi", `'C:\path\'`},
+		{`C:\path\`, "mysql", `'C:\\path\\'`},
+		{`\'`, "mysql", `'\\'''`},
+		{[]string{`a\`}, "mysql", `'["a\\\\"]'`},
+	}
+
+	for _, test := range tests {
+		if got := sqlValue(test.in, test.dialect); got != test.out {
+			t.Errorf("expected %s got %s", test.out, got)
+		}
+	}
+}
+
+func TestSQLLookup(t *testing.T) {
+	info := GetFuncLookup("sql")
+
+	m := map[string][]string{
+		"table":    {"people"},
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+		},
+	}
+	_, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestSQLNullProbability(t *testing.T) {
+	for _, prob := range []float32{1, 0} {
+		value, err := SQL(&SQLOptions{
+			Table:    "people",
+			RowCount: 100,
+			Fields: []Field{
+				{Name: "first_name", Function: "firstname", NullProbability: prob},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		nulls := strings.Count(string(value), "(NULL)")
+		if prob == 1 && nulls != 100 {
+			t.Fatalf("expected 100 NULL values with probability 1, got %d", nulls)
+		}
+		if prob == 0 && nulls != 0 {
+			t.Fatalf("expected no NULL values with probability 0, got %d", nulls)
+		}
+	}
+}
@@ -1,31 +1,54 @@
 package gofakeit
 
-import (
-	"math/rand"
-)
+import "unicode/utf8"
 
 // Letter will generate a single random lower case ASCII letter
-func Letter() string {
-	return string(randLetter())
+func Letter() string { return letter(globalFaker.ctx()) }
+
+// Letter will generate a single random lower case ASCII letter
+func (f *Faker) Letter() string { return letter(f.ctx()) }
+
+func letter(r fakerCtx) string {
+	return string(randLetter(r))
 }
 
 // Digit will generate a single ASCII digit
-func Digit() string {
-	return string(randDigit())
+func Digit() string { return digit(globalFaker.ctx()) }
+
+// Digit will generate a single ASCII digit
+func (f *Faker) Digit() string { return digit(f.ctx()) }
+
+func digit(r fakerCtx) string {
+	return string(randDigit(r))
 }
 
 // Numerify will replace # with random numerical values
-func Numerify(str string) string {
-	return replaceWithNumbers(str)
+func Numerify(str string) string { return numerify(globalFaker.ctx(), str) }
+
+// Numerify will replace # with random numerical values
+func (f *Faker) Numerify(str string) string { return numerify(f.ctx(), str) }
+
+func numerify(r fakerCtx, str string) string {
+	return replaceWithNumbers(r, str)
 }
 
 // Lexify will replace ? will random generated letters
-func Lexify(str string) string {
-	return replaceWithLetters(str)
+func Lexify(str string) string { return lexify(globalFaker.ctx(), str) }
+
+// Lexify will replace ? will random generated letters
+func (f *Faker) Lexify(str string) string { return lexify(f.ctx(), str) }
+
+func lexify(r fakerCtx, str string) string {
+	return replaceWithLetters(r, str)
 }
 
 // ShuffleStrings will randomize a slice of strings
-func ShuffleStrings(a []string) {
+func ShuffleStrings(a []string) { shuffleStrings(globalFaker.ctx(), a) }
+
+// ShuffleStrings will randomize a slice of strings
+func (f *Faker) ShuffleStrings(a []string) { shuffleStrings(f.ctx(), a) }
+
+func shuffleStrings(r fakerCtx, a []string) {
 	swap := func(i, j int) {
 		a[i], a[j] = a[j], a[i]
 	}
@@ -38,13 +61,18 @@ func ShuffleStrings(a []string) {
 	//if size is > int32 probably it will never finish, or ran out of entropy
 	i := n - 1
 	for ; i > 0; i-- {
-		j := int(rand.Int31n(int32(i + 1)))
+		j := int(r.Int31n(int32(i + 1)))
 		swap(i, j)
 	}
 }
 
 // RandomString will take in a slice of string and return a randomly selected value
-func RandomString(a []string) string {
+func RandomString(a []string) string { return randomString(globalFaker.ctx(), a) }
+
+// RandomString will take in a slice of string and return a randomly selected value
+func (f *Faker) RandomString(a []string) string { return randomString(f.ctx(), a) }
+
+func randomString(r fakerCtx, a []string) string {
 	size := len(a)
 	if size == 0 {
 		return ""
@@ -52,7 +80,49 @@ func RandomString(a []string) string {
 	if size == 1 {
 		return a[0]
 	}
-	return a[rand.Intn(size)]
+	return a[r.Intn(size)]
+}
+
+// ClampString will truncate s to at most max characters, a max of 0 or less leaves s as is
+func ClampString(s string, max int) string { return clampString(s, max) }
+
+// ClampString will truncate s to at most max characters, a max of 0 or less leaves s as is
+func (f *Faker) ClampString(s string, max int) string { return clampString(s, max) }
+
+func clampString(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+
+	n := 0
+	for i := range s {
+		if n == max {
+			return s[:i]
+		}
+		n++
+	}
+	return s
+}
+
+// ClampStringBytes will truncate s to at most max bytes without splitting a multibyte character,
+// a max of 0 or less leaves s as is
+func ClampStringBytes(s string, max int) string { return clampStringBytes(s, max) }
+
+// ClampStringBytes will truncate s to at most max bytes without splitting a multibyte character,
+// a max of 0 or less leaves s as is
+func (f *Faker) ClampStringBytes(s string, max int) string { return clampStringBytes(s, max) }
+
+func clampStringBytes(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+
+	// Back up to the start of the character that would be cut in half
+	cut := max
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
 }
 
 func addStringLookup() {
@@ -62,8 +132,8 @@ func addStringLookup() {
 		Description: "Generate a single random lower case ASCII letter",
 		Example:     "g",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Letter(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return letter(r), nil
 		},
 	})
 
@@ -73,8 +143,8 @@ func addStringLookup() {
 		Description: "Generate a single random lower case ASCII letter",
 		Example:     "g",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Letter(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return letter(r), nil
 		},
 	})
 
@@ -87,13 +157,13 @@ func addStringLookup() {
 		Params: []Param{
 			{Field: "str", Display: "String", Type: "string", Description: "String value to replace #'s"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			str, err := info.GetString(m, "str")
 			if err != nil {
 				return nil, err
 			}
 
-			return Numerify(str), nil
+			return numerify(r, str), nil
 		},
 	})
 
@@ -106,13 +176,13 @@ func addStringLookup() {
 		Params: []Param{
 			{Field: "str", Display: "String", Type: "string", Description: "String value to replace #'s"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			str, err := info.GetString(m, "str")
 			if err != nil {
 				return nil, err
 			}
 
-			return Lexify(str), nil
+			return lexify(r, str), nil
 		},
 	})
 
@@ -125,13 +195,13 @@ func addStringLookup() {
 		Params: []Param{
 			{Field: "strs", Display: "Strings", Type: "[]string", Description: "Delimited separated strings"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			strs, err := info.GetStringArray(m, "strs")
 			if err != nil {
 				return nil, err
 			}
 
-			ShuffleStrings(strs)
+			shuffleStrings(r, strs)
 
 			return strs, nil
 		},
@@ -146,13 +216,13 @@ func addStringLookup() {
 		Params: []Param{
 			{Field: "strs", Display: "Strings", Type: "[]string", Description: "Delimited separated strings"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			strs, err := info.GetStringArray(m, "strs")
 			if err != nil {
 				return nil, err
 			}
 
-			return RandomString(strs), nil
+			return randomString(r, strs), nil
 		},
 	})
 }
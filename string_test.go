@@ -1,8 +1,11 @@
 package gofakeit
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
 	"testing"
+	"unicode/utf8"
 )
 
 func ExampleLetter() {
@@ -114,3 +117,87 @@ func BenchmarkRandomString(b *testing.B) {
 		RandomString([]string{"hello", "world"})
 	}
 }
+
+func ExampleClampString() {
+	fmt.Println(ClampString("Ünïcödé text", 7))
+	fmt.Println(ClampStringBytes("Ünïcödé text", 7))
+	// Output: Ünïcödé
+	// Ünïc
+}
+
+func TestClampString(t *testing.T) {
+	tests := []struct {
+		s     string
+		max   int
+		runes string
+		bytes string
+	}{
+		{"hello world", 5, "hello", "hello"},
+		{"hello", 10, "hello", "hello"},
+		{"hello", 0, "hello", "hello"},
+		{"hello", -1, "hello", "hello"},
+		{"日本語テキスト", 3, "日本語", "日"},
+		{"日本語テキスト", 2, "日本", ""},
+		{"a😀b", 2, "a😀", "a"},
+		{"", 3, "", ""},
+	}
+	for _, test := range tests {
+		if got := ClampString(test.s, test.max); got != test.runes {
+			t.Errorf("ClampString(%q, %d) = %q, want %q", test.s, test.max, got, test.runes)
+		}
+		if got := ClampStringBytes(test.s, test.max); got != test.bytes {
+			t.Errorf("ClampStringBytes(%q, %d) = %q, want %q", test.s, test.max, got, test.bytes)
+		}
+	}
+
+	// Every cut of multibyte content stays valid
+	s := "Ñandú 日本語 😀🎉 naïve"
+	for max := 1; max <= len(s); max++ {
+		if got := ClampString(s, max); !utf8.ValidString(got) || utf8.RuneCountInString(got) > max {
+			t.Fatalf("ClampString(%q, %d) = %q is invalid", s, max, got)
+		}
+		if got := ClampStringBytes(s, max); !utf8.ValidString(got) || len(got) > max {
+			t.Fatalf("ClampStringBytes(%q, %d) = %q is invalid", s, max, got)
+		}
+	}
+}
+
+func TestFieldMaxLen(t *testing.T) {
+	strs := map[string][]string{"strs": {"Ñandú", "日本語テキスト", "😀🎉😀🎉"}}
+	value, err := CSV(&CSVOptions{
+		RowCount: 50,
+		Fields: []Field{
+			{Name: "runes", Function: "randomstring", Params: strs, MaxLen: 3},
+			{Name: "bytes", Function: "randomstring", Params: strs, MaxLen: 5, MaxLenBytes: true},
+			{Name: "sentence", Function: "sentence", Params: map[string][]string{"wordcount": {"10"}}, MaxLen: 12},
+			{Name: "id", Function: "number", Params: map[string][]string{"min": {"100"}, "max": {"999"}}, MaxLen: 1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(value)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range records[1:] {
+		for _, v := range record[:3] {
+			if !utf8.ValidString(v) {
+				t.Fatalf("invalid utf-8 value %q", v)
+			}
+		}
+		if utf8.RuneCountInString(record[0]) > 3 {
+			t.Errorf("expected at most 3 characters got %q", record[0])
+		}
+		if len(record[1]) > 5 {
+			t.Errorf("expected at most 5 bytes got %q", record[1])
+		}
+		if utf8.RuneCountInString(record[2]) > 12 {
+			t.Errorf("expected at most 12 characters got %q", record[2])
+		}
+		if len(record[3]) != 3 {
+			t.Errorf("expected numbers to be left as is got %q", record[3])
+		}
+	}
+}
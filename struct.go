@@ -1,6 +1,9 @@
 package gofakeit
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -8,164 +11,499 @@ import (
 
 // Struct fills in exported elements of a struct with random data
 // based on the value of `fake` tag of exported elements.
-// Use `fake:"skip"` to explicitly skip an element.
+// Use `fake:"skip"` or `fake:"-"` to explicitly skip an element.
+// Pointers are allocated and filled, use `fakenil:"0.25"` to leave one nil with that chance.
 // All built-in types are supported, with templating support
-// for string types.
-func Struct(v interface{}) {
-	r(reflect.TypeOf(v), reflect.ValueOf(v), "", 0)
+// for string types. Numeric types also accept `fake:"{range:1,100}"`
+// to pick a value within min and max, clamped to what the type can hold.
+// Maps get the number of entries from their tag, like `fake:"{number:1,5}"`, or fakesize.
+// Tags that can not be used fall back to a random value, use StructE to get an error instead.
+func Struct(v interface{}) { structFunc(globalFaker.ctx(), v) }
+
+// Struct fills in exported elements of a struct with random data
+// based on the value of `fake` tag of exported elements.
+// Use `fake:"skip"` or `fake:"-"` to explicitly skip an element.
+// Pointers are allocated and filled, use `fakenil:"0.25"` to leave one nil with that chance.
+// All built-in types are supported, with templating support
+// for string types. Numeric types also accept `fake:"{range:1,100}"`
+// to pick a value within min and max, clamped to what the type can hold.
+// Maps get the number of entries from their tag, like `fake:"{number:1,5}"`, or fakesize.
+// Tags that can not be used fall back to a random value, use StructE to get an error instead.
+func (f *Faker) Struct(v interface{}) { structFunc(f.ctx(), v) }
+
+// StructE fills in a struct the same as Struct and returns an error for the first tag
+// that could not be used, prefixed with the path of its field like User.Address.Zip
+func StructE(v interface{}) error { return structE(globalFaker.ctx(), v) }
+
+// StructE fills in a struct the same as Struct and returns an error for the first tag
+// that could not be used, prefixed with the path of its field like User.Address.Zip
+func (f *Faker) StructE(v interface{}) error { return structE(f.ctx(), v) }
+
+// MustStruct fills in a struct the same as Struct and panics if StructE would return an error
+func MustStruct(v interface{}) { mustStruct(globalFaker.ctx(), v) }
+
+// MustStruct fills in a struct the same as Struct and panics if StructE would return an error
+func (f *Faker) MustStruct(v interface{}) { mustStruct(f.ctx(), v) }
+
+func structFunc(ra fakerCtx, v interface{}) {
+	r(ra, reflect.TypeOf(v), reflect.ValueOf(v), "", 0)
+}
+
+// Slice fills in a pointer to a slice with random data using the same rules as Struct.
+// A slice that already has a length, like one made with make([]User, 50), has each
+// of its elements filled, otherwise a random amount of 1 to 10 elements is added.
+// Slices within the elements are sized with their `fakesize` tag.
+func Slice(v interface{}) { sliceFunc(globalFaker.ctx(), v) }
+
+// Slice fills in a pointer to a slice with random data using the same rules as Struct.
+// A slice that already has a length, like one made with make([]User, 50), has each
+// of its elements filled, otherwise a random amount of 1 to 10 elements is added.
+// Slices within the elements are sized with their `fakesize` tag.
+func (f *Faker) Slice(v interface{}) { sliceFunc(f.ctx(), v) }
+
+func sliceFunc(ra fakerCtx, v interface{}) {
+	pv := reflect.ValueOf(v)
+	if pv.Kind() != reflect.Ptr || pv.IsNil() || pv.Elem().Kind() != reflect.Slice {
+		return
+	}
+
+	sv := pv.Elem()
+	if sv.Len() == 0 {
+		rSlice(ra, sv.Type(), sv, "", number(ra, 1, 10))
+		return
+	}
+
+	elemT := sv.Type().Elem()
+	for i := 0; i < sv.Len(); i++ {
+		r(ra, elemT, sv.Index(i), "", number(ra, 1, 10))
+	}
+}
+
+func structE(ra fakerCtx, v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || reflect.ValueOf(v).IsNil() {
+		return errors.New("Must pass a pointer to fill in")
+	}
+
+	err := r(ra, t, reflect.ValueOf(v), "", 0)
+	if se, ok := err.(*structError); ok && t.Elem().Name() != "" {
+		se.path = append([]string{t.Elem().Name()}, se.path...)
+	}
+	return err
 }
 
-func r(t reflect.Type, v reflect.Value, template string, size int) {
+func mustStruct(ra fakerCtx, v interface{}) {
+	if err := structE(ra, v); err != nil {
+		panic(err)
+	}
+}
+
+// structError is a tag error along with the path of the field it was set on
+type structError struct {
+	path []string
+	err  error
+}
+
+func (e *structError) Error() string {
+	var path string
+	for _, p := range e.path {
+		if path != "" && !strings.HasPrefix(p, "[") {
+			path += "."
+		}
+		path += p
+	}
+	return path + ": " + e.err.Error()
+}
+
+// structErrorPath adds name to the front of the path of err
+func structErrorPath(name string, err error) error {
+	if se, ok := err.(*structError); ok {
+		se.path = append([]string{name}, se.path...)
+		return se
+	}
+	return &structError{path: []string{name}, err: err}
+}
+
+// r fills in v and returns the first tag error. Invalid tags still
+// get a random value so Struct can carry on and ignore the error
+func r(ra fakerCtx, t reflect.Type, v reflect.Value, template string, size int) error {
 	switch t.Kind() {
 	case reflect.Ptr:
-		rPointer(t, v, template)
+		return rPointer(ra, t, v, template)
 	case reflect.Struct:
-		rStruct(t, v)
+		return rStruct(ra, t, v)
 	case reflect.String:
-		rString(t, v, template)
+		return rString(ra, t, v, template)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		rUint(t, v, template)
+		return rUint(ra, t, v, template)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		rInt(t, v, template)
+		return rInt(ra, t, v, template)
 	case reflect.Float32, reflect.Float64:
-		rFloat(t, v, template)
+		return rFloat(ra, t, v, template)
 	case reflect.Bool:
-		rBool(t, v, template)
+		return rBool(ra, t, v, template)
 	case reflect.Array, reflect.Slice:
-		rSlice(t, v, template, size)
+		return rSlice(ra, t, v, template, size)
+	case reflect.Map:
+		return rMap(ra, t, v, template, size)
 	}
+	return nil
 }
 
-func rStruct(t reflect.Type, v reflect.Value) {
+func rStruct(ra fakerCtx, t reflect.Type, v reflect.Value) error {
+	var firstErr error
 	n := t.NumField()
 	for i := 0; i < n; i++ {
 		elementT := t.Field(i)
 		elementV := v.Field(i)
 		t, ok := elementT.Tag.Lookup("fake")
-		if ok && t == "skip" {
+		if ok && (t == "skip" || t == "-") {
 			// Do nothing, skip it
 		} else if elementV.CanSet() {
+			// Check if a pointer should be left nil
+			if fn, ok := elementT.Tag.Lookup("fakenil"); ok && elementT.Type.Kind() == reflect.Ptr {
+				chance, err := strconv.ParseFloat(fn, 64)
+				if err != nil || chance < 0 || chance > 1 {
+					if firstErr == nil {
+						firstErr = structErrorPath(elementT.Name, fmt.Errorf("invalid fakenil tag %s, must be a chance between 0 and 1", fn))
+					}
+				} else if ra.Float64() < chance {
+					elementV.Set(reflect.Zero(elementT.Type))
+					continue
+				}
+			}
+
 			// Check if fakesize is set
-			size := Number(1, 10)
+			size := number(ra, 1, 10)
 			fs, ok := elementT.Tag.Lookup("fakesize")
 			if ok {
 				var err error
 				size, err = strconv.Atoi(fs)
 				if err != nil {
-					size = Number(1, 10)
+					size = number(ra, 1, 10)
+					if firstErr == nil {
+						firstErr = structErrorPath(elementT.Name, fmt.Errorf("invalid fakesize tag %s", fs))
+					}
 				}
 			}
-			r(elementT.Type, elementV, t, size)
+			if err := r(ra, elementT.Type, elementV, t, size); err != nil && firstErr == nil {
+				firstErr = structErrorPath(elementT.Name, err)
+			}
 		}
 	}
+	return firstErr
 }
 
-func rPointer(t reflect.Type, v reflect.Value, template string) {
+func rPointer(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
 	elemT := t.Elem()
 	if v.IsNil() {
 		nv := reflect.New(elemT)
-		r(elemT, nv.Elem(), template, 0)
+		err := r(ra, elemT, nv.Elem(), template, 0)
 		v.Set(nv)
-	} else {
-		r(elemT, v.Elem(), template, 0)
+		return err
 	}
+	return r(ra, elemT, v.Elem(), template, 0)
 }
 
-func rSlice(t reflect.Type, v reflect.Value, template string, size int) {
+func rSlice(ra fakerCtx, t reflect.Type, v reflect.Value, template string, size int) error {
+	var firstErr error
 	elemT := t.Elem()
 
 	if v.CanSet() {
 		for i := 0; i < size; i++ {
 			nv := reflect.New(elemT)
-			r(elemT, nv.Elem(), template, size)
+			if err := r(ra, elemT, nv.Elem(), template, size); err != nil && firstErr == nil {
+				firstErr = structErrorPath("["+strconv.Itoa(i)+"]", err)
+			}
 			v.Set(reflect.Append(reflect.Indirect(v), reflect.Indirect(nv)))
 		}
 	}
+	return firstErr
 }
 
-func rString(t reflect.Type, v reflect.Value, template string) {
+// rMap adds entries with random keys and values. The count comes from the template,
+// like `fake:"{number:1,5}"`, falling back to the fakesize tag
+func rMap(ra fakerCtx, t reflect.Type, v reflect.Value, template string, size int) error {
+	if !v.CanSet() {
+		return nil
+	}
+
+	var err error
 	if template != "" {
-		v.SetString(Generate(template))
-	} else {
-		v.SetString(Generate(strings.Repeat("?", Number(4, 10))))
+		count, cerr := strconv.Atoi(generate(ra, template))
+		if cerr == nil && count >= 0 {
+			size = count
+		} else {
+			err = fmt.Errorf("invalid tag %s, value could not convert to a map size", template)
+		}
 	}
+
+	keyT := t.Key()
+	elemT := t.Elem()
+	if v.IsNil() {
+		v.Set(reflect.MakeMapWithSize(t, size))
+	}
+
+	// Keys can repeat so keep trying, within reason for small key types like bool
+	for tries := 0; v.Len() < size && tries < size*10; tries++ {
+		nk := reflect.New(keyT)
+		if kerr := r(ra, keyT, nk.Elem(), "", size); kerr != nil && err == nil {
+			err = kerr
+		}
+		nv := reflect.New(elemT)
+		if verr := r(ra, elemT, nv.Elem(), "", size); verr != nil && err == nil {
+			err = structErrorPath(fmt.Sprintf("[%v]", nk.Elem().Interface()), verr)
+		}
+		v.SetMapIndex(nk.Elem(), nv.Elem())
+	}
+	return err
 }
 
-func rInt(t reflect.Type, v reflect.Value, template string) {
+func rString(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
 	if template != "" {
-		i, err := strconv.ParseInt(Generate(template), 10, 64)
-		if err == nil {
+		v.SetString(generate(ra, template))
+		return structTemplateCheck(template)
+	}
+
+	v.SetString(generate(ra, strings.Repeat("?", number(ra, 4, 10))))
+	return nil
+}
+
+// structTemplateCheck returns an error for functions in a template that do not exist,
+// generate would otherwise replace them with n/a
+func structTemplateCheck(template string) error {
+	for _, part := range strings.Split(template, "{")[1:] {
+		end := strings.Index(part, "}")
+		if end < 0 {
+			break
+		}
+		name := strings.SplitN(part[:end], ":", 2)[0]
+		if GetFuncLookup(name) == nil {
+			return fmt.Errorf("invalid tag %s, %s is not a function", template, name)
+		}
+	}
+	return nil
+}
+
+// structTagError is returned when a template could not be used for a numeric or bool type
+func structTagError(template string, t reflect.Type) error {
+	if strings.HasPrefix(template, "{range:") {
+		return fmt.Errorf("invalid tag %s, range must be {range:min,max} within %s", template, t.Kind())
+	}
+	return fmt.Errorf("invalid tag %s, value could not convert to %s", template, t.Kind())
+}
+
+func rInt(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
+	var err error
+	if min, max, ok := structRange(template); ok {
+		if i, ok := structRangeInt(ra, t, min, max); ok {
+			v.SetInt(i)
+			return nil
+		}
+		err = structTagError(template, t)
+	} else if template != "" {
+		i, perr := strconv.ParseInt(generate(ra, template), 10, 64)
+		if perr == nil && !v.OverflowInt(i) {
 			v.SetInt(i)
-			return
+			return nil
 		}
+		err = structTagError(template, t)
 	}
 
 	// If no template or error converting to int, set with random value
 	switch t.Kind() {
 	case reflect.Int:
-		v.SetInt(Int64())
+		v.SetInt(int64Func(ra))
 	case reflect.Int8:
-		v.SetInt(int64(Int8()))
+		v.SetInt(int64(int8Func(ra)))
 	case reflect.Int16:
-		v.SetInt(int64(Int16()))
+		v.SetInt(int64(int16Func(ra)))
 	case reflect.Int32:
-		v.SetInt(int64(Int32()))
+		v.SetInt(int64(int32Func(ra)))
 	case reflect.Int64:
-		v.SetInt(Int64())
+		v.SetInt(int64Func(ra))
 	}
+	return err
 }
 
-func rUint(t reflect.Type, v reflect.Value, template string) {
-	if template != "" {
-		u, err := strconv.ParseUint(Generate(template), 10, 64)
-		if err == nil {
+func rUint(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
+	var err error
+	if min, max, ok := structRange(template); ok {
+		if u, ok := structRangeUint(ra, t, min, max); ok {
+			v.SetUint(u)
+			return nil
+		}
+		err = structTagError(template, t)
+	} else if template != "" {
+		u, perr := strconv.ParseUint(generate(ra, template), 10, 64)
+		if perr == nil && !v.OverflowUint(u) {
 			v.SetUint(u)
-			return
+			return nil
 		}
+		err = structTagError(template, t)
 	}
 
 	// If no template or error converting to uint, set with random value
 	switch t.Kind() {
 	case reflect.Uint:
-		v.SetUint(Uint64())
+		v.SetUint(uint64Func(ra))
 	case reflect.Uint8:
-		v.SetUint(uint64(Uint8()))
+		v.SetUint(uint64(uint8Func(ra)))
 	case reflect.Uint16:
-		v.SetUint(uint64(Uint16()))
+		v.SetUint(uint64(uint16Func(ra)))
 	case reflect.Uint32:
-		v.SetUint(uint64(Uint32()))
+		v.SetUint(uint64(uint32Func(ra)))
 	case reflect.Uint64:
-		v.SetUint(Uint64())
+		v.SetUint(uint64Func(ra))
 	}
+	return err
 }
 
-func rFloat(t reflect.Type, v reflect.Value, template string) {
-	if template != "" {
-		f, err := strconv.ParseFloat(Generate(template), 64)
-		if err == nil {
+func rFloat(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
+	var err error
+	if min, max, ok := structRange(template); ok {
+		if f, ok := structRangeFloat(ra, t, min, max); ok {
 			v.SetFloat(f)
-			return
+			return nil
 		}
+		err = structTagError(template, t)
+	} else if template != "" {
+		f, perr := strconv.ParseFloat(generate(ra, template), 64)
+		if perr == nil && !v.OverflowFloat(f) {
+			v.SetFloat(f)
+			return nil
+		}
+		err = structTagError(template, t)
 	}
 
 	// If no template or error converting to float, set with random value
 	switch t.Kind() {
 	case reflect.Float64:
-		v.SetFloat(Float64())
+		v.SetFloat(float64Func(ra))
 	case reflect.Float32:
-		v.SetFloat(float64(Float32()))
+		v.SetFloat(float64(float32Func(ra)))
 	}
+	return err
 }
 
-func rBool(t reflect.Type, v reflect.Value, template string) {
+func rBool(ra fakerCtx, t reflect.Type, v reflect.Value, template string) error {
 	if template != "" {
-		b, err := strconv.ParseBool(Generate(template))
+		b, err := strconv.ParseBool(generate(ra, template))
 		if err == nil {
 			v.SetBool(b)
-			return
+			return nil
 		}
 	}
 
 	// If no template or error converting to boolean, set with random value
-	v.SetBool(Bool())
+	v.SetBool(boolFunc(ra))
+	if template != "" {
+		return structTagError(template, t)
+	}
+	return nil
+}
+
+// structRange returns the min and max of a {range:min,max} template
+func structRange(template string) (string, string, bool) {
+	if !strings.HasPrefix(template, "{range:") || !strings.HasSuffix(template, "}") {
+		return "", "", false
+	}
+
+	parts := strings.Split(template[len("{range:"):len(template)-1], ",")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), true
+}
+
+// structRangeInt picks an int between min and max, clamped to the bounds of the int type
+func structRangeInt(ra fakerCtx, t reflect.Type, minStr, maxStr string) (int64, bool) {
+	min, err := strconv.ParseInt(minStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	max, err := strconv.ParseInt(maxStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	typeMax := int64(1)<<(uint(t.Bits())-1) - 1
+	typeMin := -typeMax - 1
+	if min < typeMin {
+		min = typeMin
+	}
+	if max > typeMax {
+		max = typeMax
+	}
+	if min > max {
+		return 0, false
+	}
+
+	return min + int64(randUint64n(ra, uint64(max-min))), true
+}
+
+// structRangeUint picks a uint between min and max, clamped to the bounds of the uint type
+func structRangeUint(ra fakerCtx, t reflect.Type, minStr, maxStr string) (uint64, bool) {
+	min, err := strconv.ParseUint(minStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	max, err := strconv.ParseUint(maxStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	typeMax := uint64(1)<<uint(t.Bits()) - 1
+	if max > typeMax {
+		max = typeMax
+	}
+	if min > max {
+		return 0, false
+	}
+
+	return min + randUint64n(ra, max-min), true
+}
+
+// structRangeFloat picks a float between min and max, clamped to the bounds of the float type
+func structRangeFloat(ra fakerCtx, t reflect.Type, minStr, maxStr string) (float64, bool) {
+	min, err := strconv.ParseFloat(minStr, 64)
+	if err != nil {
+		return 0, false
+	}
+	max, err := strconv.ParseFloat(maxStr, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	if t.Kind() == reflect.Float32 {
+		if min < -math.MaxFloat32 {
+			min = -math.MaxFloat32
+		}
+		if max > math.MaxFloat32 {
+			max = math.MaxFloat32
+		}
+	}
+	if min > max {
+		return 0, false
+	}
+
+	return randFloat64Range(ra, min, max), true
+}
+
+// randUint64n returns a random number between 0 and max inclusive
+func randUint64n(ra fakerCtx, max uint64) uint64 {
+	if max == math.MaxUint64 {
+		return ra.Uint64()
+	}
+	if max < math.MaxInt64 {
+		return uint64(ra.Int63n(int64(max + 1)))
+	}
+
+	// Reject values past max so every value is equally likely
+	for {
+		if n := ra.Uint64(); n <= max {
+			return n
+		}
+	}
 }
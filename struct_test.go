@@ -2,6 +2,7 @@ package gofakeit
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -257,6 +258,75 @@ func TestStructToFloat(t *testing.T) {
 	}
 }
 
+func TestStructNumberRange(t *testing.T) {
+	Seed(11)
+
+	var sr struct {
+		Number     int     `fake:"{number:1,100}"`
+		Range      int     `fake:"{range:1,100}"`
+		Negative   int32   `fake:"{range:-50,-10}"`
+		Clamped    int8    `fake:"{range:-500,500}"`
+		Uint       uint16  `fake:"{range:1,100}"`
+		UintClamp  uint8   `fake:"{range:200,1000}"`
+		Float      float64 `fake:"{range:1,100}"`
+		Float32    float32 `fake:"{range: 0.5, 1.5}"`
+		Overflow   int8    `fake:"{number:1000,2000}"`
+		Pointer    *int    `fake:"{range:1,100}"`
+		Equal      int     `fake:"{range:7,7}"`
+		FullRange  int64   `fake:"{range:-9223372036854775808,9223372036854775807}"`
+		FullUint64 uint64  `fake:"{range:0,18446744073709551615}"`
+	}
+
+	for i := 0; i < 1000; i++ {
+		Struct(&sr)
+
+		if sr.Number < 1 || sr.Number > 100 {
+			t.Fatalf("Number should be between 1-100 but got %d", sr.Number)
+		}
+		if sr.Range < 1 || sr.Range > 100 {
+			t.Fatalf("Range should be between 1-100 but got %d", sr.Range)
+		}
+		if sr.Negative < -50 || sr.Negative > -10 {
+			t.Fatalf("Negative should be between -50 and -10 but got %d", sr.Negative)
+		}
+		if sr.Uint < 1 || sr.Uint > 100 {
+			t.Fatalf("Uint should be between 1-100 but got %d", sr.Uint)
+		}
+		if sr.UintClamp < 200 {
+			t.Fatalf("UintClamp should be between 200-255 but got %d", sr.UintClamp)
+		}
+		if sr.Float < 1 || sr.Float > 100 {
+			t.Fatalf("Float should be between 1-100 but got %f", sr.Float)
+		}
+		if sr.Float32 < 0.5 || sr.Float32 > 1.5 {
+			t.Fatalf("Float32 should be between 0.5-1.5 but got %f", sr.Float32)
+		}
+		if *sr.Pointer < 1 || *sr.Pointer > 100 {
+			t.Fatalf("Pointer should be between 1-100 but got %d", *sr.Pointer)
+		}
+		if sr.Equal != 7 {
+			t.Fatalf("Equal should be 7 but got %d", sr.Equal)
+		}
+	}
+}
+
+func TestStructNumberRangeInvalid(t *testing.T) {
+	Seed(11)
+
+	// Invalid ranges fall back to a random value instead of failing
+	var sr struct {
+		Reversed int   `fake:"{range:100,1}"`
+		NotInt   int   `fake:"{range:a,b}"`
+		Missing  int   `fake:"{range:1}"`
+		Negative uint8 `fake:"{range:-5,5}"`
+	}
+	Struct(&sr)
+
+	if sr.Reversed == 0 || sr.NotInt == 0 || sr.Missing == 0 || sr.Negative == 0 {
+		t.Errorf("invalid ranges should still be filled with random values, got %+v", sr)
+	}
+}
+
 func TestStructToBool(t *testing.T) {
 	Seed(11)
 
@@ -333,3 +403,333 @@ func Example_array() {
 	// [{MaRxH -6396943744266753635 Carole 4 <nil>}]
 	// [Amie Alice Zachary]
 }
+
+func ExampleSlice() {
+	Seed(11)
+
+	type Foo struct {
+		Name   string `fake:"{firstname}"`
+		Number int    `fake:"{number:1,10}"`
+	}
+
+	foos := make([]Foo, 3)
+	Slice(&foos)
+
+	fmt.Printf("%v\n", foos)
+
+	// Output: [{Alayna 5} {Lucinda 9} {Enrique 7}]
+}
+
+func TestSlice(t *testing.T) {
+	type Address struct {
+		Street string `fake:"{street}"`
+		City   string `fake:"{city}"`
+	}
+
+	type User struct {
+		Name      string     `fake:"{name}"`
+		Addresses []Address  `fakesize:"2"`
+		Previous  []*Address `fakesize:"3"`
+	}
+
+	check := func(users []User) {
+		for _, u := range users {
+			if u.Name == "" {
+				t.Fatal("expected user name to be set")
+			}
+			if len(u.Addresses) != 2 || len(u.Previous) != 3 {
+				t.Fatalf("expected 2 addresses and 3 previous, got %d and %d", len(u.Addresses), len(u.Previous))
+			}
+			for _, a := range u.Addresses {
+				if a.Street == "" || a.City == "" {
+					t.Fatalf("expected address to be filled, got %+v", a)
+				}
+			}
+			for _, a := range u.Previous {
+				if a == nil || a.Street == "" {
+					t.Fatalf("expected previous address to be filled, got %+v", a)
+				}
+			}
+		}
+	}
+
+	// Preallocated slices keep their length
+	users := make([]User, 25)
+	Slice(&users)
+	if len(users) != 25 {
+		t.Fatalf("expected 25 users, got %d", len(users))
+	}
+	check(users)
+
+	// Empty slices get a random amount
+	var random []User
+	Slice(&random)
+	if len(random) < 1 || len(random) > 10 {
+		t.Fatalf("expected 1 to 10 users, got %d", len(random))
+	}
+	check(random)
+
+	var names []string
+	Slice(&names)
+	if len(names) == 0 || names[0] == "" {
+		t.Errorf("expected strings to be filled, got %v", names)
+	}
+
+	// Anything but a pointer to a slice is left alone
+	Slice(users)
+	Slice(nil)
+	var notSlice User
+	Slice(&notSlice)
+	if notSlice.Name != "" {
+		t.Error("expected a struct pointer to be ignored")
+	}
+}
+
+func BenchmarkSlice(b *testing.B) {
+	type Foo struct {
+		Name  string `fake:"{firstname}"`
+		Email string `fake:"{email}"`
+	}
+
+	for i := 0; i < b.N; i++ {
+		foos := make([]Foo, 10)
+		Slice(&foos)
+	}
+}
+
+type structETestAddress struct {
+	Street string `fake:"{street}"`
+	Zip    int    `fake:"{range:a,b}"`
+}
+
+type structETestUser struct {
+	Name    string `fake:"{firstname}"`
+	Address structETestAddress
+}
+
+func ExampleStructE() {
+	Seed(11)
+
+	var u structETestUser
+	err := StructE(&u)
+	fmt.Println(err)
+
+	// Output: structETestUser.Address.Zip: invalid tag {range:a,b}, range must be {range:min,max} within int
+}
+
+func TestStructE(t *testing.T) {
+	var b Basic
+	if err := StructE(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.S == "" {
+		t.Error("expected struct to be filled in")
+	}
+
+	var u structETestUser
+	err := StructE(&u)
+	if err == nil {
+		t.Fatal("expected an error for an invalid range")
+	}
+	if !strings.HasPrefix(err.Error(), "structETestUser.Address.Zip: ") {
+		t.Errorf("expected error to have the field path, got %s", err)
+	}
+	if u.Name == "" || u.Address.Street == "" || u.Address.Zip == 0 {
+		t.Errorf("expected the rest of the struct to still be filled in, got %+v", u)
+	}
+
+	if err := StructE(u); err == nil {
+		t.Error("expected an error when not passing a pointer")
+	}
+	if err := StructE(nil); err == nil {
+		t.Error("expected an error when passing nil")
+	}
+}
+
+func TestStructEErrors(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		path string
+	}{
+		{&struct {
+			Name string `fake:"{notafunction}"`
+		}{}, "Name: "},
+		{&struct {
+			Age int8 `fake:"{number:1,1000}"`
+		}{}, "Age: "},
+		{&struct {
+			Active bool `fake:"{firstname}"`
+		}{}, "Active: "},
+		{&struct {
+			Tags []string `fake:"{word}" fakesize:"many"`
+		}{}, "Tags: "},
+		{&struct {
+			Items []struct {
+				Price float64 `fake:"{range:1}"`
+			} `fakesize:"2"`
+		}{}, "Items[0].Price: "},
+	}
+
+	for _, test := range tests {
+		err := StructE(test.v)
+		if err == nil {
+			t.Errorf("expected an error for %+v", test.v)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), test.path) {
+			t.Errorf("expected error to start with %s, got %s", test.path, err)
+		}
+	}
+}
+
+func TestMustStruct(t *testing.T) {
+	var b Basic
+	MustStruct(&b)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected MustStruct to panic on an invalid tag")
+		}
+	}()
+
+	var u structETestUser
+	MustStruct(&u)
+}
+
+func BenchmarkStructE(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var u structETestUser
+		StructE(&u)
+	}
+}
+
+func TestStructMap(t *testing.T) {
+	Seed(11)
+
+	type Item struct {
+		Name  string `fake:"{noun}"`
+		Price int    `fake:"{range:1,100}"`
+	}
+	var sm struct {
+		Labels  map[string]string `fake:"{number:3,3}"`
+		Counts  map[string]int    `fake:"5"`
+		Items   map[string]Item   `fakesize:"4"`
+		IntKeys map[int]float64   `fake:"{number:2,2}"`
+		Pointer *map[string]bool  `fake:"2"`
+		Empty   map[string]string `fake:"{number:0,0}"`
+	}
+	if err := StructE(&sm); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sm.Labels) != 3 {
+		t.Errorf("expected 3 labels, got %d", len(sm.Labels))
+	}
+	for k, v := range sm.Labels {
+		if k == "" || v == "" {
+			t.Errorf("expected label keys and values to be filled, got %q: %q", k, v)
+		}
+	}
+	if len(sm.Counts) != 5 {
+		t.Errorf("expected 5 counts, got %d", len(sm.Counts))
+	}
+	if len(sm.Items) != 4 {
+		t.Errorf("expected 4 items, got %d", len(sm.Items))
+	}
+	for _, item := range sm.Items {
+		if item.Name == "" || item.Price < 1 || item.Price > 100 {
+			t.Errorf("expected item to be filled from its tags, got %+v", item)
+		}
+	}
+	if len(sm.IntKeys) != 2 {
+		t.Errorf("expected 2 int keys, got %d", len(sm.IntKeys))
+	}
+	if sm.Pointer == nil || len(*sm.Pointer) != 2 {
+		t.Errorf("expected pointer to a map with 2 entries, got %v", sm.Pointer)
+	}
+	if sm.Empty == nil || len(sm.Empty) != 0 {
+		t.Errorf("expected an empty map, got %v", sm.Empty)
+	}
+}
+
+func TestStructMapInvalid(t *testing.T) {
+	var sm struct {
+		Labels map[string]string `fake:"{firstname}" fakesize:"2"`
+	}
+	err := StructE(&sm)
+	if err == nil || !strings.HasPrefix(err.Error(), "Labels: ") {
+		t.Errorf("expected an error for an invalid map size, got %v", err)
+	}
+	if len(sm.Labels) != 2 {
+		t.Errorf("expected fakesize to be used after an invalid tag, got %d", len(sm.Labels))
+	}
+}
+
+func TestStructPointers(t *testing.T) {
+	Seed(11)
+
+	var sp struct {
+		Int    *int
+		String *string `fake:"{firstname}"`
+		Nested *Basic
+		Double **float32
+		Dash   *string `fake:"-"`
+		Zero   int     `fake:"-"`
+		Always *int    `fakenil:"1"`
+		Never  *int    `fakenil:"0"`
+	}
+	if err := StructE(&sp); err != nil {
+		t.Fatal(err)
+	}
+
+	if sp.Int == nil {
+		t.Error("expected *int to be allocated")
+	}
+	if sp.String == nil || *sp.String == "" {
+		t.Error("expected *string to be allocated and filled")
+	}
+	if sp.Nested == nil || sp.Nested.S == "" {
+		t.Error("expected *Basic to be allocated and filled")
+	}
+	if sp.Double == nil || *sp.Double == nil {
+		t.Error("expected **float32 to be allocated")
+	}
+	if sp.Dash != nil || sp.Zero != 0 {
+		t.Errorf("expected fields tagged - to be skipped, got %v and %d", sp.Dash, sp.Zero)
+	}
+	if sp.Always != nil {
+		t.Error("expected fakenil 1 to leave the pointer nil")
+	}
+	if sp.Never == nil {
+		t.Error("expected fakenil 0 to allocate the pointer")
+	}
+}
+
+func TestStructPointerNilChance(t *testing.T) {
+	Seed(11)
+
+	nils := 0
+	for i := 0; i < 1000; i++ {
+		var sp struct {
+			Maybe *string `fakenil:"0.3"`
+		}
+		Struct(&sp)
+		if sp.Maybe == nil {
+			nils++
+		}
+	}
+	if nils < 200 || nils > 400 {
+		t.Errorf("expected about 300 nil pointers, got %d", nils)
+	}
+
+	var invalid struct {
+		Maybe *string `fakenil:"often"`
+	}
+	err := StructE(&invalid)
+	if err == nil || !strings.HasPrefix(err.Error(), "Maybe: ") {
+		t.Errorf("expected an error for an invalid fakenil tag, got %v", err)
+	}
+	if invalid.Maybe == nil {
+		t.Error("expected an invalid fakenil tag to still fill the pointer")
+	}
+}
@@ -0,0 +1,58 @@
+package gofakeit
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// telemetryDevices are the device types a telemetry device id starts with
+var telemetryDevices = []string{"sensor", "thermostat", "gateway", "meter", "tracker", "beacon"}
+
+// TelemetryInfo is a struct containing a single reading from an iot device
+type TelemetryInfo struct {
+	DeviceID    string    `json:"device_id" xml:"device_id"`
+	Timestamp   time.Time `json:"timestamp" xml:"timestamp"`
+	Temperature float64   `json:"temperature" xml:"temperature"` // celsius from -40 to 85
+	Humidity    float64   `json:"humidity" xml:"humidity"`       // relative percent from 0 to 100
+	Battery     int       `json:"battery" xml:"battery"`         // percent from 0 to 100
+	Lat         float64   `json:"lat" xml:"lat"`
+	Lng         float64   `json:"lng" xml:"lng"`
+}
+
+// Telemetry will generate a struct with a device reading, temperature is in celsius from -40 to 85,
+// humidity is a relative percent from 0 to 100 and battery is a percent from 0 to 100
+func Telemetry() *TelemetryInfo { return telemetry(globalFaker.ctx()) }
+
+// Telemetry will generate a struct with a device reading, temperature is in celsius from -40 to 85,
+// humidity is a relative percent from 0 to 100 and battery is a percent from 0 to 100
+func (f *Faker) Telemetry() *TelemetryInfo { return telemetry(f.ctx()) }
+
+func telemetry(r fakerCtx) *TelemetryInfo {
+	id := []byte(randomString(r, telemetryDevices) + "-")
+	for i := 0; i < 6; i++ {
+		id = append(id, "0123456789abcdef"[r.Intn(16)])
+	}
+
+	return &TelemetryInfo{
+		DeviceID:    string(id),
+		Timestamp:   date(r),
+		Temperature: toFixed(randFloat64Range(r, -40, 85), 1),
+		Humidity:    toFixed(randFloat64Range(r, 0, 100), 1),
+		Battery:     number(r, 0, 100),
+		Lat:         latitude(r),
+		Lng:         longitude(r),
+	}
+}
+
+func addTelemetryLookup() {
+	AddFuncLookup("telemetry", Info{
+		Display:     "Telemetry",
+		Category:    "misc",
+		Description: "Random iot device reading with temperature, humidity, battery and location in json format",
+		Example:     `{"device_id":"sensor-754695","timestamp":"2005-10-21T05:49:35.292174773Z","temperature":12.3,"humidity":12.4,"battery":19,"lat":87.25234,"lng":-68.139829}`,
+		Output:      "[]byte",
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return json.Marshal(telemetry(r))
+		},
+	})
+}
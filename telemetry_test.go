@@ -0,0 +1,73 @@
+package gofakeit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func ExampleTelemetry() {
+	Seed(11)
+	reading := Telemetry()
+	fmt.Println(reading.DeviceID)
+	fmt.Println(reading.Temperature)
+	fmt.Println(reading.Humidity)
+	fmt.Println(reading.Battery)
+	fmt.Println(reading.Lat)
+	fmt.Println(reading.Lng)
+	// Output: sensor-754695
+	// 12.3
+	// 12.4
+	// 19
+	// 87.25234
+	// -68.139829
+}
+
+func TestTelemetry(t *testing.T) {
+	f := New(rand.NewSource(11))
+	for i := 0; i < 1000; i++ {
+		reading := f.Telemetry()
+		if !strings.Contains(reading.DeviceID, "-") || reading.Timestamp.IsZero() {
+			t.Fatalf("expected a device id and timestamp got %+v", reading)
+		}
+		if reading.Temperature < -40 || reading.Temperature > 85 {
+			t.Fatalf("expected temperature between -40 and 85 got %v", reading.Temperature)
+		}
+		if reading.Humidity < 0 || reading.Humidity > 100 {
+			t.Fatalf("expected humidity between 0 and 100 got %v", reading.Humidity)
+		}
+		if reading.Battery < 0 || reading.Battery > 100 {
+			t.Fatalf("expected battery between 0 and 100 got %v", reading.Battery)
+		}
+		if reading.Lat < -90 || reading.Lat > 90 {
+			t.Fatalf("expected lat between -90 and 90 got %v", reading.Lat)
+		}
+		if reading.Lng < -180 || reading.Lng > 180 {
+			t.Fatalf("expected lng between -180 and 180 got %v", reading.Lng)
+		}
+	}
+}
+
+func TestTelemetryLookup(t *testing.T) {
+	info := GetFuncLookup("telemetry")
+	value, err := info.call(fakerCtx{Rand: rand.New(rand.NewSource(11))}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var reading TelemetryInfo
+	if err := json.Unmarshal(value.([]byte), &reading); err != nil {
+		t.Fatal(err)
+	}
+	if reading.DeviceID == "" {
+		t.Errorf("expected a device id got %s", value)
+	}
+}
+
+func BenchmarkTelemetry(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Telemetry()
+	}
+}
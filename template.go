@@ -0,0 +1,142 @@
+package gofakeit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// TemplateOptions defines values needed for template rendering
+type TemplateOptions struct {
+	Funcs template.FuncMap `json:"-" xml:"-"` // extra functions available to this render only
+	Data  interface{}      `json:"data" xml:"data"`
+}
+
+// templateFuncName is what text/template allows as a function name
+var templateFuncName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// TemplateE renders a text/template with every gofakeit function available by its lookup name,
+// with params passed in order like {{number 1 10}} or {{sentence 3}}.
+// Funcs in the options are only available for this render and take precedence over
+// gofakeit functions and the text/template builtins with the same name.
+//
+// repeat, also available as Repeat, calls a gofakeit function count times joined by a separator,
+// like {{repeat 5 ", " "firstname"}}, with any extra args passed as its params. The function name
+// is not case sensitive. Args are evaluated once before repeat runs, so a nested call like
+// {{repeat 3 " " "number" (number 1 5) 10}} passes the same min to every call. Repeat returns a
+// string so it can be nested as an arg or piped, but it can't repeat itself or a custom function
+func TemplateE(tmpl string, opts *TemplateOptions) (string, error) {
+	return templateE(globalFaker.ctx(), tmpl, opts)
+}
+
+// TemplateE renders a text/template with every gofakeit function available by its lookup name,
+// with params passed in order like {{number 1 10}} or {{sentence 3}}.
+// Funcs in the options are only available for this render and take precedence over
+// gofakeit functions and the text/template builtins with the same name.
+//
+// repeat, also available as Repeat, calls a gofakeit function count times joined by a separator,
+// like {{repeat 5 ", " "firstname"}}, with any extra args passed as its params. The function name
+// is not case sensitive. Args are evaluated once before repeat runs, so a nested call like
+// {{repeat 3 " " "number" (number 1 5) 10}} passes the same min to every call. Repeat returns a
+// string so it can be nested as an arg or piped, but it can't repeat itself or a custom function
+func (f *Faker) TemplateE(tmpl string, opts *TemplateOptions) (string, error) {
+	return templateE(f.ctx(), tmpl, opts)
+}
+
+func templateE(r fakerCtx, tmpl string, opts *TemplateOptions) (string, error) {
+	if opts == nil {
+		opts = &TemplateOptions{}
+	}
+
+	funcs := template.FuncMap{}
+	lookups := make(map[string]func(args ...interface{}) (interface{}, error))
+	lockFuncLookups.Lock()
+	for name, info := range FuncLookups {
+		fn := templateFunc(r, name, info)
+		lookups[name] = fn
+		if templateFuncName.MatchString(name) {
+			funcs[name] = fn
+		}
+	}
+	lockFuncLookups.Unlock()
+
+	repeat := templateRepeat(lookups)
+	funcs["repeat"] = repeat
+	funcs["Repeat"] = repeat
+
+	for name, fn := range opts.Funcs {
+		funcs[name] = fn
+	}
+
+	t, err := template.New("gofakeit").Funcs(funcs).Parse(tmpl)
+	if err != nil {
+		return "", errors.New("Unable to parse template, " + err.Error())
+	}
+
+	b := &bytes.Buffer{}
+	if err := t.Execute(b, opts.Data); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// templateRepeat calls a lookup count times and joins the values with sep
+func templateRepeat(lookups map[string]func(args ...interface{}) (interface{}, error)) func(count int, sep string, name string, args ...interface{}) (string, error) {
+	return func(count int, sep string, name string, args ...interface{}) (string, error) {
+		if count < 0 {
+			return "", errors.New("Repeat count must be 0 or more")
+		}
+
+		fn, ok := lookups[strings.ToLower(name)]
+		if !ok {
+			return "", errors.New("Invalid function, " + name + " does not exist")
+		}
+
+		values := make([]string, count)
+		for i := range values {
+			value, err := fn(args...)
+			if err != nil {
+				return "", err
+			}
+			values[i] = fmt.Sprintf("%v", value)
+		}
+
+		return strings.Join(values, sep), nil
+	}
+}
+
+// templateFunc calls a lookup with args mapped to its params in order,
+// slices of strings are passed as multiple values of a param
+func templateFunc(r fakerCtx, name string, info Info) func(args ...interface{}) (interface{}, error) {
+	return func(args ...interface{}) (interface{}, error) {
+		if len(args) > len(info.Params) {
+			return nil, fmt.Errorf("%s takes at most %d params, got %d", name, len(info.Params), len(args))
+		}
+
+		var m map[string][]string
+		for i, arg := range args {
+			if m == nil {
+				m = make(map[string][]string)
+			}
+			if values, ok := arg.([]string); ok {
+				m[info.Params[i].Field] = values
+			} else {
+				m[info.Params[i].Field] = []string{fmt.Sprintf("%v", arg)}
+			}
+		}
+
+		value, err := info.call(r, &m)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := value.([]byte); ok {
+			return string(b), nil
+		}
+
+		return value, nil
+	}
+}
@@ -0,0 +1,155 @@
+package gofakeit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func ExampleTemplateE() {
+	Seed(11)
+
+	value, err := TemplateE(`{{firstname}} {{shout (lastname)}} is {{number 18 99}}`, &TemplateOptions{
+		Funcs: template.FuncMap{
+			"shout": func(s interface{}) string { return strings.ToUpper(fmt.Sprint(s)) + "!" },
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+
+	// Output: Markus MOEN! is 73
+}
+
+func TestTemplateE(t *testing.T) {
+	value, err := TemplateE(`{{range .}}{{.}}: {{greet (firstname)}}{{end}}`, &TemplateOptions{
+		Funcs: template.FuncMap{
+			"greet": func(name interface{}) string { return fmt.Sprintf("hello %v", name) },
+		},
+		Data: []string{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(value, "hello ") != 2 || !strings.HasPrefix(value, "a: hello ") {
+		t.Errorf("expected custom function to render for each data value, got %s", value)
+	}
+
+	// Custom functions are only available to the render they were passed to
+	if _, err := TemplateE(`{{greet "bob"}}`, nil); err == nil {
+		t.Error("expected custom function to not be available without options")
+	}
+	if GetFuncLookup("greet") != nil {
+		t.Error("expected custom function to not be added to the lookups")
+	}
+}
+
+func TestTemplateEParams(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		value, err := TemplateE(`{{number 5 7}}|{{randomstring .}}`, &TemplateOptions{Data: []string{"x", "y"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value[0] < '5' || value[0] > '7' || (value[2:] != "x" && value[2:] != "y") {
+			t.Fatalf("expected params to be passed in order, got %s", value)
+		}
+	}
+}
+
+func TestTemplateEPrecedence(t *testing.T) {
+	value, err := TemplateE(`{{firstname}} {{print "x"}}`, &TemplateOptions{
+		Funcs: template.FuncMap{
+			"firstname": func() string { return "custom" },
+			"print":     func(s string) string { return "printed " + s },
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "custom printed x" {
+		t.Errorf("expected custom functions to take precedence, got %s", value)
+	}
+}
+
+func ExampleTemplateE_repeat() {
+	Seed(11)
+
+	value, err := TemplateE(`{{repeat 3 ", " "firstname"}}`, nil)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(value)
+
+	// Output: Markus, Marcel, Alayna
+}
+
+func TestTemplateERepeat(t *testing.T) {
+	value, err := TemplateE(`{{Repeat 5 ", " "FirstName"}}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := strings.Split(value, ", ")
+	if len(names) != 5 {
+		t.Fatalf("expected 5 names separated by a comma got %s", value)
+	}
+	for _, name := range names {
+		if name == "" || strings.Contains(name, ",") {
+			t.Fatalf("expected a name got %q in %s", name, value)
+		}
+	}
+
+	// Extra args are passed as params and repeat can be nested as an arg
+	for i := 0; i < 100; i++ {
+		value, err = TemplateE(`{{repeat 4 "-" "number" 1 (repeat 1 "" "number" 1 9)}}`, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		numbers := strings.Split(value, "-")
+		if len(numbers) != 4 {
+			t.Fatalf("expected 4 numbers got %s", value)
+		}
+		for _, n := range numbers {
+			if len(n) != 1 || n < "1" || n > "9" {
+				t.Fatalf("expected numbers between 1 and 9 got %s", value)
+			}
+		}
+	}
+
+	value, err = TemplateE(`[{{repeat 0 "," "firstname"}}]`, nil)
+	if err != nil || value != "[]" {
+		t.Errorf("expected an empty repeat got %s %v", value, err)
+	}
+
+	for _, tmpl := range []string{
+		`{{repeat -1 "," "firstname"}}`,
+		`{{repeat 2 "," "notafunction"}}`,
+		`{{repeat 2 "," "number" "a"}}`,
+	} {
+		if _, err := TemplateE(tmpl, nil); err == nil {
+			t.Errorf("expected an error for %s", tmpl)
+		}
+	}
+}
+
+func TestTemplateEErrors(t *testing.T) {
+	for _, tmpl := range []string{
+		`{{notafunction}}`,
+		`{{firstname`,
+		`{{number 1 2 3}}`,
+		`{{number 10 1}}`,
+	} {
+		if _, err := TemplateE(tmpl, nil); err == nil {
+			t.Errorf("expected error for template %s", tmpl)
+		}
+	}
+}
+
+func BenchmarkTemplateE(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		TemplateE(`{{firstname}} {{lastname}} {{email}}`, nil)
+	}
+}
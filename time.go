@@ -7,78 +7,318 @@ import (
 )
 
 // Date will generate a random time.Time struct
-func Date() time.Time {
-	return time.Date(Year(), time.Month(Number(0, 12)), Day(), Hour(), Minute(), Second(), NanoSecond(), time.UTC)
+func Date() time.Time { return date(globalFaker.ctx()) }
+
+// Date will generate a random time.Time struct
+func (f *Faker) Date() time.Time { return date(f.ctx()) }
+
+func date(r fakerCtx) time.Time {
+	return time.Date(year(r), time.Month(number(r, 0, 12)), day(r), hour(r), minute(r), second(r), nanoSecond(r), time.UTC)
 }
 
 // DateRange will generate a random time.Time struct between a start and end date
-func DateRange(start, end time.Time) time.Time {
-	return time.Unix(0, int64(Number(int(start.UnixNano()), int(end.UnixNano())))).UTC()
+func DateRange(start, end time.Time) time.Time { return dateRange(globalFaker.ctx(), start, end) }
+
+// DateRange will generate a random time.Time struct between a start and end date
+func (f *Faker) DateRange(start, end time.Time) time.Time { return dateRange(f.ctx(), start, end) }
+
+func dateRange(r fakerCtx, start, end time.Time) time.Time {
+	return time.Unix(0, int64(number(r, int(start.UnixNano()), int(end.UnixNano())))).UTC()
+}
+
+// DateRangeFormat will generate a random date between a start and end date formatted with a go time layout.
+// The layout is validated by parsing the formatted value back
+func DateRangeFormat(start, end time.Time, layout string) (string, error) {
+	return dateRangeFormat(globalFaker.ctx(), start, end, layout)
+}
+
+// DateRangeFormat will generate a random date between a start and end date formatted with a go time layout.
+// The layout is validated by parsing the formatted value back
+func (f *Faker) DateRangeFormat(start, end time.Time, layout string) (string, error) {
+	return dateRangeFormat(f.ctx(), start, end, layout)
+}
+
+func dateRangeFormat(r fakerCtx, start, end time.Time, layout string) (string, error) {
+	if end.Before(start) {
+		return "", errors.New("End date must be after start date")
+	}
+
+	value := dateRange(r, start, end).Format(layout)
+
+	// A layout without any time elements formats to itself
+	if layout == "" || value == layout {
+		return "", errors.New("Invalid layout, must contain time elements")
+	}
+
+	// Round trip to make sure the layout can be read back
+	parsed, err := time.Parse(layout, value)
+	if err != nil || parsed.Format(layout) != value {
+		return "", errors.New("Invalid layout, unable to parse formatted date")
+	}
+
+	return value, nil
+}
+
+// DateRFC3339 will generate a random date between a start and end date formatted as RFC3339
+// in the IANA timezone tz, like America/New_York, with the offset in effect at that date.
+// An empty tz uses UTC
+func DateRFC3339(start, end time.Time, tz string) (string, error) {
+	return dateRFC3339(globalFaker.ctx(), start, end, tz)
+}
+
+// DateRFC3339 will generate a random date between a start and end date formatted as RFC3339
+// in the IANA timezone tz, like America/New_York, with the offset in effect at that date.
+// An empty tz uses UTC
+func (f *Faker) DateRFC3339(start, end time.Time, tz string) (string, error) {
+	return dateRFC3339(f.ctx(), start, end, tz)
+}
+
+func dateRFC3339(r fakerCtx, start, end time.Time, tz string) (string, error) {
+	if end.Before(start) {
+		return "", errors.New("End date must be after start date")
+	}
+
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return "", errors.New("Invalid timezone " + tz)
+	}
+
+	return dateRange(r, start, end).In(loc).Format(time.RFC3339), nil
+}
+
+// DateBusinessHours will generate a random time between 09:00 and 17:00 on the given day.
+// A day falling on a weekend is moved to the following monday
+func DateBusinessHours(day time.Time) time.Time { return dateBusinessHours(globalFaker.ctx(), day) }
+
+// DateBusinessHours will generate a random time between 09:00 and 17:00 on the given day.
+// A day falling on a weekend is moved to the following monday
+func (f *Faker) DateBusinessHours(day time.Time) time.Time { return dateBusinessHours(f.ctx(), day) }
+
+func dateBusinessHours(r fakerCtx, day time.Time) time.Time {
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	open, close := businessHours(day)
+	return open.Add(time.Duration(r.Int63n(int64(close.Sub(open)))))
 }
 
+// DateRangeBusinessHours will generate a random time between a start and end date
+// that falls on a weekday between 09:00 and 17:00 in the location of start.
+// If the range does not contain any business hours start is returned
+func DateRangeBusinessHours(start, end time.Time) time.Time {
+	return dateRangeBusinessHours(globalFaker.ctx(), start, end)
+}
+
+// DateRangeBusinessHours will generate a random time between a start and end date
+// that falls on a weekday between 09:00 and 17:00 in the location of start.
+// If the range does not contain any business hours start is returned
+func (f *Faker) DateRangeBusinessHours(start, end time.Time) time.Time {
+	return dateRangeBusinessHours(f.ctx(), start, end)
+}
+
+func dateRangeBusinessHours(r fakerCtx, start, end time.Time) time.Time {
+	end = end.In(start.Location())
+	if end.Before(start) {
+		return start
+	}
+
+	// Roughly a quarter of the week is business hours so sampling the range
+	// and keeping the first hit is quick and stays uniform
+	for i := 0; i < 100; i++ {
+		t := dateRange(r, start, end).In(start.Location())
+		if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday && t.Hour() >= 9 && t.Hour() < 17 {
+			return t
+		}
+	}
+
+	// Short ranges with few or no business hours get walked day by day.
+	// Collect the business hours of every weekday that overlap the range
+	type span struct {
+		from time.Time
+		dur  int64
+	}
+	var spans []span
+	var total int64
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location()); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+
+		open, close := businessHours(day)
+		if open.Before(start) {
+			open = start
+		}
+		if close.After(end) {
+			close = end
+		}
+		if close.After(open) {
+			spans = append(spans, span{open, int64(close.Sub(open))})
+			total += int64(close.Sub(open))
+		}
+	}
+
+	if total == 0 {
+		return start
+	}
+
+	// Pick a point across all spans so each business hour is equally likely
+	n := r.Int63n(total)
+	for _, s := range spans {
+		if n < s.dur {
+			return s.from.Add(time.Duration(n))
+		}
+		n -= s.dur
+	}
+	return start
+}
+
+// businessHours returns the opening and closing time of a day
+func businessHours(day time.Time) (time.Time, time.Time) {
+	open := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, day.Location())
+	close := time.Date(day.Year(), day.Month(), day.Day(), 17, 0, 0, 0, day.Location())
+	return open, close
+}
+
+// dateParse reads a lookup date param as RFC3339 or a plain yyyy-mm-dd date
+func dateParse(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("Invalid date " + value + ", must be RFC3339 or yyyy-mm-dd")
+}
+
+// NanoSecond will generate a random nano second
+func NanoSecond() int { return nanoSecond(globalFaker.ctx()) }
+
 // NanoSecond will generate a random nano second
-func NanoSecond() int {
-	return Number(0, 999999999)
+func (f *Faker) NanoSecond() int { return nanoSecond(f.ctx()) }
+
+func nanoSecond(r fakerCtx) int {
+	return number(r, 0, 999999999)
 }
 
 // Second will generate a random second
-func Second() int {
-	return Number(0, 59)
+func Second() int { return second(globalFaker.ctx()) }
+
+// Second will generate a random second
+func (f *Faker) Second() int { return second(f.ctx()) }
+
+func second(r fakerCtx) int {
+	return number(r, 0, 59)
 }
 
 // Minute will generate a random minute
-func Minute() int {
-	return Number(0, 59)
+func Minute() int { return minute(globalFaker.ctx()) }
+
+// Minute will generate a random minute
+func (f *Faker) Minute() int { return minute(f.ctx()) }
+
+func minute(r fakerCtx) int {
+	return number(r, 0, 59)
 }
 
 // Hour will generate a random hour - in military time
-func Hour() int {
-	return Number(0, 23)
+func Hour() int { return hour(globalFaker.ctx()) }
+
+// Hour will generate a random hour - in military time
+func (f *Faker) Hour() int { return hour(f.ctx()) }
+
+func hour(r fakerCtx) int {
+	return number(r, 0, 23)
 }
 
 // Day will generate a random day between 1 - 31
-func Day() int {
-	return Number(1, 31)
+func Day() int { return day(globalFaker.ctx()) }
+
+// Day will generate a random day between 1 - 31
+func (f *Faker) Day() int { return day(f.ctx()) }
+
+func day(r fakerCtx) int {
+	return number(r, 1, 31)
 }
 
 // WeekDay will generate a random weekday string (Monday-Sunday)
-func WeekDay() string {
-	return time.Weekday(Number(0, 6)).String()
+func WeekDay() string { return weekDay(globalFaker.ctx()) }
+
+// WeekDay will generate a random weekday string (Monday-Sunday)
+func (f *Faker) WeekDay() string { return weekDay(f.ctx()) }
+
+func weekDay(r fakerCtx) string {
+	return time.Weekday(number(r, 0, 6)).String()
 }
 
 // Month will generate a random month string
-func Month() string {
-	return time.Month(Number(1, 12)).String()
+func Month() string { return month(globalFaker.ctx()) }
+
+// Month will generate a random month string
+func (f *Faker) Month() string { return month(f.ctx()) }
+
+func month(r fakerCtx) string {
+	return time.Month(number(r, 1, 12)).String()
 }
 
 // Year will generate a random year between 1900 - current year
-func Year() int {
-	return Number(1900, time.Now().Year())
+func Year() int { return year(globalFaker.ctx()) }
+
+// Year will generate a random year between 1900 - current year
+func (f *Faker) Year() int { return year(f.ctx()) }
+
+func year(r fakerCtx) int {
+	return number(r, 1900, time.Now().Year())
 }
 
 // TimeZone will select a random timezone string
-func TimeZone() string {
-	return getRandValue([]string{"timezone", "text"})
+func TimeZone() string { return timeZone(globalFaker.ctx()) }
+
+// TimeZone will select a random timezone string
+func (f *Faker) TimeZone() string { return timeZone(f.ctx()) }
+
+func timeZone(r fakerCtx) string {
+	return getRandValue(r, []string{"timezone", "text"})
 }
 
 // TimeZoneFull will select a random full timezone string
-func TimeZoneFull() string {
-	return getRandValue([]string{"timezone", "full"})
+func TimeZoneFull() string { return timeZoneFull(globalFaker.ctx()) }
+
+// TimeZoneFull will select a random full timezone string
+func (f *Faker) TimeZoneFull() string { return timeZoneFull(f.ctx()) }
+
+func timeZoneFull(r fakerCtx) string {
+	return getRandValue(r, []string{"timezone", "full"})
 }
 
 // TimeZoneRegion will select a random region style timezone string, e.g. "America/Chicago"
-func TimeZoneRegion() string {
-	return getRandValue([]string{"timezone", "region"})
+func TimeZoneRegion() string { return timeZoneRegion(globalFaker.ctx()) }
+
+// TimeZoneRegion will select a random region style timezone string, e.g. "America/Chicago"
+func (f *Faker) TimeZoneRegion() string { return timeZoneRegion(f.ctx()) }
+
+func timeZoneRegion(r fakerCtx) string {
+	return getRandValue(r, []string{"timezone", "region"})
 }
 
 // TimeZoneAbv will select a random timezone abbreviation string
-func TimeZoneAbv() string {
-	return getRandValue([]string{"timezone", "abr"})
+func TimeZoneAbv() string { return timeZoneAbv(globalFaker.ctx()) }
+
+// TimeZoneAbv will select a random timezone abbreviation string
+func (f *Faker) TimeZoneAbv() string { return timeZoneAbv(f.ctx()) }
+
+func timeZoneAbv(r fakerCtx) string {
+	return getRandValue(r, []string{"timezone", "abr"})
 }
 
 // TimeZoneOffset will select a random timezone offset
-func TimeZoneOffset() float32 {
-	value, _ := strconv.ParseFloat(getRandValue([]string{"timezone", "offset"}), 32)
+func TimeZoneOffset() float32 { return timeZoneOffset(globalFaker.ctx()) }
+
+// TimeZoneOffset will select a random timezone offset
+func (f *Faker) TimeZoneOffset() float32 { return timeZoneOffset(f.ctx()) }
+
+func timeZoneOffset(r fakerCtx) float32 {
+	value, _ := strconv.ParseFloat(getRandValue(r, []string{"timezone", "offset"}), 32)
 	return float32(value)
 }
 
@@ -99,7 +339,7 @@ func addDateTimeLookup() {
 				Description: "Date time string format output",
 			},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			format, err := info.GetString(m, "format")
 			if err != nil {
 				return nil, err
@@ -107,39 +347,150 @@ func addDateTimeLookup() {
 
 			switch format {
 			case "ANSIC":
-				return Date().Format(time.ANSIC), nil
+				return date(r).Format(time.ANSIC), nil
 			case "UnixDate":
-				return Date().Format(time.UnixDate), nil
+				return date(r).Format(time.UnixDate), nil
 			case "RubyDate":
-				return Date().Format(time.RubyDate), nil
+				return date(r).Format(time.RubyDate), nil
 			case "RFC822":
-				return Date().Format(time.RFC822), nil
+				return date(r).Format(time.RFC822), nil
 			case "RFC822Z":
-				return Date().Format(time.RFC822Z), nil
+				return date(r).Format(time.RFC822Z), nil
 			case "RFC850":
-				return Date().Format(time.RFC850), nil
+				return date(r).Format(time.RFC850), nil
 			case "RFC1123":
-				return Date().Format(time.RFC1123), nil
+				return date(r).Format(time.RFC1123), nil
 			case "RFC1123Z":
-				return Date().Format(time.RFC1123Z), nil
+				return date(r).Format(time.RFC1123Z), nil
 			case "RFC3339":
-				return Date().Format(time.RFC3339), nil
+				return date(r).Format(time.RFC3339), nil
 			case "RFC3339Nano":
-				return Date().Format(time.RFC3339Nano), nil
+				return date(r).Format(time.RFC3339Nano), nil
 			}
 
 			return "", errors.New("Invalid format")
 		},
 	})
 
+	AddFuncLookup("daterangeformat", Info{
+		Display:     "Date Range Format",
+		Category:    "time",
+		Description: "Random date between a start and end date formatted with a go time layout",
+		Example:     "2012-02-04",
+		Output:      "string",
+		Params: []Param{
+			{Field: "startdate", Display: "Start Date", Type: "string", Default: "1970-01-01", Description: "Start date in RFC3339 or yyyy-mm-dd"},
+			{Field: "enddate", Display: "End Date", Type: "string", Default: "2100-12-31", Description: "End date in RFC3339 or yyyy-mm-dd"},
+			{Field: "format", Display: "Format", Type: "string", Default: "2006-01-02", Description: "Go time layout of the output"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			startStr, err := info.GetString(m, "startdate")
+			if err != nil {
+				return nil, err
+			}
+			start, err := dateParse(startStr)
+			if err != nil {
+				return nil, err
+			}
+
+			endStr, err := info.GetString(m, "enddate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateParse(endStr)
+			if err != nil {
+				return nil, err
+			}
+
+			format, err := info.GetString(m, "format")
+			if err != nil {
+				return nil, err
+			}
+
+			return dateRangeFormat(r, start, end, format)
+		},
+	})
+
+	AddFuncLookup("daterfc3339", Info{
+		Display:     "Date RFC3339",
+		Category:    "time",
+		Description: "Random date between a start and end date in RFC3339 format with the offset of a timezone",
+		Example:     "2012-02-04T09:15:37-05:00",
+		Output:      "string",
+		Params: []Param{
+			{Field: "startdate", Display: "Start Date", Type: "string", Default: "1970-01-01", Description: "Start date in RFC3339 or yyyy-mm-dd"},
+			{Field: "enddate", Display: "End Date", Type: "string", Default: "2100-12-31", Description: "End date in RFC3339 or yyyy-mm-dd"},
+			{Field: "timezone", Display: "Timezone", Type: "string", Default: "UTC", Description: "IANA timezone name like America/New_York"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			startStr, err := info.GetString(m, "startdate")
+			if err != nil {
+				return nil, err
+			}
+			start, err := dateParse(startStr)
+			if err != nil {
+				return nil, err
+			}
+
+			endStr, err := info.GetString(m, "enddate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateParse(endStr)
+			if err != nil {
+				return nil, err
+			}
+
+			tz, err := info.GetString(m, "timezone")
+			if err != nil {
+				return nil, err
+			}
+
+			return dateRFC3339(r, start, end, tz)
+		},
+	})
+
+	AddFuncLookup("daterangebusinesshours", Info{
+		Display:     "Date Range Business Hours",
+		Category:    "time",
+		Description: "Random date between a start and end date on a weekday between 09:00 and 17:00",
+		Example:     "2012-02-03T14:10:37Z",
+		Output:      "string",
+		Params: []Param{
+			{Field: "startdate", Display: "Start Date", Type: "string", Default: "1970-01-01", Description: "Start date in RFC3339 or yyyy-mm-dd"},
+			{Field: "enddate", Display: "End Date", Type: "string", Default: "2100-12-31", Description: "End date in RFC3339 or yyyy-mm-dd"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			startStr, err := info.GetString(m, "startdate")
+			if err != nil {
+				return nil, err
+			}
+			start, err := dateParse(startStr)
+			if err != nil {
+				return nil, err
+			}
+
+			endStr, err := info.GetString(m, "enddate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateParse(endStr)
+			if err != nil {
+				return nil, err
+			}
+
+			return dateRangeBusinessHours(r, start, end).Format(time.RFC3339), nil
+		},
+	})
+
 	AddFuncLookup("nanosecond", Info{
 		Display:     "Nanosecond",
 		Category:    "time",
 		Description: "Random nanosecond",
 		Example:     "196446360",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return NanoSecond(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return nanoSecond(r), nil
 		},
 	})
 
@@ -149,8 +500,8 @@ func addDateTimeLookup() {
 		Description: "Random second",
 		Example:     "43",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Second(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return second(r), nil
 		},
 	})
 
@@ -160,8 +511,8 @@ func addDateTimeLookup() {
 		Description: "Random minute",
 		Example:     "34",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Second(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return second(r), nil
 		},
 	})
 
@@ -171,8 +522,8 @@ func addDateTimeLookup() {
 		Description: "Random hour",
 		Example:     "8",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Second(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return second(r), nil
 		},
 	})
 
@@ -182,8 +533,8 @@ func addDateTimeLookup() {
 		Description: "Random day",
 		Example:     "12",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Day(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return day(r), nil
 		},
 	})
 
@@ -193,8 +544,8 @@ func addDateTimeLookup() {
 		Description: "Random week day",
 		Example:     "Friday",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return WeekDay(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return weekDay(r), nil
 		},
 	})
 
@@ -204,8 +555,8 @@ func addDateTimeLookup() {
 		Description: "Random year",
 		Example:     "1900",
 		Output:      "int",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Year(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return year(r), nil
 		},
 	})
 
@@ -215,8 +566,8 @@ func addDateTimeLookup() {
 		Description: "Random timezone",
 		Example:     "Kaliningrad Standard Time",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return TimeZone(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return timeZone(r), nil
 		},
 	})
 
@@ -226,8 +577,8 @@ func addDateTimeLookup() {
 		Description: "Random abbreviated timezone",
 		Example:     "KST",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return TimeZoneAbv(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return timeZoneAbv(r), nil
 		},
 	})
 
@@ -237,8 +588,8 @@ func addDateTimeLookup() {
 		Description: "Random full timezone",
 		Example:     "(UTC+03:00) Kaliningrad, Minsk",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return TimeZoneFull(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return timeZoneFull(r), nil
 		},
 	})
 
@@ -248,8 +599,8 @@ func addDateTimeLookup() {
 		Description: "Random timezone offset",
 		Example:     "3",
 		Output:      "float32",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return TimeZoneOffset(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return timeZoneOffset(r), nil
 		},
 	})
 
@@ -259,8 +610,8 @@ func addDateTimeLookup() {
 		Description: "Random region timezone",
 		Example:     "America/Alaska",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return TimeZoneRegion(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return timeZoneRegion(r), nil
 		},
 	})
 
@@ -2,6 +2,7 @@ package gofakeit
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -30,6 +31,205 @@ func BenchmarkDateRange(b *testing.B) {
 	}
 }
 
+func ExampleDateRangeFormat() {
+	Seed(11)
+	value, err := DateRangeFormat(time.Date(1985, 5, 10, 0, 0, 0, 0, time.UTC), time.Date(2015, 5, 10, 0, 0, 0, 0, time.UTC), "Jan 2, 2006")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: Feb 3, 2012
+}
+
+func TestDateRangeFormat(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	for _, layout := range []string{"2006-01-02", time.RFC3339, time.RFC1123Z, "02/01/2006 15:04"} {
+		for i := 0; i < 100; i++ {
+			value, err := DateRangeFormat(start, end, layout)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			parsed, err := time.Parse(layout, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Before(start) || parsed.After(end) {
+				t.Fatalf("%s is outside of %s to %s", value, start, end)
+			}
+		}
+	}
+}
+
+func ExampleDateRFC3339() {
+	Seed(11)
+	value, err := DateRFC3339(time.Date(1985, 5, 10, 0, 0, 0, 0, time.UTC), time.Date(2015, 5, 10, 0, 0, 0, 0, time.UTC), "America/New_York")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(value)
+	// Output: 2012-02-03T04:38:12-05:00
+}
+
+func TestDateRFC3339(t *testing.T) {
+	// Daylight saving time in New York began at 2am on March 14 2021, 7am UTC
+	transition := time.Date(2021, 3, 14, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		start, end time.Time
+		offset     string
+	}{
+		{transition.Add(-2 * time.Hour), transition.Add(-time.Second), "-05:00"},
+		{transition, transition.Add(2 * time.Hour), "-04:00"},
+	}
+	for _, test := range tests {
+		for i := 0; i < 100; i++ {
+			value, err := DateRFC3339(test.start, test.end, "America/New_York")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(value, test.offset) {
+				t.Fatalf("expected %s to have offset %s", value, test.offset)
+			}
+
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Before(test.start.Truncate(time.Second)) || parsed.After(test.end) {
+				t.Fatalf("%s is outside of %s to %s", value, test.start, test.end)
+			}
+		}
+	}
+
+	value, err := DateRFC3339(transition, transition, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "2021-03-14T07:00:00Z" {
+		t.Errorf("expected an empty timezone to be utc got %s", value)
+	}
+
+	if _, err := DateRFC3339(transition, transition, "Mars/Olympus_Mons"); err == nil {
+		t.Error("expected error for an unknown timezone")
+	}
+	if _, err := DateRFC3339(transition, transition.Add(-time.Hour), "UTC"); err == nil {
+		t.Error("expected error for end before start")
+	}
+}
+
+func TestDateRangeFormatErrors(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	if _, err := DateRangeFormat(end, start, "2006-01-02"); err == nil {
+		t.Error("expected error for end before start")
+	}
+	for _, layout := range []string{"", "no time here"} {
+		if _, err := DateRangeFormat(start, end, layout); err == nil {
+			t.Errorf("expected error for layout %q", layout)
+		}
+	}
+}
+
+func TestDateRangeFormatLookup(t *testing.T) {
+	info := GetFuncLookup("daterangeformat")
+
+	m := map[string][]string{
+		"startdate": {"2000-01-01"},
+		"enddate":   {"2000-01-31T23:59:59Z"},
+		"format":    {"2006-01-02"},
+	}
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(value.(string), "2000-01-") {
+		t.Errorf("expected a date in january 2000, got %s", value)
+	}
+
+	m["startdate"] = []string{"yesterday"}
+	if _, err := info.call(globalFaker.ctx(), &m); err == nil {
+		t.Error("expected error for invalid start date")
+	}
+}
+
+func BenchmarkDateRangeFormat(b *testing.B) {
+	start := time.Now().AddDate(-30, 0, 0)
+	end := time.Now()
+	for i := 0; i < b.N; i++ {
+		DateRangeFormat(start, end, time.RFC3339)
+	}
+}
+
+func ExampleDateBusinessHours() {
+	Seed(11)
+	fmt.Println(DateBusinessHours(time.Date(2020, 3, 14, 0, 0, 0, 0, time.UTC))) // Saturday
+	// Output: 2020-03-16 10:38:12.693298265 +0000 UTC
+}
+
+func ExampleDateRangeBusinessHours() {
+	Seed(11)
+	fmt.Println(DateRangeBusinessHours(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)))
+	// Output: 2020-03-16 09:38:12.69329794 +0000 UTC
+}
+
+// businessHoursTestCheck reports why a time is outside of business hours
+func businessHoursTestCheck(t *testing.T, value time.Time) {
+	t.Helper()
+	if value.Weekday() == time.Saturday || value.Weekday() == time.Sunday {
+		t.Fatalf("%s falls on a weekend", value)
+	}
+	if value.Hour() < 9 || value.Hour() >= 17 {
+		t.Fatalf("%s is outside of 09:00 to 17:00", value)
+	}
+}
+
+func TestDateBusinessHours(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	for i := 0; i < 1000; i++ {
+		day := DateRange(time.Date(2000, 1, 1, 0, 0, 0, 0, loc), time.Date(2030, 1, 1, 0, 0, 0, 0, loc)).In(loc)
+		value := DateBusinessHours(day)
+		businessHoursTestCheck(t, value)
+
+		if value.Location() != loc {
+			t.Fatalf("expected location %s, got %s", loc, value.Location())
+		}
+		if wd := day.Weekday(); wd != time.Saturday && wd != time.Sunday && value.YearDay() != day.YearDay() {
+			t.Fatalf("expected %s to be on the same day as %s", value, day)
+		}
+	}
+}
+
+func TestDateRangeBusinessHours(t *testing.T) {
+	start := time.Date(2020, 3, 4, 12, 30, 0, 0, time.UTC) // Wednesday afternoon
+	end := time.Date(2020, 3, 16, 10, 0, 0, 0, time.UTC)   // Monday morning
+	for i := 0; i < 1000; i++ {
+		value := DateRangeBusinessHours(start, end)
+		businessHoursTestCheck(t, value)
+
+		if value.Before(start) || value.After(end) {
+			t.Fatalf("%s is outside of %s to %s", value, start, end)
+		}
+	}
+
+	// A weekend has no business hours
+	weekend := time.Date(2020, 3, 7, 0, 0, 0, 0, time.UTC)
+	if value := DateRangeBusinessHours(weekend, weekend.AddDate(0, 0, 2)); !value.Equal(weekend) {
+		t.Errorf("expected start to be returned, got %s", value)
+	}
+}
+
+func BenchmarkDateRangeBusinessHours(b *testing.B) {
+	start := time.Now().AddDate(-1, 0, 0)
+	end := time.Now()
+	for i := 0; i < b.N; i++ {
+		DateRangeBusinessHours(start, end)
+	}
+}
+
 func ExampleMonth() {
 	Seed(11)
 	fmt.Println(Month())
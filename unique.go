@@ -0,0 +1,46 @@
+package gofakeit
+
+import "errors"
+
+// uniqueMaxAttempts is the number of times Unique will call the generator before giving up
+const uniqueMaxAttempts = 1000
+
+// Unique will call fn until it returns a value not previously returned by Unique on the global faker.
+// An error is returned if no new value is found after 1000 attempts. It is safe for concurrent use,
+// fn is called without holding the lock so it can use the faker
+func Unique(fn func() string) (string, error) { return globalFaker.Unique(fn) }
+
+// Unique will call fn until it returns a value not previously returned by Unique on this faker.
+// An error is returned if no new value is found after 1000 attempts. It is safe for concurrent use,
+// fn is called without holding the lock so it can use the faker
+func (f *Faker) Unique(fn func() string) (string, error) {
+	for i := 0; i < uniqueMaxAttempts; i++ {
+		value := fn()
+
+		f.uniqueLock.Lock()
+		if f.unique == nil {
+			f.unique = make(map[string]struct{})
+		}
+		_, seen := f.unique[value]
+		if !seen {
+			f.unique[value] = struct{}{}
+		}
+		f.uniqueLock.Unlock()
+
+		if !seen {
+			return value, nil
+		}
+	}
+
+	return "", errors.New("Unable to generate a unique value, too many attempts")
+}
+
+// UniqueReset will clear the values seen by Unique on the global faker
+func UniqueReset() { globalFaker.UniqueReset() }
+
+// UniqueReset will clear the values seen by Unique on this faker
+func (f *Faker) UniqueReset() {
+	f.uniqueLock.Lock()
+	f.unique = nil
+	f.uniqueLock.Unlock()
+}
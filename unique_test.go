@@ -0,0 +1,107 @@
+package gofakeit
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func ExampleFaker_Unique() {
+	f := New(rand.NewSource(11))
+
+	for i := 0; i < 3; i++ {
+		value, err := f.Unique(func() string { return f.Generate("{number:1,3}") })
+		if err != nil {
+			fmt.Println(err)
+		}
+		fmt.Println(value)
+	}
+
+	// Output:
+	// 1
+	// 3
+	// 2
+}
+
+func TestUniqueEmails(t *testing.T) {
+	f := New(rand.NewSource(11))
+
+	seen := map[string]bool{}
+	for i := 0; i < 1000; i++ {
+		email, err := f.Unique(f.Email)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[email] {
+			t.Fatalf("duplicate email %s", email)
+		}
+		seen[email] = true
+	}
+}
+
+func TestUniqueExhausted(t *testing.T) {
+	f := New(rand.NewSource(11))
+
+	_, err := f.Unique(func() string { return "same" })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = f.Unique(func() string { return "same" })
+	if err == nil {
+		t.Error("expected error once generator can no longer produce new values")
+	}
+
+	f.UniqueReset()
+	_, err = f.Unique(func() string { return "same" })
+	if err != nil {
+		t.Errorf("expected reset to clear seen values, got %s", err)
+	}
+}
+
+func TestUniqueGlobal(t *testing.T) {
+	UniqueReset()
+	defer UniqueReset()
+
+	for i := 0; i < 100; i++ {
+		if _, err := Unique(Username); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestUniqueConcurrency(t *testing.T) {
+	// Run with go test -race to verify the seen values arent raced on
+	UniqueReset()
+	defer UniqueReset()
+
+	var mu sync.Mutex
+	values := make(map[string]struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ii := 0; ii < 50; ii++ {
+				value, err := Unique(UUID)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				mu.Lock()
+				if _, ok := values[value]; ok {
+					t.Errorf("value %s returned twice", value)
+				}
+				values[value] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(values) != 1000 {
+		t.Errorf("expected 1000 unique values got %d", len(values))
+	}
+}
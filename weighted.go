@@ -0,0 +1,125 @@
+package gofakeit
+
+import (
+	"errors"
+	"math"
+)
+
+// Weighted will take in an array of options and weights and return a random selection based upon its indexed weight
+func Weighted(options []interface{}, weights []float32) (interface{}, error) {
+	return weighted(globalFaker.ctx(), options, weights)
+}
+
+// Weighted will take in an array of options and weights and return a random selection based upon its indexed weight
+func (f *Faker) Weighted(options []interface{}, weights []float32) (interface{}, error) {
+	return weighted(f.ctx(), options, weights)
+}
+
+func weighted(r fakerCtx, options []interface{}, weights []float32) (interface{}, error) {
+	ol := len(options)
+	wl := len(weights)
+
+	// If options length is 0 return nil
+	if ol == 0 {
+		return nil, errors.New("Options are empty")
+	}
+
+	// Make sure they are passing in options and weights of the same length
+	if ol != wl {
+		return nil, errors.New("Options and weights need to be the same length")
+	}
+
+	// Add up weights, summing as float64 so large float32 weights dont overflow
+	var total float64
+	for _, w := range weights {
+		if math.IsNaN(float64(w)) || math.IsInf(float64(w), 0) {
+			return nil, errors.New("Weights must be finite")
+		}
+		if w <= 0 {
+			return nil, errors.New("Weights must be greater than 0")
+		}
+		total += float64(w)
+	}
+
+	// Pick a point along the total and find which option it lands on
+	point := r.Float64() * total
+	for i, w := range weights {
+		point -= float64(w)
+		if point < 0 {
+			return options[i], nil
+		}
+	}
+
+	// Floating point rounding can leave a tiny remainder, fall back to the last option
+	return options[ol-1], nil
+}
+
+func addWeightedLookup() {
+	AddFuncLookup("weighted", Info{
+		Display:     "Weighted",
+		Category:    "misc",
+		Description: "Randomly select a given option based upon an equal amount of weights",
+		Example:     "[hello, 2, 6.9] [1, 2, 3] => 6.9",
+		Output:      "interface{}",
+		Params: []Param{
+			{Field: "options", Display: "Options", Type: "[]string", Description: "Array of any values"},
+			{Field: "weights", Display: "Weights", Type: "[]float", Description: "Array of weights"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			options, err := info.GetStringArray(m, "options")
+			if err != nil {
+				return nil, err
+			}
+
+			weights, err := info.GetFloat32Array(m, "weights")
+			if err != nil {
+				return nil, err
+			}
+
+			optionsInterface := make([]interface{}, len(options))
+			for i, o := range options {
+				optionsInterface[i] = o
+			}
+
+			return weighted(r, optionsInterface, weights)
+		},
+	})
+
+	AddFuncLookup("oneof", Info{
+		Display:     "One Of",
+		Category:    "misc",
+		Description: "Randomly select one of the given values, optionally weighted",
+		Example:     "[active, pending, disabled] [6, 3, 1] => active",
+		Output:      "string",
+		Params: []Param{
+			{Field: "values", Display: "Values", Type: "[]string", Description: "Array of values to pick from"},
+			{Field: "weights", Display: "Weights", Type: "[]float", Optional: true, Description: "Optional array of weights, one per value"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			values, err := info.GetStringArray(m, "values")
+			if err != nil {
+				return nil, err
+			}
+			if len(values) == 0 {
+				return nil, errors.New("Values are empty")
+			}
+
+			// Without weights every value is equally likely
+			if m == nil || len((*m)["weights"]) == 0 {
+				return randomString(r, values), nil
+			}
+
+			weights, err := info.GetFloat32Array(m, "weights")
+			if err != nil {
+				return nil, err
+			}
+
+			valuesInterface := make([]interface{}, len(values))
+			for i, v := range values {
+				valuesInterface[i] = v
+			}
+
+			return weighted(r, valuesInterface, weights)
+		},
+	})
+}
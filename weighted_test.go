@@ -0,0 +1,207 @@
+package gofakeit
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func ExampleWeighted() {
+	Seed(11)
+
+	options := []interface{}{"hello", 2, 6.9}
+	weights := []float32{1, 2, 3}
+	option, err := Weighted(options, weights)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(option)
+	// Output: hello
+}
+
+func ExampleFaker_Weighted() {
+	f := New(rand.NewSource(11))
+
+	options := []interface{}{"hello", 2, 6.9}
+	weights := []float32{1, 2, 3}
+	option, err := f.Weighted(options, weights)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(option)
+	// Output: hello
+}
+
+func TestWeightedDistribution(t *testing.T) {
+	Seed(11)
+
+	options := []interface{}{"active", "pending", "closed"}
+	weights := []float32{70, 20, 10}
+	iterations := 100000
+
+	counts := map[interface{}]int{}
+	for i := 0; i < iterations; i++ {
+		option, err := Weighted(options, weights)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[option]++
+	}
+
+	for i, option := range options {
+		expected := float64(weights[i]) / 100
+		got := float64(counts[option]) / float64(iterations)
+		if math.Abs(got-expected) > 0.01 {
+			t.Errorf("%s expected %.2f got %.4f", option, expected, got)
+		}
+	}
+}
+
+func TestWeightedSingle(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		option, err := Weighted([]interface{}{"only"}, []float32{0.5})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if option != "only" {
+			t.Fatalf("expected only got %v", option)
+		}
+	}
+}
+
+func TestWeightedErrors(t *testing.T) {
+	_, err := Weighted([]interface{}{}, []float32{})
+	if err == nil {
+		t.Error("expected error for empty options")
+	}
+
+	_, err = Weighted([]interface{}{"a", "b"}, []float32{1})
+	if err == nil {
+		t.Error("expected error for mismatched lengths")
+	}
+
+	_, err = Weighted([]interface{}{"a", "b"}, []float32{1, 0})
+	if err == nil {
+		t.Error("expected error for zero weight")
+	}
+
+	_, err = Weighted([]interface{}{"a", "b"}, []float32{1, -2})
+	if err == nil {
+		t.Error("expected error for negative weight")
+	}
+
+	for _, w := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err = Weighted([]interface{}{"a", "b"}, []float32{1, float32(w)})
+		if err == nil {
+			t.Errorf("expected error for %v weight", w)
+		}
+	}
+}
+
+func TestWeightedLookup(t *testing.T) {
+	info := GetFuncLookup("weighted")
+	m := map[string][]string{
+		"options": {"hello", "world"},
+		"weights": {"1", "0.000001"},
+	}
+
+	counts := map[interface{}]int{}
+	for i := 0; i < 1000; i++ {
+		value, err := info.call(globalFaker.ctx(), &m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[value]++
+	}
+
+	if counts["hello"] < 990 {
+		t.Errorf("expected hello to be picked nearly every time, got %v", counts)
+	}
+}
+
+func TestOneOfLookup(t *testing.T) {
+	info := GetFuncLookup("oneof")
+	values := []string{"active", "pending", "disabled"}
+	m := map[string][]string{"values": values}
+
+	counts := map[interface{}]int{}
+	for i := 0; i < 1000; i++ {
+		value, err := info.call(globalFaker.ctx(), &m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stringInSlice(value.(string), values) {
+			t.Fatalf("%s is not one of %v", value, values)
+		}
+		counts[value]++
+	}
+
+	if len(counts) != len(values) {
+		t.Errorf("expected every value to be picked, got %v", counts)
+	}
+}
+
+func TestOneOfWeighted(t *testing.T) {
+	info := GetFuncLookup("oneof")
+	m := map[string][]string{
+		"values":  {"common", "rare"},
+		"weights": {"9", "1"},
+	}
+
+	counts := map[interface{}]int{}
+	for i := 0; i < 10000; i++ {
+		value, err := info.call(globalFaker.ctx(), &m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[value]++
+	}
+
+	if math.Abs(float64(counts["common"])/10000-0.9) > 0.02 {
+		t.Errorf("expected common to be picked about 90%% of the time, got %v", counts)
+	}
+}
+
+func TestOneOfCSV(t *testing.T) {
+	value, err := CSV(&CSVOptions{
+		RowCount: 10,
+		Fields: []Field{
+			{Name: "status", Function: "oneof", Params: map[string][]string{"values": {"open", "closed"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, row := range strings.Split(strings.TrimSpace(string(value)), "\n")[1:] {
+		if row != "open" && row != "closed" {
+			t.Errorf("expected open or closed, got %s", row)
+		}
+	}
+}
+
+func TestOneOfErrors(t *testing.T) {
+	info := GetFuncLookup("oneof")
+
+	for _, m := range []map[string][]string{
+		{},
+		{"values": {"a", "b"}, "weights": {"1"}},
+		{"values": {"a", "b"}, "weights": {"1", "x"}},
+	} {
+		if _, err := info.call(globalFaker.ctx(), &m); err == nil {
+			t.Errorf("expected error for %v", m)
+		}
+	}
+}
+
+func BenchmarkWeighted(b *testing.B) {
+	options := []interface{}{"hello", 2, 6.9}
+	weights := []float32{1, 2, 3}
+	for i := 0; i < b.N; i++ {
+		Weighted(options, weights)
+	}
+}
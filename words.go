@@ -16,69 +16,136 @@ type paragrapOptions struct {
 
 const bytesPerWordEstimation = 6
 
-type sentenceGenerator func(wordCount int) string
-type wordGenerator func() string
+type sentenceGenerator func(r fakerCtx, wordCount int) string
+type wordGenerator func(r fakerCtx) string
 
 // Noun will generate a random noun
-func Noun() string {
-	return getRandValue([]string{"word", "noun"})
+func Noun() string { return noun(globalFaker.ctx()) }
+
+// Noun will generate a random noun
+func (f *Faker) Noun() string { return noun(f.ctx()) }
+
+func noun(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "noun"})
 }
 
 // Verb will generate a random verb
-func Verb() string {
-	return getRandValue([]string{"word", "verb"})
+func Verb() string { return verb(globalFaker.ctx()) }
+
+// Verb will generate a random verb
+func (f *Faker) Verb() string { return verb(f.ctx()) }
+
+func verb(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "verb"})
 }
 
 // Adverb will generate a random adverb
-func Adverb() string {
-	return getRandValue([]string{"word", "adverb"})
+func Adverb() string { return adverb(globalFaker.ctx()) }
+
+// Adverb will generate a random adverb
+func (f *Faker) Adverb() string { return adverb(f.ctx()) }
+
+func adverb(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "adverb"})
 }
 
 // Preposition will generate a random preposition
-func Preposition() string {
-	return getRandValue([]string{"word", "preposition"})
+func Preposition() string { return preposition(globalFaker.ctx()) }
+
+// Preposition will generate a random preposition
+func (f *Faker) Preposition() string { return preposition(f.ctx()) }
+
+func preposition(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "preposition"})
 }
 
 // Adjective will generate a random adjective
-func Adjective() string {
-	return getRandValue([]string{"word", "adjective"})
+func Adjective() string { return adjective(globalFaker.ctx()) }
+
+// Adjective will generate a random adjective
+func (f *Faker) Adjective() string { return adjective(f.ctx()) }
+
+func adjective(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "adjective"})
 }
 
 // Word will generate a random word
-func Word() string {
-	if Bool() {
-		return getRandValue([]string{"word", "noun"})
+func Word() string { return word(globalFaker.ctx()) }
+
+// Word will generate a random word
+func (f *Faker) Word() string { return word(f.ctx()) }
+
+func word(r fakerCtx) string {
+	if boolFunc(r) {
+		return getRandValue(r, []string{"word", "noun"})
 	}
 
-	return getRandValue([]string{"word", "verb"})
+	return getRandValue(r, []string{"word", "verb"})
 }
 
 // Sentence will generate a random sentence
-func Sentence(wordCount int) string {
-	return sentence(wordCount, Word)
+func Sentence(wordCount int) string { return sentence(globalFaker.ctx(), wordCount) }
+
+// Sentence will generate a random sentence
+func (f *Faker) Sentence(wordCount int) string { return sentence(f.ctx(), wordCount) }
+
+func sentence(r fakerCtx, wordCount int) string {
+	return sentenceGen(r, wordCount, word)
 }
 
 // Paragraph will generate a random paragraphGenerator
 func Paragraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
-	return paragraphGenerator(paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, Sentence)
+	return paragraph(globalFaker.ctx(), paragraphCount, sentenceCount, wordCount, separator)
+}
+
+// Paragraph will generate a random paragraphGenerator
+func (f *Faker) Paragraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return paragraph(f.ctx(), paragraphCount, sentenceCount, wordCount, separator)
 }
 
+func paragraph(r fakerCtx, paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return paragraphGenerator(r, paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, sentence)
+}
+
+// LoremIpsumWord will generate a random word
+func LoremIpsumWord() string { return loremIpsumWord(globalFaker.ctx()) }
+
 // LoremIpsumWord will generate a random word
-func LoremIpsumWord() string {
-	return getRandValue([]string{"lorem", "word"})
+func (f *Faker) LoremIpsumWord() string { return loremIpsumWord(f.ctx()) }
+
+func loremIpsumWord(r fakerCtx) string {
+	return getRandValue(r, []string{"lorem", "word"})
 }
 
 // LoremIpsumSentence will generate a random sentence
 func LoremIpsumSentence(wordCount int) string {
-	return sentence(wordCount, LoremIpsumWord)
+	return loremIpsumSentence(globalFaker.ctx(), wordCount)
+}
+
+// LoremIpsumSentence will generate a random sentence
+func (f *Faker) LoremIpsumSentence(wordCount int) string {
+	return loremIpsumSentence(f.ctx(), wordCount)
+}
+
+func loremIpsumSentence(r fakerCtx, wordCount int) string {
+	return sentenceGen(r, wordCount, loremIpsumWord)
 }
 
 // LoremIpsumParagraph will generate a random paragraphGenerator
 func LoremIpsumParagraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
-	return paragraphGenerator(paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, LoremIpsumSentence)
+	return loremIpsumParagraph(globalFaker.ctx(), paragraphCount, sentenceCount, wordCount, separator)
+}
+
+// LoremIpsumParagraph will generate a random paragraphGenerator
+func (f *Faker) LoremIpsumParagraph(paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return loremIpsumParagraph(f.ctx(), paragraphCount, sentenceCount, wordCount, separator)
+}
+
+func loremIpsumParagraph(r fakerCtx, paragraphCount int, sentenceCount int, wordCount int, separator string) string {
+	return paragraphGenerator(r, paragrapOptions{paragraphCount, sentenceCount, wordCount, separator}, loremIpsumSentence)
 }
 
-func sentence(wordCount int, word wordGenerator) string {
+func sentenceGen(r fakerCtx, wordCount int, word wordGenerator) string {
 	if wordCount <= 0 {
 		return ""
 	}
@@ -88,7 +155,7 @@ func sentence(wordCount int, word wordGenerator) string {
 	sentence.Grow(wordCount * bytesPerWordEstimation)
 
 	for i := 0; i < wordCount; i++ {
-		word := word()
+		word := word(r)
 		if i == 0 {
 			runes := []rune(word)
 			runes[0] = unicode.ToTitle(runes[0])
@@ -103,7 +170,7 @@ func sentence(wordCount int, word wordGenerator) string {
 	return sentence.String()
 }
 
-func paragraphGenerator(opts paragrapOptions, sentecer sentenceGenerator) string {
+func paragraphGenerator(r fakerCtx, opts paragrapOptions, sentecer sentenceGenerator) string {
 	if opts.paragraphCount <= 0 || opts.sentenceCount <= 0 || opts.wordCount <= 0 {
 		return ""
 	}
@@ -116,7 +183,7 @@ func paragraphGenerator(opts paragrapOptions, sentecer sentenceGenerator) string
 
 	for i := 0; i < opts.paragraphCount; i++ {
 		for e := 0; e < opts.sentenceCount; e++ {
-			paragraphs.WriteString(sentecer(opts.wordCount))
+			paragraphs.WriteString(sentecer(r, opts.wordCount))
 			if e < opts.sentenceCount-1 {
 				paragraphs.WriteRune(wordSeparator)
 			}
@@ -131,18 +198,33 @@ func paragraphGenerator(opts paragrapOptions, sentecer sentenceGenerator) string
 }
 
 // Question will return a random question
-func Question() string {
-	return strings.Replace(HipsterSentence(Number(3, 10)), ".", "?", 1)
+func Question() string { return question(globalFaker.ctx()) }
+
+// Question will return a random question
+func (f *Faker) Question() string { return question(f.ctx()) }
+
+func question(r fakerCtx) string {
+	return strings.Replace(hipsterSentence(r, number(r, 3, 10)), ".", "?", 1)
 }
 
 // Quote will return a random quote from a random person
-func Quote() string {
-	return `"` + HipsterSentence(Number(3, 10)) + `" - ` + FirstName() + " " + LastName()
+func Quote() string { return quote(globalFaker.ctx()) }
+
+// Quote will return a random quote from a random person
+func (f *Faker) Quote() string { return quote(f.ctx()) }
+
+func quote(r fakerCtx) string {
+	return `"` + hipsterSentence(r, number(r, 3, 10)) + `" - ` + firstName(r) + " " + lastName(r)
 }
 
 // Phrase will return a random dictionary phrase
-func Phrase() string {
-	return getRandValue([]string{"word", "phrase"})
+func Phrase() string { return phrase(globalFaker.ctx()) }
+
+// Phrase will return a random dictionary phrase
+func (f *Faker) Phrase() string { return phrase(f.ctx()) }
+
+func phrase(r fakerCtx) string {
+	return getRandValue(r, []string{"word", "phrase"})
 }
 
 func addWordLookup() {
@@ -152,8 +234,8 @@ func addWordLookup() {
 		Description: "Random noun",
 		Example:     "foot",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Noun(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return noun(r), nil
 		},
 	})
 
@@ -163,8 +245,8 @@ func addWordLookup() {
 		Description: "Random verb",
 		Example:     "release",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Verb(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return verb(r), nil
 		},
 	})
 
@@ -174,8 +256,8 @@ func addWordLookup() {
 		Description: "Random adverb",
 		Example:     "smoothly",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Adverb(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return adverb(r), nil
 		},
 	})
 
@@ -185,8 +267,8 @@ func addWordLookup() {
 		Description: "Random preposition",
 		Example:     "down",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Preposition(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return preposition(r), nil
 		},
 	})
 
@@ -196,8 +278,8 @@ func addWordLookup() {
 		Description: "Random adjective",
 		Example:     "genuine",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Adjective(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return adjective(r), nil
 		},
 	})
 
@@ -207,8 +289,8 @@ func addWordLookup() {
 		Description: "Random word",
 		Example:     "man",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Word(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return word(r), nil
 		},
 	})
 
@@ -221,7 +303,7 @@ func addWordLookup() {
 		Params: []Param{
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			wordCount, err := info.GetInt(m, "wordcount")
 			if err != nil {
 				return nil, err
@@ -230,7 +312,7 @@ func addWordLookup() {
 				return nil, errors.New("Invalid word count, must be greater than 0, less than 50")
 			}
 
-			return Sentence(wordCount), nil
+			return sentence(r, wordCount), nil
 		},
 	})
 
@@ -246,7 +328,7 @@ func addWordLookup() {
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 			{Field: "paragraphseparator", Display: "Paragraph Separator", Type: "string", Default: "<br />", Description: "String value to add between paragraphs"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			paragraphCount, err := info.GetInt(m, "paragraphcount")
 			if err != nil {
 				return nil, err
@@ -276,7 +358,7 @@ func addWordLookup() {
 				return nil, err
 			}
 
-			return Paragraph(paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
+			return paragraph(r, paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
 		},
 	})
 
@@ -286,8 +368,8 @@ func addWordLookup() {
 		Description: "Random lorem ipsum word",
 		Example:     "quia",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return LoremIpsumWord(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return loremIpsumWord(r), nil
 		},
 	})
 
@@ -300,7 +382,7 @@ func addWordLookup() {
 		Params: []Param{
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			wordCount, err := info.GetInt(m, "wordcount")
 			if err != nil {
 				return nil, err
@@ -309,7 +391,7 @@ func addWordLookup() {
 				return nil, errors.New("Invalid word count, must be greater than 0, less than 50")
 			}
 
-			return LoremIpsumSentence(wordCount), nil
+			return loremIpsumSentence(r, wordCount), nil
 		},
 	})
 
@@ -325,7 +407,7 @@ func addWordLookup() {
 			{Field: "wordcount", Display: "Word Count", Type: "int", Default: "5", Description: "Number of words in a sentence"},
 			{Field: "paragraphseparator", Display: "Paragraph Separator", Type: "string", Default: "<br />", Description: "String value to add between paragraphs"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			paragraphCount, err := info.GetInt(m, "paragraphcount")
 			if err != nil {
 				return nil, err
@@ -355,7 +437,7 @@ func addWordLookup() {
 				return nil, err
 			}
 
-			return LoremIpsumParagraph(paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
+			return loremIpsumParagraph(r, paragraphCount, sentenceCount, wordCount, paragraphSeparator), nil
 		},
 	})
 
@@ -365,8 +447,8 @@ func addWordLookup() {
 		Description: "Random question",
 		Example:     "Roof chia echo?",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Question(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return question(r), nil
 		},
 	})
 
@@ -376,8 +458,8 @@ func addWordLookup() {
 		Description: "Random quote",
 		Example:     `"Roof chia echo." - Lura Lockman`,
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Quote(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return quote(r), nil
 		},
 	})
 
@@ -387,8 +469,8 @@ func addWordLookup() {
 		Description: "Random phrase",
 		Example:     "time will tell",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
-			return Phrase(), nil
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			return phrase(r), nil
 		},
 	})
 }
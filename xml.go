@@ -8,9 +8,9 @@ import (
 	"reflect"
 )
 
-// XMLOptions defines values needed for json generation
+// XMLOptions defines values needed for xml generation
 type XMLOptions struct {
-	Type          string  `json:"type" xml:"type"` // single or multiple
+	Type          string  `json:"type" xml:"type"` // single or array, defaults to array
 	RootElement   string  `json:"root_element" xml:"root_element"`
 	RecordElement string  `json:"record_element" xml:"record_element"`
 	RowCount      int     `json:"row_count" xml:"row_count"`
@@ -126,8 +126,18 @@ func xmlMapLoop(e *xml.Encoder, m *xmlMap) error {
 	return nil
 }
 
-// XML generates an object or an array of objects in json format
-func XML(xo *XMLOptions) ([]byte, error) {
+// XML generates an object or an array of objects in xml format
+func XML(xo *XMLOptions) ([]byte, error) { return xmlFunc(globalFaker.ctx(), xo) }
+
+// XML generates an object or an array of objects in xml format
+func (f *Faker) XML(xo *XMLOptions) ([]byte, error) { return xmlFunc(f.ctx(), xo) }
+
+func xmlFunc(r fakerCtx, xo *XMLOptions) ([]byte, error) {
+	// Default to an array of records
+	if xo.Type == "" {
+		xo.Type = "array"
+	}
+
 	// Check to make sure they passed in a type
 	if xo.Type != "single" && xo.Type != "array" {
 		return nil, errors.New("Invalid type, must be array or object")
@@ -140,7 +150,7 @@ func XML(xo *XMLOptions) ([]byte, error) {
 
 	// Check root element string
 	if xo.RootElement == "" {
-		xo.RecordElement = "xml"
+		xo.RootElement = "xml"
 	}
 
 	// Check record element string
@@ -149,7 +159,7 @@ func XML(xo *XMLOptions) ([]byte, error) {
 	}
 
 	// Get key order by order of fields array
-	keyOrder := make([]string, len(xo.Fields))
+	keyOrder := make([]string, 0, len(xo.Fields))
 	for _, f := range xo.Fields {
 		keyOrder = append(keyOrder, f.Name)
 	}
@@ -169,7 +179,7 @@ func XML(xo *XMLOptions) ([]byte, error) {
 				return nil, errors.New("Invalid function, " + field.Function + " does not exist")
 			}
 
-			value, err := funcInfo.Call(&field.Params, funcInfo)
+			value, err := fieldCall(r, &field, funcInfo)
 			if err != nil {
 				return nil, err
 			}
@@ -199,7 +209,7 @@ func XML(xo *XMLOptions) ([]byte, error) {
 
 		xa := xmlArray{
 			XMLName: xml.Name{Local: xo.RootElement},
-			Array:   make([]xmlMap, xo.RowCount),
+			Array:   make([]xmlMap, 0, xo.RowCount),
 		}
 
 		for i := 1; i <= int(xo.RowCount); i++ {
@@ -212,7 +222,20 @@ func XML(xo *XMLOptions) ([]byte, error) {
 			// Loop through fields and add to them to map[string]interface{}
 			for _, field := range xo.Fields {
 				if field.Function == "autoincrement" {
-					v.Map[field.Name] = i
+					id, err := autoIncrement(&field, i)
+					if err != nil {
+						return nil, err
+					}
+					v.Map[field.Name] = id
+					continue
+				}
+
+				if field.Function == "timeseries" {
+					ts, err := timeSeries(r, &field, i)
+					if err != nil {
+						return nil, err
+					}
+					v.Map[field.Name] = ts
 					continue
 				}
 
@@ -222,7 +245,7 @@ func XML(xo *XMLOptions) ([]byte, error) {
 					return nil, errors.New("Invalid function, " + field.Function + " does not exist")
 				}
 
-				value, err := funcInfo.Call(&field.Params, funcInfo)
+				value, err := fieldCall(r, &field, funcInfo)
 				if err != nil {
 					return nil, err
 				}
@@ -278,7 +301,7 @@ func addFileXMLLookup() {
 			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
 			{Field: "indent", Display: "Indent", Type: "bool", Default: "false", Description: "Whether or not to add indents and newlines"},
 		},
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
 			xo := XMLOptions{}
 
 			typ, err := info.GetString(m, "type")
@@ -329,7 +352,7 @@ func addFileXMLLookup() {
 			}
 			xo.Indent = indent
 
-			return XML(&xo)
+			return xmlFunc(r, &xo)
 		},
 	})
 }
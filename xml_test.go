@@ -1,7 +1,10 @@
 package gofakeit
 
 import (
+	"encoding/xml"
 	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -79,7 +82,7 @@ func TestXMLSingle(t *testing.T) {
 		Description: "",
 		Example:     "",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
 			return map[string]interface{}{
 				"string": "string value",
 				"int":    123456789,
@@ -120,7 +123,7 @@ func TestXMLArray(t *testing.T) {
 		Description: "",
 		Example:     "",
 		Output:      "string",
-		Call: func(m *map[string][]string, info *Info) (interface{}, error) {
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
 			return map[string]interface{}{
 				"string": "string value",
 				"int":    123456789,
@@ -155,6 +158,64 @@ func TestXMLArray(t *testing.T) {
 	}
 }
 
+func TestXMLUnmarshal(t *testing.T) {
+	value, err := XML(&XMLOptions{
+		RootElement:   "people",
+		RecordElement: "person",
+		RowCount:      25,
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "note", Function: "generate", Params: map[string][]string{"str": {"<b>Tom & 'Jerry'</b>"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var people struct {
+		XMLName xml.Name `xml:"people"`
+		Person  []struct {
+			ID        int    `xml:"id"`
+			FirstName string `xml:"first_name"`
+			Note      string `xml:"note"`
+		} `xml:"person"`
+	}
+	err = xml.Unmarshal(value, &people)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(people.Person) != 25 {
+		t.Fatalf("expected 25 records got %d", len(people.Person))
+	}
+	for i, p := range people.Person {
+		if p.ID != i+1 {
+			t.Errorf("expected id %d got %d", i+1, p.ID)
+		}
+		if p.FirstName == "" {
+			t.Error("expected first name to be set")
+		}
+		if p.Note != "<b>Tom & 'Jerry'</b>" {
+			t.Errorf("expected escaped note to round trip, got %s", p.Note)
+		}
+	}
+}
+
+func TestXMLDefaultElements(t *testing.T) {
+	value, err := XML(&XMLOptions{
+		RowCount: 2,
+		Fields:   []Field{{Name: "first_name", Function: "firstname"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(string(value), "<xml><record><first_name>") {
+		t.Errorf("expected xml root and record elements, got %s", value)
+	}
+}
+
 func TestXMLLookup(t *testing.T) {
 	info := GetFuncLookup("xml")
 
@@ -168,7 +229,7 @@ func TestXMLLookup(t *testing.T) {
 			`{"name":"password","function":"password","params":{"special":["false"],"length":["20"]}}`,
 		},
 	}
-	_, err := info.Call(&m, info)
+	_, err := info.call(globalFaker.ctx(), &m)
 	if err != nil {
 		t.Fatal(err.Error())
 	}
@@ -191,7 +252,7 @@ func BenchmarkXMLLookup100(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -213,7 +274,7 @@ func BenchmarkXMLLookup1000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -235,7 +296,7 @@ func BenchmarkXMLLookup10000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -256,7 +317,7 @@ func BenchmarkXMLLookup100000(b *testing.B) {
 				`{"name":"created_at","function":"date"}`,
 			},
 		}
-		_, err := info.Call(&m, info)
+		_, err := info.call(globalFaker.ctx(), &m)
 		if err != nil {
 			b.Fatal(err.Error())
 		}
@@ -0,0 +1,314 @@
+package gofakeit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// YAMLOptions defines values needed for yaml generation
+type YAMLOptions struct {
+	Type     string  `json:"type" xml:"type"` // array or object, defaults to array
+	RowCount int     `json:"row_count" xml:"row_count"`
+	Fields   []Field `json:"fields" xml:"fields"`
+}
+
+// yamlMapping keeps the order of keys as they were defined by the fields
+type yamlMapping []yamlPair
+
+type yamlPair struct {
+	Key   string
+	Value interface{}
+}
+
+// YAML generates a mapping or a sequence of mappings in yaml format
+func YAML(yo *YAMLOptions) ([]byte, error) { return yamlFunc(globalFaker.ctx(), yo) }
+
+// YAML generates a mapping or a sequence of mappings in yaml format
+func (f *Faker) YAML(yo *YAMLOptions) ([]byte, error) { return yamlFunc(f.ctx(), yo) }
+
+func yamlFunc(r fakerCtx, yo *YAMLOptions) ([]byte, error) {
+	if yo.Type == "" {
+		yo.Type = "array"
+	}
+
+	// Check to make sure they passed in a type
+	if yo.Type != "array" && yo.Type != "object" {
+		return nil, errors.New("Invalid type, must be array or object")
+	}
+
+	if yo.Fields == nil || len(yo.Fields) <= 0 {
+		return nil, errors.New("Must pass fields in order to build yaml mapping(s)")
+	}
+
+	var b bytes.Buffer
+
+	if yo.Type == "object" {
+		// Object only has one row
+		v, err := jsonRow(r, yo.Fields, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		mapping, err := yamlNormalize(v)
+		if err != nil {
+			return nil, err
+		}
+
+		yamlWriteMapping(&b, mapping.(yamlMapping), 0, false)
+		return b.Bytes(), nil
+	}
+
+	// Make sure you set a row count
+	if yo.RowCount <= 0 {
+		return nil, errors.New("Must have row count")
+	}
+
+	rows := make([]interface{}, yo.RowCount)
+	for i := 0; i < yo.RowCount; i++ {
+		v, err := jsonRow(r, yo.Fields, i+1) // +1 because index starts with 0
+		if err != nil {
+			return nil, err
+		}
+
+		rows[i], err = yamlNormalize(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	yamlWriteSequence(&b, rows, 0, false)
+	return b.Bytes(), nil
+}
+
+// yamlNormalize converts a generated value into a yamlMapping, []interface{} or a scalar string
+func yamlNormalize(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case jsonOrderedKeyVal:
+		m := make(yamlMapping, len(val))
+		for i, kv := range val {
+			value, err := yamlNormalize(kv.Value)
+			if err != nil {
+				return nil, err
+			}
+			m[i] = yamlPair{Key: kv.Key, Value: value}
+		}
+		return m, nil
+	case []jsonOrderedKeyVal:
+		s := make([]interface{}, len(val))
+		for i, obj := range val {
+			value, err := yamlNormalize(obj)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = value
+		}
+		return s, nil
+	case nil:
+		return "null", nil
+	case string:
+		return yamlString(val), nil
+	case json.Number:
+		return val.String(), nil
+	}
+
+	// Anything else, numbers, bools, structs, maps and slices, goes through json which keeps
+	// struct field order. Values json can't marshal, like NaN or channels, are returned as errors
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.New("Unable to marshal yaml value, " + err.Error())
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return string(j), nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	return yamlDecodeJSON(d)
+}
+
+// yamlDecodeJSON reads the next json value from d keeping the order of object keys
+func yamlDecodeJSON(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case json.Delim('{'):
+		m := yamlMapping{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := yamlDecodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, yamlPair{Key: key.(string), Value: value})
+		}
+		_, err = d.Token() // Closing }
+		return m, err
+	case json.Delim('['):
+		s := []interface{}{}
+		for d.More() {
+			value, err := yamlDecodeJSON(d)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, value)
+		}
+		_, err = d.Token() // Closing ]
+		return s, err
+	}
+
+	return yamlNormalize(t)
+}
+
+// yamlString returns s as a plain scalar when that is unambiguous, otherwise double quoted
+func yamlString(s string) string {
+	plain := s != "" && s == strings.TrimSpace(s)
+	for i, c := range s {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && ((c >= '0' && c <= '9') || strings.ContainsRune(" _.-@/", c))) {
+			continue
+		}
+		plain = false
+		break
+	}
+
+	// Words yaml would read as booleans or null
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "y", "n", "null", "nan", "inf":
+		plain = false
+	}
+
+	if plain {
+		return s
+	}
+
+	// JSON string escapes are valid in yaml double quoted scalars
+	j, _ := json.Marshal(s)
+	return string(j)
+}
+
+func yamlWriteMapping(b *bytes.Buffer, m yamlMapping, indent int, inline bool) {
+	if len(m) == 0 {
+		b.WriteString("{}\n")
+		return
+	}
+
+	for i, pair := range m {
+		if i > 0 || !inline {
+			b.WriteString(strings.Repeat(" ", indent))
+		}
+		b.WriteString(yamlString(pair.Key) + ":")
+
+		switch val := pair.Value.(type) {
+		case yamlMapping:
+			if len(val) == 0 {
+				b.WriteString(" {}\n")
+				continue
+			}
+			b.WriteString("\n")
+			yamlWriteMapping(b, val, indent+2, false)
+		case []interface{}:
+			if len(val) == 0 {
+				b.WriteString(" []\n")
+				continue
+			}
+			b.WriteString("\n")
+			yamlWriteSequence(b, val, indent+2, false)
+		default:
+			b.WriteString(" " + val.(string) + "\n")
+		}
+	}
+}
+
+func yamlWriteSequence(b *bytes.Buffer, s []interface{}, indent int, inline bool) {
+	if len(s) == 0 {
+		b.WriteString("[]\n")
+		return
+	}
+
+	for i, item := range s {
+		if i > 0 || !inline {
+			b.WriteString(strings.Repeat(" ", indent))
+		}
+		b.WriteString("- ")
+
+		switch val := item.(type) {
+		case yamlMapping:
+			yamlWriteMapping(b, val, indent+2, true)
+		case []interface{}:
+			yamlWriteSequence(b, val, indent+2, true)
+		default:
+			b.WriteString(val.(string) + "\n")
+		}
+	}
+}
+
+func addFileYAMLLookup() {
+	AddFuncLookup("yaml", Info{
+		Display:     "YAML",
+		Category:    "file",
+		Description: "Generates a mapping or a sequence of mappings in yaml format",
+		Example: `
+			- first_name: Markus
+			  last_name: Moen
+			  password: Dc0VYXjkWABx
+			- first_name: Osborne
+			  last_name: Hilll
+			  password: XPJ9OVNbs5lm
+		`,
+		Output: "[]byte",
+		Params: []Param{
+			{Field: "type", Display: "Type", Type: "string", Default: "array", Options: []string{"array", "object"}, Description: "Type of yaml, a sequence of mappings or a single mapping"},
+			{Field: "rowcount", Display: "Row Count", Type: "int", Default: "100", Description: "Number of rows in yaml sequence"},
+			{Field: "fields", Display: "Fields", Type: "[]Field", Description: "Fields containing key name and function to run in json format"},
+		},
+		generate: func(r fakerCtx, m *map[string][]string, info *Info) (interface{}, error) {
+			yo := YAMLOptions{}
+
+			typ, err := info.GetString(m, "type")
+			if err != nil {
+				return nil, err
+			}
+			yo.Type = typ
+
+			rowcount, err := info.GetInt(m, "rowcount")
+			if err != nil {
+				return nil, err
+			}
+			yo.RowCount = rowcount
+
+			fieldsStr, err := info.GetStringArray(m, "fields")
+			if err != nil {
+				return nil, err
+			}
+
+			// Check to make sure fields has length
+			if len(fieldsStr) > 0 {
+				yo.Fields = make([]Field, len(fieldsStr))
+
+				for i, f := range fieldsStr {
+					// Unmarshal fields string into fields array
+					err = json.Unmarshal([]byte(f), &yo.Fields[i])
+					if err != nil {
+						return nil, errors.New("Unable to decode json string")
+					}
+				}
+			}
+
+			return yamlFunc(r, &yo)
+		},
+	})
+}
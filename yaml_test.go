@@ -0,0 +1,170 @@
+package gofakeit
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func ExampleYAML_object() {
+	Seed(11)
+
+	value, err := YAML(&YAMLOptions{
+		Type: "object",
+		Fields: []Field{
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "address", Function: "address"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output: first_name: Markus
+	// last_name: Moen
+	// address:
+	//   address: "4599 Dale ton, Lake Carroll, Mississippi 90635"
+	//   street: "4599 Dale ton"
+	//   city: Lake Carroll
+	//   state: Mississippi
+	//   zip: "90635"
+	//   country: Saint Pierre and Miquelon
+	//   latitude: 22.008873
+	//   longitude: 158.531956
+	// password: YjJbXclnVN0H
+}
+
+func ExampleYAML_array() {
+	Seed(11)
+
+	value, err := YAML(&YAMLOptions{
+		Type: "array",
+		Fields: []Field{
+			{Name: "id", Function: "autoincrement"},
+			{Name: "first_name", Function: "firstname"},
+			{Name: "last_name", Function: "lastname"},
+			{Name: "password", Function: "password", Params: map[string][]string{"special": {"false"}}},
+		},
+		RowCount: 3,
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(string(value))
+
+	// Output: - id: 1
+	//   first_name: Markus
+	//   last_name: Moen
+	//   password: Dc0VYXjkWABx
+	// - id: 2
+	//   first_name: Osborne
+	//   last_name: Hilll
+	//   password: XPJ9OVNbs5lm
+	// - id: 3
+	//   first_name: Mertie
+	//   last_name: Halvorson
+	//   password: eyl3bhwfV8wA
+}
+
+func TestYAMLLookup(t *testing.T) {
+	info := GetFuncLookup("yaml")
+
+	m := map[string][]string{
+		"type":     {"array"},
+		"rowcount": {"10"},
+		"fields": {
+			`{"name":"id","function":"autoincrement"}`,
+			`{"name":"first_name","function":"firstname"}`,
+			`{"name":"password","function":"password","params":{"special":["false"],"length":["20"]}}`,
+		},
+	}
+
+	value, err := info.call(globalFaker.ctx(), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every row of the sequence starts with a dash at the start of a line
+	if rows := strings.Count("\n"+string(value.([]byte)), "\n- "); rows != 10 {
+		t.Errorf("expected 10 rows, got %d", rows)
+	}
+}
+
+func TestYAMLString(t *testing.T) {
+	tests := map[string]string{
+		"Markus":      "Markus",
+		"Markus Moen": "Markus Moen",
+		"true":        `"true"`,
+		"No":          `"No"`,
+		"null":        `"null"`,
+		"123":         `"123"`,
+		"":            `""`,
+		" pad":        `" pad"`,
+		"a: b":        `"a: b"`,
+		"#hash":       `"#hash"`,
+		"-dash":       `"-dash"`,
+	}
+
+	for in, out := range tests {
+		if got := yamlString(in); got != out {
+			t.Errorf("yamlString(%q) expected %s, got %s", in, out, got)
+		}
+	}
+}
+
+func TestYAMLErrors(t *testing.T) {
+	_, err := YAML(&YAMLOptions{Type: "list", RowCount: 1, Fields: []Field{{Name: "a", Function: "name"}}})
+	if err == nil {
+		t.Error("expected error for invalid type")
+	}
+
+	_, err = YAML(&YAMLOptions{RowCount: 1})
+	if err == nil {
+		t.Error("expected error for missing fields")
+	}
+
+	_, err = YAML(&YAMLOptions{Fields: []Field{{Name: "a", Function: "name"}}})
+	if err == nil {
+		t.Error("expected error for missing row count")
+	}
+}
+
+func TestYAMLMarshalError(t *testing.T) {
+	AddFuncLookup("yamlchannel", Info{
+		Category:    "custom",
+		Description: "Value json can't marshal",
+		Example:     "",
+		Output:      "chan int",
+		CallR: func(r *rand.Rand, m *map[string][]string, info *Info) (interface{}, error) {
+			return make(chan int), nil
+		},
+	})
+	defer RemoveFuncLookup("yamlchannel")
+
+	for _, typ := range []string{"object", "array"} {
+		value, err := YAML(&YAMLOptions{Type: typ, RowCount: 2, Fields: []Field{{Name: "a", Function: "yamlchannel"}}})
+		if err == nil {
+			t.Errorf("expected error for %s with a value that can't be marshaled, got %s", typ, value)
+		}
+	}
+}
+
+func BenchmarkYAML100(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		YAML(&YAMLOptions{
+			RowCount: 100,
+			Fields: []Field{
+				{Name: "id", Function: "autoincrement"},
+				{Name: "first_name", Function: "firstname"},
+				{Name: "last_name", Function: "lastname"},
+				{Name: "password", Function: "password"},
+			},
+		})
+	}
+}